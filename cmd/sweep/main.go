@@ -0,0 +1,39 @@
+// Command sweep removes the resources of a Connect instance left behind by
+// tests and experiments, i.e. whose name starts with a prefix. Resources the
+// API cannot delete, e.g. agent statuses, are disabled instead.
+//
+//	go run ./cmd/sweep -instance-id <instance_id> -prefix tf-test-
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/USAN/terraform-provider-awsext/provider"
+	"github.com/aws/aws-sdk-go-v2/config"
+)
+
+func main() {
+	var options provider.SweepOptions
+	var region, profile string
+
+	flag.StringVar(&options.InstanceID, "instance-id", "", "ID of the Connect instance to sweep")
+	flag.StringVar(&options.Prefix, "prefix", "", "name prefix of the resources to sweep")
+	flag.BoolVar(&options.DryRun, "dry-run", false, "only report the resources that would be swept")
+	flag.BoolVar(&options.Instances, "instances", false, "also delete the other instances whose alias starts with the prefix")
+	flag.StringVar(&region, "region", "", "AWS region, defaults to the shared config")
+	flag.StringVar(&profile, "profile", "", "AWS profile, defaults to the shared config")
+	flag.Parse()
+
+	options.Logf = log.Printf
+
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(region), config.WithSharedConfigProfile(profile))
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if err := provider.Sweep(context.Background(), cfg, options); err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,19 @@
+action "awsext_connect_publish_flow" "main" {
+  config {
+    instance_id         = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+    contact_flow_id     = "aaaaaaaa-bbbb-cccc-dddd-222222222222"
+    create_version      = true
+    version_description = "Release 42"
+  }
+}
+
+resource "terraform_data" "release" {
+  input = "42"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.awsext_connect_publish_flow.main]
+    }
+  }
+}
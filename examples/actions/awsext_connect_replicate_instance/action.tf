@@ -0,0 +1,7 @@
+action "awsext_connect_replicate_instance" "dr" {
+  config {
+    instance_id    = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+    replica_region = "us-west-2"
+    replica_alias  = "example-dr"
+  }
+}
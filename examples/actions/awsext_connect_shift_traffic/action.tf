@@ -0,0 +1,9 @@
+action "awsext_connect_shift_traffic" "failover" {
+  config {
+    traffic_distribution_group_id = "aaaaaaaa-bbbb-cccc-dddd-333333333333"
+    distribution = {
+      "us-east-1" = 0
+      "us-west-2" = 100
+    }
+  }
+}
@@ -0,0 +1,11 @@
+data "awsext_connect_agent_statuses" "all" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+}
+
+locals {
+  agent_status_arns = { for status in data.awsext_connect_agent_statuses.all.agent_statuses : status.name => status.arn }
+}
+
+output "available_status_arn" {
+  value = local.agent_status_arns["Available"]
+}
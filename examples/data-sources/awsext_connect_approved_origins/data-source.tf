@@ -0,0 +1,3 @@
+data "awsext_connect_approved_origins" "example" {
+  instance_id = "your-instance-id"
+}
@@ -0,0 +1,16 @@
+data "awsext_connect_bot_associations" "example" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  lex_version = "V2"
+}
+
+locals {
+  required_bot_alias_arns   = ["arn:aws:lex:us-east-1:123456789012:bot-alias/ABCDEFGHIJ/TSTALIASID"]
+  associated_bot_alias_arns = [for bot in data.awsext_connect_bot_associations.example.bots : bot.alias_arn]
+}
+
+check "required_bots_associated" {
+  assert {
+    condition     = length(setsubtract(local.required_bot_alias_arns, local.associated_bot_alias_arns)) == 0
+    error_message = "Associate the Lex bots referenced by the flows with the instance before publishing them."
+  }
+}
@@ -0,0 +1,17 @@
+data "awsext_connect_current_metric_data" "example" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  queue_ids   = ["eeeeeeee-ffff-0000-1111-222222222222"]
+  groupings   = ["QUEUE"]
+  metrics     = ["AGENTS_ONLINE", "CONTACTS_IN_QUEUE"]
+}
+
+# Warn before changing a queue with contacts waiting
+check "queue_idle" {
+  assert {
+    condition = alltrue([
+      for result in data.awsext_connect_current_metric_data.example.results :
+      coalesce(result.metrics["CONTACTS_IN_QUEUE"], 0) == 0
+    ])
+    error_message = "The queue has contacts waiting."
+  }
+}
@@ -0,0 +1,3 @@
+data "awsext_connect_email_addresses" "example" {
+  instance_id = "your-instance-id"
+}
@@ -0,0 +1,11 @@
+data "awsext_connect_instance" "contact_center" {
+  instance_alias = "acme-contact-center"
+}
+
+output "contact_center_instance_id" {
+  value = data.awsext_connect_instance.contact_center.instance_id
+}
+
+output "contact_lens_enabled" {
+  value = data.awsext_connect_instance.contact_center.attributes["CONTACT_LENS"] == "true"
+}
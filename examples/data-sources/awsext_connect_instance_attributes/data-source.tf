@@ -0,0 +1,3 @@
+data "awsext_connect_instance_attributes" "example" {
+  instance_id = "your-instance-id"
+}
@@ -0,0 +1,10 @@
+data "awsext_connect_instance_export" "example" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+}
+
+# Write the import blocks, then run
+# terraform plan -generate-config-out=generated.tf
+resource "local_file" "imports" {
+  filename = "${path.module}/imports.tf"
+  content  = data.awsext_connect_instance_export.example.import_blocks
+}
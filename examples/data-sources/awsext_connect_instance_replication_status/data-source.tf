@@ -0,0 +1,11 @@
+data "awsext_connect_instance_replication_status" "example" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+}
+
+# Only shift traffic to the replica once replication is complete
+check "replica_ready" {
+  assert {
+    condition     = data.awsext_connect_instance_replication_status.example.ready
+    error_message = "The instance replication is not complete."
+  }
+}
@@ -0,0 +1,3 @@
+data "awsext_connect_lambda_function_associations" "example" {
+  instance_id = "your-instance-id"
+}
@@ -0,0 +1,23 @@
+# Average queue answer time of a queue over the last week
+data "awsext_connect_metric_data_v2" "example" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  start_time  = timeadd(plantimestamp(), "-168h")
+  end_time    = plantimestamp()
+
+  filters = [{
+    key    = "QUEUE"
+    values = ["eeeeeeee-ffff-0000-1111-222222222222"]
+  }]
+
+  metrics = [
+    { name = "AVG_QUEUE_ANSWER_TIME" },
+    {
+      name      = "SERVICE_LEVEL"
+      threshold = { comparison = "LT", value = 60 }
+    },
+  ]
+}
+
+output "avg_queue_answer_time" {
+  value = data.awsext_connect_metric_data_v2.example.results[0].metrics["AVG_QUEUE_ANSWER_TIME"]
+}
@@ -0,0 +1,12 @@
+data "awsext_connect_phone_number" "support" {
+  instance_id  = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  phone_number = "+12065550100"
+}
+
+data "awsext_connect_phone_number" "sales" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+
+  tags = {
+    Line = "sales"
+  }
+}
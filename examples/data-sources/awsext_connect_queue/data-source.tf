@@ -0,0 +1,8 @@
+data "awsext_connect_queue" "billing" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  name        = "Billing"
+}
+
+output "billing_queue_arn" {
+  value = data.awsext_connect_queue.billing.arn
+}
@@ -0,0 +1,8 @@
+data "awsext_connect_quick_connect" "escalations" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  name        = "Escalations"
+}
+
+output "escalations_queue_id" {
+  value = data.awsext_connect_quick_connect.escalations.queue_id
+}
@@ -0,0 +1,8 @@
+data "awsext_connect_routing_profile" "basic" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  name        = "Basic Routing Profile"
+}
+
+output "basic_routing_profile_id" {
+  value = data.awsext_connect_routing_profile.basic.routing_profile_id
+}
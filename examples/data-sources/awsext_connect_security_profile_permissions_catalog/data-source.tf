@@ -0,0 +1,8 @@
+data "awsext_connect_security_profile_permissions_catalog" "example" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+}
+
+# Read-only access to every area
+output "view_permissions" {
+  value = [for permission in data.awsext_connect_security_profile_permissions_catalog.example.permissions : permission if endswith(permission, ".View")]
+}
@@ -0,0 +1,10 @@
+data "awsext_connect_user" "jane" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  username    = "jane.doe@example.com"
+}
+
+resource "awsext_connect_user_hierarchy_group_association" "jane" {
+  instance_id        = data.awsext_connect_user.jane.instance_id
+  user_id            = data.awsext_connect_user.jane.user_id
+  hierarchy_group_id = "33333333-4444-5555-6666-777777777777"
+}
@@ -0,0 +1,9 @@
+data "awsext_connect_view" "form" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  name        = "Form"
+  type        = "AWS_MANAGED"
+}
+
+output "form_view_arn" {
+  value = data.awsext_connect_view.form.arn
+}
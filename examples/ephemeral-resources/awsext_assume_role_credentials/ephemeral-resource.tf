@@ -0,0 +1,16 @@
+ephemeral "awsext_assume_role_credentials" "example" {
+  role_arn     = "arn:aws:iam::123456789012:role/your-role"
+  session_name = "your-session-name"
+  external_id  = "your-external-id"
+
+  tags = {
+    Project = "contact-center"
+  }
+}
+
+provider "aws" {
+  alias      = "assumed"
+  access_key = ephemeral.awsext_assume_role_credentials.example.access_key_id
+  secret_key = ephemeral.awsext_assume_role_credentials.example.secret_access_key
+  token      = ephemeral.awsext_assume_role_credentials.example.session_token
+}
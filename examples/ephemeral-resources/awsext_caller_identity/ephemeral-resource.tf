@@ -0,0 +1 @@
+ephemeral "awsext_caller_identity" "current" {}
@@ -0,0 +1,10 @@
+ephemeral "awsext_connect_federation_token" "example" {
+  instance_id = "your-instance-id"
+}
+
+# Token of a test agent, e.g. for smoke tests in CI
+ephemeral "awsext_connect_federation_token" "agent" {
+  instance_id = "your-instance-id"
+  role_arn    = "arn:aws:iam::123456789012:role/connect-saml-agents"
+  username    = "test-agent@example.com"
+}
@@ -0,0 +1,20 @@
+ephemeral "awsext_connect_user_password" "initial" {
+  length = 20
+}
+
+resource "awsext_connect_users_bulk" "agents" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+
+  users = {
+    "jdoe" = {
+      first_name           = "Jane"
+      last_name            = "Doe"
+      email                = "jdoe@example.com"
+      routing_profile_id   = "eeeeeeee-ffff-0000-1111-222222222222"
+      security_profile_ids = ["33333333-4444-5555-6666-777777777777"]
+    }
+  }
+
+  initial_password_wo         = ephemeral.awsext_connect_user_password.initial.password
+  initial_password_wo_version = 1
+}
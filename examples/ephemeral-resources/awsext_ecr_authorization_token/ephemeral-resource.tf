@@ -0,0 +1,11 @@
+ephemeral "awsext_ecr_authorization_token" "example" {}
+
+# Log the docker provider in to the registry without storing the password in
+# the state
+provider "docker" {
+  registry_auth {
+    address  = ephemeral.awsext_ecr_authorization_token.example.registry_url
+    username = ephemeral.awsext_ecr_authorization_token.example.username
+    password = ephemeral.awsext_ecr_authorization_token.example.password
+  }
+}
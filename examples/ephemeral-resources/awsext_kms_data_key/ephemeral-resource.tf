@@ -0,0 +1,7 @@
+ephemeral "awsext_kms_data_key" "example" {
+  key_id = "alias/your-key"
+
+  encryption_context = {
+    purpose = "flow-attachments"
+  }
+}
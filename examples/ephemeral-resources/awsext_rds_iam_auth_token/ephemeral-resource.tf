@@ -0,0 +1,12 @@
+ephemeral "awsext_rds_iam_auth_token" "enrichment" {
+  endpoint = "ctr-enrichment.cluster-abcdefghijkl.us-east-1.rds.amazonaws.com:5432"
+  username = "terraform"
+}
+
+provider "postgresql" {
+  host     = "ctr-enrichment.cluster-abcdefghijkl.us-east-1.rds.amazonaws.com"
+  port     = 5432
+  username = "terraform"
+  password = ephemeral.awsext_rds_iam_auth_token.enrichment.token
+  sslmode  = "require"
+}
@@ -0,0 +1,7 @@
+ephemeral "awsext_s3_presigned_url" "example" {
+  bucket       = "your-bucket"
+  key          = "prompts/welcome.wav"
+  method       = "PUT"
+  content_type = "audio/wav"
+  expires_in   = 300
+}
@@ -0,0 +1,3 @@
+ephemeral "awsext_secretsmanager_secret_value" "example" {
+  secret_id = "your-secret-name"
+}
@@ -0,0 +1,3 @@
+ephemeral "awsext_ssm_parameter" "example" {
+  name = "/your/parameter/name"
+}
@@ -0,0 +1,12 @@
+ephemeral "awsext_sts_federation_token" "example" {
+  name             = "provisioning-script"
+  duration_seconds = 900
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject"]
+      Resource = "arn:aws:s3:::your-bucket/prompts/*"
+    }]
+  })
+}
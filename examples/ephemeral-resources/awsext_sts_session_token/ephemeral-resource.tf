@@ -0,0 +1,9 @@
+variable "mfa_code" {
+  type      = string
+  ephemeral = true
+}
+
+ephemeral "awsext_sts_session_token" "example" {
+  serial_number = "arn:aws:iam::123456789012:mfa/your-user"
+  token_code    = var.mfa_code
+}
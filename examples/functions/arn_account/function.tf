@@ -0,0 +1,3 @@
+locals {
+  instance_account_id = provider::awsext::arn_account(var.instance_arn)
+}
@@ -0,0 +1,3 @@
+output "queue_arn" {
+  value = provider::awsext::arn_build("aws", "connect", "us-east-1", "123456789012", "instance/your-instance-id/queue/your-queue-id")
+}
@@ -0,0 +1,16 @@
+variable "instance_arn" {
+  type = string
+}
+
+variable "queue_arns" {
+  type = list(string)
+}
+
+resource "terraform_data" "flow" {
+  lifecycle {
+    precondition {
+      condition     = alltrue([for arn in var.queue_arns : provider::awsext::arn_matches(arn, "${var.instance_arn}/queue/*")])
+      error_message = "The flow may only reference queues of its own instance."
+    }
+  }
+}
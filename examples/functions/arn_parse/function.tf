@@ -0,0 +1,7 @@
+locals {
+  agent_status = provider::awsext::arn_parse("arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/agent-state/eeeeeeee-ffff-0000-1111-222222222222")
+}
+
+output "account" {
+  value = local.agent_status.account
+}
@@ -0,0 +1,3 @@
+locals {
+  partition = provider::awsext::arn_partition(var.instance_arn)
+}
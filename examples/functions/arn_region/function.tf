@@ -0,0 +1,4 @@
+locals {
+  # The replica region of a Global Resiliency instance pair
+  replica_region = provider::awsext::arn_region(var.replica_instance_arn)
+}
@@ -0,0 +1,11 @@
+variable "queues" {
+  type = map(object({
+    description = string
+  }))
+}
+
+locals {
+  queue_names = {
+    for key, queue in var.queues : key => provider::awsext::connect_name_sanitize("support/${key}", 127)
+  }
+}
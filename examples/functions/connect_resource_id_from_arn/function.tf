@@ -0,0 +1,3 @@
+output "queue_id" {
+  value = provider::awsext::connect_resource_id_from_arn("arn:aws:connect:us-east-1:123456789012:instance/your-instance-id/queue/your-queue-id")
+}
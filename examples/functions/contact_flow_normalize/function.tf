@@ -0,0 +1,3 @@
+locals {
+  flow_content = provider::awsext::contact_flow_normalize(file("${path.module}/flows/inbound.json"))
+}
@@ -0,0 +1,17 @@
+locals {
+  flow_references = provider::awsext::contact_flow_references(file("${path.module}/flows/inbound.json"))
+}
+
+resource "aws_connect_contact_flow" "inbound" {
+  instance_id = "your-instance-id"
+  name        = "Inbound"
+  type        = "CONTACT_FLOW"
+  content     = file("${path.module}/flows/inbound.json")
+
+  lifecycle {
+    precondition {
+      condition     = length(setsubtract(local.flow_references, var.known_arns)) == 0
+      error_message = "The flow references resources that do not exist."
+    }
+  }
+}
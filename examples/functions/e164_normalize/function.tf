@@ -0,0 +1,3 @@
+output "caller_id" {
+  value = provider::awsext::e164_normalize("(555) 123-4567", "1")
+}
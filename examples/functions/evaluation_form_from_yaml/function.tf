@@ -0,0 +1,17 @@
+locals {
+  # forms/call_quality.yaml:
+  #
+  # title: Call quality
+  # scoring:
+  #   mode: SECTION_ONLY
+  # sections:
+  #   - title: Greeting
+  #     weight: 100
+  #     questions:
+  #       - title: Did the agent use the standard greeting?
+  #         type: SINGLESELECT
+  #         options:
+  #           - { text: "Yes", score: 10 }
+  #           - { text: "No", score: 0, automatic_fail: true }
+  call_quality_form = jsondecode(provider::awsext::evaluation_form_from_yaml(file("${path.module}/forms/call_quality.yaml")))
+}
@@ -0,0 +1,8 @@
+locals {
+  # flows/support.json contains placeholders such as "QueueId": "{{ support_queue }}"
+  support_flow = provider::awsext::flow_template_render(file("${path.module}/flows/support.json"), {
+    support_queue  = aws_connect_queue.support.arn
+    welcome_prompt = data.aws_connect_prompt.welcome.arn
+    lookup_lambda  = aws_lambda_function.customer_lookup.arn
+  })
+}
@@ -0,0 +1,10 @@
+locals {
+  support_hours = provider::awsext::hours_of_operation_config(
+    "Mon-Fri 08:00-12:00,13:00-18:00; Sat 09:00-13:00",
+    "America/New_York",
+  )
+}
+
+output "support_hours_config" {
+  value = local.support_hours.config
+}
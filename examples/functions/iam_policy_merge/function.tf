@@ -0,0 +1,15 @@
+locals {
+  recordings_bucket_policy = provider::awsext::iam_policy_merge([
+    data.aws_iam_policy_document.baseline_bucket.json,
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid       = "ConnectRecordings"
+        Effect    = "Allow"
+        Principal = { Service = "connect.amazonaws.com" }
+        Action    = ["s3:PutObject", "s3:GetBucketAcl"]
+        Resource  = ["arn:aws:s3:::example-recordings", "arn:aws:s3:::example-recordings/*"]
+      }]
+    }),
+  ])
+}
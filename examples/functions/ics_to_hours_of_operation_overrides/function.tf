@@ -0,0 +1,17 @@
+locals {
+  # Holiday calendar exported from the HR system, its times converted to the
+  # time zone of the hours of operation
+  holidays = provider::awsext::ics_to_hours_of_operation_overrides(file("${path.module}/holidays.ics"), "America/New_York")
+
+  closed_days = [for holiday in local.holidays : holiday if holiday.closed]
+}
+
+output "holiday_overrides" {
+  value = {
+    for holiday in local.holidays : holiday.name => {
+      effective_from = holiday.effective_from
+      effective_till = holiday.effective_till
+      config         = holiday.config
+    }
+  }
+}
@@ -0,0 +1,7 @@
+locals {
+  tags_all = provider::awsext::tags_merge(
+    [var.default_tags, var.module_tags, { Name = "support-queue" }],
+    ["LastModifiedBy"],
+    ["kubernetes.io/"],
+  )
+}
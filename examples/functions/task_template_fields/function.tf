@@ -0,0 +1,18 @@
+locals {
+  callback_template = jsondecode(provider::awsext::task_template_fields({
+    customer_number = {
+      type     = "TEXT"
+      required = true
+    }
+    priority = {
+      type    = "SINGLE_SELECT"
+      options = ["Low", "Normal", "High"]
+      default = "Normal"
+    }
+    case_url = {
+      type        = "URL"
+      description = "Link to the CRM case"
+      read_only   = true
+    }
+  }))
+}
@@ -0,0 +1,8 @@
+variable "connect_instance" {
+  description = "Connect instance ID or instance ARN"
+  type        = string
+}
+
+locals {
+  instance_id = provider::awsext::validate_connect_instance_id(var.connect_instance)
+}
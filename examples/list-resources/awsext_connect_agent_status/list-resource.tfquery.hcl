@@ -0,0 +1,7 @@
+list "awsext_connect_agent_status" "all" {
+  provider = awsext
+
+  config {
+    instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  }
+}
@@ -2,7 +2,42 @@ provider "awsext" {
   region     = "us-west-2"
   access_key = "your-access-key"
   profile    = "your-profile"
-  role_arn   = "your-role-arn"
   secret_key = "your-secret-key"
   token      = "your-token"
+
+  operation_policies = {
+    connect_agent_status = {
+      max_attempts = 30
+      timeout      = "5m"
+    }
+  }
+
+  assume_role {
+    role_arn     = "arn:aws:iam::111111111111:role/deployer"
+    session_name = "terraform"
+    external_id  = "your-external-id"
+    duration     = "1h"
+
+    tags = {
+      Project = "contact-center"
+    }
+    transitive_tag_keys = ["Project"]
+  }
+
+  # Chained with the credentials of the role above
+  assume_role {
+    role_arn = "arn:aws:iam::222222222222:role/connect-admin"
+  }
+
+  default_tags {
+    tags = {
+      Environment = "production"
+      ManagedBy   = "terraform"
+    }
+  }
+
+  ignore_tags {
+    keys         = ["LastScannedBy"]
+    key_prefixes = ["kubernetes.io/"]
+  }
 }
@@ -1 +1,8 @@
-terraform import awsext_connect_agent_status.test "id-123"
+# Agent statuses can be imported by <instance_id>:<agent_status_id> or <instance_id>/<agent_status_id>
+terraform import awsext_connect_agent_status.example "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
+
+# by <instance_id>:name=<name>
+terraform import awsext_connect_agent_status.example "aaaaaaaa-bbbb-cccc-dddd-111111111111:name=Lunch"
+
+# or by ARN
+terraform import awsext_connect_agent_status.example "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/agent-state/eeeeeeee-ffff-0000-1111-222222222222"
@@ -3,4 +3,5 @@ resource "awsext_connect_agent_status" "example" {
   name        = "your-name"
   state       = "your-state"
   description = "your-description"
+  on_destroy  = "disable_and_rename"
 }
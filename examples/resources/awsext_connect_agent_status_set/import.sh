@@ -0,0 +1,2 @@
+# Importing by instance ID manages all enabled custom agent statuses of the instance
+terraform import awsext_connect_agent_status_set.statuses "aaaaaaaa-bbbb-cccc-dddd-111111111111"
@@ -0,0 +1,18 @@
+resource "awsext_connect_agent_status_set" "statuses" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+
+  statuses = {
+    "Lunch" = {
+      state         = "ENABLED"
+      display_order = 1
+    }
+    "Training" = {
+      description   = "Scheduled training sessions"
+      state         = "ENABLED"
+      display_order = 2
+    }
+    "Legacy Break" = {
+      state = "DISABLED"
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Importing by instance ID lists all enabled custom agent statuses of the instance in their display order
+terraform import awsext_connect_agent_statuses.statuses "aaaaaaaa-bbbb-cccc-dddd-111111111111"
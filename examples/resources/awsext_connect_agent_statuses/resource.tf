@@ -0,0 +1,19 @@
+resource "awsext_connect_agent_statuses" "statuses" {
+  instance_id       = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  disable_unmanaged = true
+
+  # Enabled statuses are displayed in the order of the list
+  statuses = [
+    {
+      name = "Lunch"
+    },
+    {
+      name        = "Training"
+      description = "Scheduled training sessions"
+    },
+    {
+      name  = "Legacy Break"
+      state = "DISABLED"
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Bot associations can be imported by <instance_id>:<alias_arn>
+terraform import awsext_connect_bot_association.support "aaaaaaaa-bbbb-cccc-dddd-111111111111:arn:aws:lex:us-east-1:123456789012:bot-alias/ABCDEFGHIJ/KLMNOPQRST"
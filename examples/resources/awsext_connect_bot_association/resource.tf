@@ -0,0 +1,4 @@
+resource "awsext_connect_bot_association" "support" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  alias_arn   = "arn:aws:lex:us-east-1:123456789012:bot-alias/ABCDEFGHIJ/KLMNOPQRST"
+}
@@ -0,0 +1,7 @@
+resource "awsext_connect_config_backup" "example" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  bucket      = "example-connect-backups"
+  prefix      = "connect/"
+
+  include = ["contact_flows", "queues", "routing_profiles"]
+}
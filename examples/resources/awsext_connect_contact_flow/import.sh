@@ -0,0 +1,5 @@
+# Flows can be imported by <instance_id>:<contact_flow_id>
+terraform import awsext_connect_contact_flow.inbound "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
+
+# or by ARN
+terraform import awsext_connect_contact_flow.inbound "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/contact-flow/eeeeeeee-ffff-0000-1111-222222222222"
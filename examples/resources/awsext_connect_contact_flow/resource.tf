@@ -0,0 +1,20 @@
+resource "awsext_connect_contact_flow" "inbound" {
+  instance_id    = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  name           = "Inbound"
+  description    = "Main inbound flow"
+  content        = file("${path.module}/flows/inbound.json")
+  create_version = true
+
+  tags = {
+    team = "contact-center"
+  }
+}
+
+# Changes to this flow are saved as a draft to be reviewed and published in
+# the flow designer
+resource "awsext_connect_contact_flow" "callback" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  name        = "Callback"
+  content     = file("${path.module}/flows/callback.json")
+  status      = "SAVED"
+}
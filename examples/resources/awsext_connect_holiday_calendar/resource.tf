@@ -0,0 +1,28 @@
+resource "awsext_connect_holiday_calendar" "us" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+
+  hours_of_operation_ids = [
+    "eeeeeeee-ffff-0000-1111-222222222222",
+    "33333333-4444-5555-6666-777777777777",
+  ]
+
+  years = [2026, 2027]
+
+  holidays = {
+    "New Year" = {
+      date = "01-01"
+    }
+    "Independence Day" = {
+      date = "07-04"
+    }
+    "Thanksgiving 2026" = {
+      date     = "2026-11-26"
+      end_date = "2026-11-27"
+    }
+    "Christmas Eve 2026" = {
+      date        = "2026-12-24"
+      description = "Closing early"
+      config      = provider::awsext::hours_of_operation_config("Thu 08:00-13:00", "America/New_York").config
+    }
+  }
+}
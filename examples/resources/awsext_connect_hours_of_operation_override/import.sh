@@ -0,0 +1 @@
+terraform import awsext_connect_hours_of_operation_override.christmas_eve "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222:33333333-4444-5555-6666-777777777777"
@@ -0,0 +1,10 @@
+resource "awsext_connect_hours_of_operation_override" "christmas_eve" {
+  instance_id           = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  hours_of_operation_id = "eeeeeeee-ffff-0000-1111-222222222222"
+
+  name           = "Christmas Eve 2026"
+  description    = "Closing early"
+  effective_from = "2026-12-24"
+  effective_till = "2026-12-24"
+  config         = provider::awsext::hours_of_operation_config("Thu 08:00-13:00", "America/New_York").config
+}
@@ -0,0 +1,5 @@
+# Instances can be imported by ID
+terraform import awsext_connect_instance.example "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+
+# or by ARN
+terraform import awsext_connect_instance.example "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111"
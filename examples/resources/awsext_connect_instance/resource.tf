@@ -0,0 +1,11 @@
+resource "awsext_connect_instance" "example" {
+  instance_alias           = "example-contact-center"
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  outbound_calls_enabled   = true
+  deletion_protection      = true
+
+  tags = {
+    environment = "production"
+  }
+}
@@ -0,0 +1,2 @@
+# Instance attributes can be imported by <instance_id>:<attribute_type>
+terraform import awsext_connect_instance_attribute.contact_lens "aaaaaaaa-bbbb-cccc-dddd-111111111111:CONTACT_LENS"
@@ -0,0 +1,11 @@
+resource "awsext_connect_instance_attribute" "contact_lens" {
+  instance_id    = awsext_connect_instance.example.instance_id
+  attribute_type = "CONTACT_LENS"
+  enabled        = true
+}
+
+resource "awsext_connect_instance_attribute" "early_media" {
+  instance_id    = awsext_connect_instance.example.instance_id
+  attribute_type = "EARLY_MEDIA"
+  enabled        = false
+}
@@ -0,0 +1,2 @@
+# Integration associations can be imported by ARN or by <instance_id>:<integration_association_id>
+terraform import awsext_connect_integration_association.voice_id "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
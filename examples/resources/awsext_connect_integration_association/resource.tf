@@ -0,0 +1,5 @@
+resource "awsext_connect_integration_association" "voice_id" {
+  instance_id      = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  integration_type = "VOICE_ID"
+  integration_arn  = "arn:aws:voiceid:us-east-1:123456789012:domain/abcdefghijklmnopqrstuv"
+}
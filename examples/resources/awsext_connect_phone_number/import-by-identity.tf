@@ -0,0 +1,6 @@
+import {
+  to = awsext_connect_phone_number.support
+  identity = {
+    arn = "arn:aws:connect:us-east-1:123456789012:phone-number/33333333-4444-5555-6666-777777777777"
+  }
+}
@@ -0,0 +1,5 @@
+# Phone numbers can be imported by their ID
+terraform import awsext_connect_phone_number.support "33333333-4444-5555-6666-777777777777"
+
+# or by ARN
+terraform import awsext_connect_phone_number.support "arn:aws:connect:us-east-1:123456789012:phone-number/33333333-4444-5555-6666-777777777777"
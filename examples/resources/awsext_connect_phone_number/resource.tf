@@ -0,0 +1,16 @@
+resource "awsext_connect_phone_number" "support" {
+  instance_id     = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  phone_number    = "+12065550100"
+  description     = "Support line"
+  contact_flow_id = "eeeeeeee-ffff-0000-1111-222222222222"
+
+  deletion_protection = true
+
+  tags = {
+    team = "support"
+  }
+
+  timeouts {
+    create = "10m"
+  }
+}
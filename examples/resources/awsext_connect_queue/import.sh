@@ -0,0 +1,5 @@
+# Queues can be imported by <instance_id>:<queue_id>
+terraform import awsext_connect_queue.example "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
+
+# or by ARN
+terraform import awsext_connect_queue.example "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/queue/eeeeeeee-ffff-0000-1111-222222222222"
@@ -0,0 +1,16 @@
+resource "awsext_connect_queue" "example" {
+  instance_id           = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  name                  = "Billing"
+  description           = "Billing enquiries"
+  hours_of_operation_id = "33333333-4444-5555-6666-777777777777"
+  max_contacts          = 50
+
+  outbound_caller_config = {
+    outbound_caller_id_name      = "Example Billing"
+    outbound_caller_id_number_id = "88888888-9999-0000-1111-222222222222"
+  }
+
+  tags = {
+    team = "billing"
+  }
+}
@@ -0,0 +1 @@
+terraform import awsext_connect_queue_outbound_caller_config.billing "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
@@ -0,0 +1,8 @@
+resource "awsext_connect_queue_outbound_caller_config" "billing" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  queue_id    = "eeeeeeee-ffff-0000-1111-222222222222"
+
+  outbound_caller_id_name      = "Example Billing"
+  outbound_caller_id_number_id = "33333333-4444-5555-6666-777777777777"
+  outbound_flow_id             = "88888888-9999-aaaa-bbbb-cccccccccccc"
+}
@@ -0,0 +1,2 @@
+# Importing by <instance_id>:<queue_id> manages all quick connects of the queue
+terraform import awsext_connect_queue_quick_connect_association.billing "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
@@ -0,0 +1,9 @@
+resource "awsext_connect_queue_quick_connect_association" "billing" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  queue_id    = "eeeeeeee-ffff-0000-1111-222222222222"
+
+  quick_connect_ids = [
+    "33333333-4444-5555-6666-777777777777",
+    "88888888-9999-0000-1111-222222222222",
+  ]
+}
@@ -0,0 +1,2 @@
+# Importing by instance ID and routing profile ID manages all users of the routing profile
+terraform import awsext_connect_routing_profile_user_association.billing "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
@@ -0,0 +1,11 @@
+resource "awsext_connect_routing_profile_user_association" "billing" {
+  instance_id        = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  routing_profile_id = "eeeeeeee-ffff-0000-1111-222222222222"
+
+  user_ids = [
+    for user in awsext_connect_users_bulk.agents.users : user.user_id
+  ]
+
+  # Users removed from the set return to the default routing profile
+  fallback_routing_profile_id = "33333333-4444-5555-6666-777777777777"
+}
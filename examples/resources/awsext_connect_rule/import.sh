@@ -0,0 +1,5 @@
+# Rules can be imported by <instance_id>:<rule_id>
+terraform import awsext_connect_rule.negative_sentiment "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
+
+# or by ARN
+terraform import awsext_connect_rule.negative_sentiment "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/rule/eeeeeeee-ffff-0000-1111-222222222222"
@@ -0,0 +1,29 @@
+resource "awsext_connect_rule" "negative_sentiment" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  name        = "Negative sentiment"
+
+  trigger_event_source = {
+    event_source_name = "OnPostCallAnalysisAvailable"
+  }
+
+  # Conditions in the rule function language, e.g. copied from the rule
+  # designer
+  function = file("${path.module}/rules/negative_sentiment.json")
+
+  task_actions = [{
+    name            = "Follow up on negative sentiment"
+    contact_flow_id = "eeeeeeee-ffff-0000-1111-222222222222"
+    references = {
+      Runbook = {
+        type  = "URL"
+        value = "https://wiki.example.com/contact-center/negative-sentiment"
+      }
+    }
+  }]
+
+  event_bridge_actions = [{
+    name = "negative-sentiment"
+  }]
+
+  assign_contact_category = true
+}
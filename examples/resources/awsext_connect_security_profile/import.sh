@@ -0,0 +1,5 @@
+# Security profiles can be imported by <instance_id>:<security_profile_id>
+terraform import awsext_connect_security_profile.example "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
+
+# or by ARN
+terraform import awsext_connect_security_profile.example "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/security-profile/eeeeeeee-ffff-0000-1111-222222222222"
@@ -0,0 +1,21 @@
+resource "awsext_connect_security_profile" "example" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  name        = "BillingAgent"
+  description = "Agents of the billing team"
+
+  permissions = [
+    "BasicAgentAccess",
+    "OutboundCallAccess",
+    "ContactSearchView",
+  ]
+
+  # Only give access to the contacts and queues of the billing team
+  allowed_access_control_tags = {
+    team = "billing"
+  }
+  tag_restricted_resources = ["Contact", "Queue"]
+
+  tags = {
+    team = "billing"
+  }
+}
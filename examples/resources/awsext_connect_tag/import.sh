@@ -0,0 +1,2 @@
+# Tags are imported by resource ARN and key, separated by a comma
+terraform import awsext_connect_tag.cost_center "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/queue/eeeeeeee-ffff-0000-1111-222222222222,CostCenter"
@@ -0,0 +1,5 @@
+resource "awsext_connect_tag" "cost_center" {
+  resource_arn = "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/queue/eeeeeeee-ffff-0000-1111-222222222222"
+  key          = "CostCenter"
+  value        = "1234"
+}
@@ -0,0 +1,5 @@
+# Hierarchy groups can be imported by <instance_id>:<hierarchy_group_id>
+terraform import awsext_connect_user_hierarchy_group.emea "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
+
+# or by ARN
+terraform import awsext_connect_user_hierarchy_group.emea "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/agent-group/eeeeeeee-ffff-0000-1111-222222222222"
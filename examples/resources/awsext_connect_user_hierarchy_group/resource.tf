@@ -0,0 +1,14 @@
+resource "awsext_connect_user_hierarchy_group" "emea" {
+  instance_id = awsext_connect_user_hierarchy_structure.main.instance_id
+  name        = "EMEA"
+
+  tags = {
+    team = "contact-center"
+  }
+}
+
+resource "awsext_connect_user_hierarchy_group" "dublin" {
+  instance_id     = awsext_connect_user_hierarchy_structure.main.instance_id
+  name            = "Dublin"
+  parent_group_id = awsext_connect_user_hierarchy_group.emea.hierarchy_group_id
+}
@@ -0,0 +1 @@
+terraform import awsext_connect_user_hierarchy_group_association.jane "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
@@ -0,0 +1,5 @@
+resource "awsext_connect_user_hierarchy_group_association" "jane" {
+  instance_id        = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  user_id            = "eeeeeeee-ffff-0000-1111-222222222222"
+  hierarchy_group_id = "33333333-4444-5555-6666-777777777777"
+}
@@ -0,0 +1,2 @@
+# The hierarchy structure is imported by instance ID
+terraform import awsext_connect_user_hierarchy_structure.main "aaaaaaaa-bbbb-cccc-dddd-111111111111"
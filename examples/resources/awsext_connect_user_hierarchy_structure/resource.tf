@@ -0,0 +1,4 @@
+resource "awsext_connect_user_hierarchy_structure" "main" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  levels      = ["Region", "Site", "Team"]
+}
@@ -0,0 +1,2 @@
+# Importing by instance ID manages all users of the instance
+terraform import awsext_connect_users_bulk.agents "aaaaaaaa-bbbb-cccc-dddd-111111111111"
@@ -0,0 +1,17 @@
+locals {
+  agents = csvdecode(file("${path.module}/agents.csv"))
+}
+
+resource "awsext_connect_users_bulk" "agents" {
+  instance_id = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+
+  users = {
+    for agent in local.agents : agent.username => {
+      first_name           = agent.first_name
+      last_name            = agent.last_name
+      email                = agent.email
+      routing_profile_id   = "eeeeeeee-ffff-0000-1111-222222222222"
+      security_profile_ids = ["33333333-4444-5555-6666-777777777777"]
+    }
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = awsext_connect_view.refund
+  identity = {
+    arn = "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/view/eeeeeeee-ffff-0000-1111-222222222222"
+  }
+}
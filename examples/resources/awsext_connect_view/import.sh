@@ -0,0 +1,5 @@
+# Views can be imported by <instance_id>:<view_id>
+terraform import awsext_connect_view.refund "aaaaaaaa-bbbb-cccc-dddd-111111111111:eeeeeeee-ffff-0000-1111-222222222222"
+
+# or by ARN
+terraform import awsext_connect_view.refund "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/view/eeeeeeee-ffff-0000-1111-222222222222"
@@ -0,0 +1,12 @@
+resource "awsext_connect_view" "refund" {
+  instance_id    = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+  name           = "Refund request"
+  description    = "Step-by-step guide for refund requests"
+  template       = file("${path.module}/views/refund.json")
+  actions        = ["Submit", "Cancel"]
+  create_version = true
+
+  tags = {
+    team = "contact-center"
+  }
+}
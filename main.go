@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/USAN/terraform-provider-awsext/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -37,6 +38,13 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Flush the spans of the last API calls once Terraform stops the provider
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if shutdownErr := provider.ShutdownTracing(ctx); shutdownErr != nil {
+		log.Printf("[WARN] Failed to export traces: %s", shutdownErr)
+	}
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// importOnExistsAttributeName is the name of the write-only attribute that
+// controls adoption of existing resources on create.
+const importOnExistsAttributeName = "import_on_exists"
+
+// importOnExistsAttribute returns the schema of the import_on_exists
+// attribute shared by all resources supporting adoption.
+func importOnExistsAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		WriteOnly:   true,
+		Description: "If the resource already exists, import it to the state instead of erroring. Defaults to the import_on_exists of the provider.",
+	}
+}
+
+// importOnExists reads the import_on_exists attribute from the config and
+// reports whether existing resources should be adopted. When the attribute is
+// not set, the provider's import_on_exists applies, which adopts by default.
+func (p *ProviderData) importOnExists(ctx context.Context, config tfsdk.Config) (bool, diag.Diagnostics) {
+	var value types.Bool
+
+	diags := config.GetAttribute(ctx, path.Root(importOnExistsAttributeName), &value)
+
+	if value.IsNull() || value.IsUnknown() {
+		return p.importOnExistsDefault, diags
+	}
+
+	return value.ValueBool(), diags
+}
+
+// adoption describes how a resource adopts an existing resource on create,
+// e.g. an agent status of the same name, instead of failing to create a
+// duplicate.
+type adoption[T any] struct {
+	// kind names the resources in diagnostics, e.g. Connect Agent Statuses.
+	kind string
+
+	// find looks up the existing resource, typically by name with
+	// findExisting.
+	find func(ctx context.Context) (T, bool, error)
+
+	// update updates the existing resource to the plan, including its tags,
+	// and sets the computed attributes of the model, e.g. its ID and ARN.
+	update func(ctx context.Context, existing T) diag.Diagnostics
+}
+
+// adopt adopts the existing resource, if any, then saves data and the
+// identity returned by identity, which is called after update. It reports
+// whether Create is done, because the resource was adopted or could not be
+// looked up or updated.
+func (a adoption[T]) adopt(ctx context.Context, resp *resource.CreateResponse, data any, identity func() any) bool {
+	existing, found, err := a.find(ctx)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error searching "+a.kind, "Could not search "+a.kind, err))
+		return true
+	}
+
+	if !found {
+		return false
+	}
+
+	resp.Diagnostics.Append(a.update(ctx, existing)...)
+
+	if resp.Diagnostics.HasError() {
+		return true
+	}
+
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, data, identity())...)
+
+	return true
+}
+
+// pageLister returns one page of a paginated list operation along with the
+// token of the next page, which is nil on the last page.
+type pageLister[S any] func(ctx context.Context, nextToken *string) ([]S, *string, error)
+
+// findExisting walks all pages of list and returns the first item for which
+// match returns true.
+func findExisting[S any](ctx context.Context, list pageLister[S], match func(S) bool) (S, bool, error) {
+	var nextToken *string
+
+	for {
+		items, next, err := list(ctx, nextToken)
+		if err != nil {
+			var zero S
+			return zero, false, err
+		}
+
+		for _, item := range items {
+			if match(item) {
+				return item, true, nil
+			}
+		}
+
+		if next == nil {
+			var zero S
+			return zero, false, nil
+		}
+
+		nextToken = next
+	}
+}
+
+// setStateAndIdentity saves the resource data and its identity, which must be
+// done together whenever a resource is created, adopted or read.
+func setStateAndIdentity(ctx context.Context, state *tfsdk.State, identity *tfsdk.ResourceIdentity, data any, identityData any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(state.Set(ctx, data)...)
+
+	if identity != nil {
+		diags.Append(identity.Set(ctx, identityData)...)
+	}
+
+	return diags
+}
@@ -3,20 +3,21 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/connect"
 	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,26 +26,60 @@ import (
 
 var _ resource.Resource = &AgentStatusResource{}
 var _ resource.ResourceWithImportState = &AgentStatusResource{}
+var _ resource.ResourceWithModifyPlan = &AgentStatusResource{}
+var _ resource.ResourceWithUpgradeState = &AgentStatusResource{}
+
+// agentStatusResourceType is the type of the agent status resource without
+// the provider prefix, e.g. in operation_policies.
+const agentStatusResourceType = "connect_agent_status"
+
+// Destroy behaviors of agent statuses, which cannot be deleted.
+const (
+	agentStatusOnDestroyNoop             = "noop"
+	agentStatusOnDestroyDisable          = "disable"
+	agentStatusOnDestroyDisableAndRename = "disable_and_rename"
+)
+
+// deletedAgentStatusPrefix prefixes the names of the agent statuses renamed on
+// destroy, sorting them last in the Connect console.
+const deletedAgentStatusPrefix = "zzz_deleted_"
+
+// defaultAgentStatusDisplayOrder is the display_order of agent statuses not
+// setting one.
+const defaultAgentStatusDisplayOrder = 1
+
+// agentStatusStateUpgrades migrates prior states of the agent status resource,
+// agentStatusStateUpgrades[v] upgrading a state of schema version v.
+var agentStatusStateUpgrades = []stateUpgrade{
+	// 0: description was Computed with a "" default, it is null when unset
+	nullEmptyString("description"),
+}
 
 func NewAgentStatusResource() resource.Resource {
 	return &AgentStatusResource{}
 }
 
 type AgentStatusResource struct {
-	config aws.Config
+	providerData *ProviderData
 }
 
 type AgentStatusResourceModel struct {
-	Arn            types.String `tfsdk:"arn"`
-	Description    types.String `tfsdk:"description"`
-	AgentStatusID  types.String `tfsdk:"agent_status_id"`
-	InstanceID     types.String `tfsdk:"instance_id"`
-	Name           types.String `tfsdk:"name"`
-	State          types.String `tfsdk:"state"`
-	DisplayOrder   types.Int32  `tfsdk:"display_order"`
-	ImportOnExists types.Bool   `tfsdk:"import_on_exists"`
-	// Tags          types.Map    `tfsdk:"tags"`
-	// TagsAll       types.Map    `tfsdk:"tags_all"`
+	Arn            types.String   `tfsdk:"arn"`
+	Description    NullableString `tfsdk:"description"`
+	AgentStatusID  types.String   `tfsdk:"agent_status_id"`
+	InstanceID     types.String   `tfsdk:"instance_id"`
+	InstanceAlias  types.String   `tfsdk:"instance_alias"`
+	Name           types.String   `tfsdk:"name"`
+	NamePrefix     types.String   `tfsdk:"name_prefix"`
+	State          types.String   `tfsdk:"state"`
+	DisplayOrder   types.Int32    `tfsdk:"display_order"`
+	ImportOnExists types.Bool     `tfsdk:"import_on_exists"`
+	Enforce        types.Bool     `tfsdk:"enforce"`
+	OnDestroy      types.String   `tfsdk:"on_destroy"`
+	Tags           types.Map      `tfsdk:"tags"`
+	TagsAll        types.Map      `tfsdk:"tags_all"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	Override       *OverrideModel `tfsdk:"override"`
 }
 
 type AgentStatusResourceIdentityModel struct {
@@ -52,26 +87,36 @@ type AgentStatusResourceIdentityModel struct {
 	AgentStatusID types.String `tfsdk:"agent_status_id"`
 }
 
+func (m AgentStatusResourceModel) identity() AgentStatusResourceIdentityModel {
+	return AgentStatusResourceIdentityModel{
+		Arn:           m.Arn,
+		AgentStatusID: m.AgentStatusID,
+	}
+}
+
+// summary returns the agent status of the model as listed, for the name index
+// of its instance.
+func (m AgentStatusResourceModel) summary() conntypes.AgentStatusSummary {
+	return conntypes.AgentStatusSummary{
+		Id:   aws.String(m.AgentStatusID.ValueString()),
+		Arn:  aws.String(m.Arn.ValueString()),
+		Name: aws.String(m.Name.ValueString()),
+		Type: conntypes.AgentStatusTypeCustom,
+	}
+}
+
 func (r *AgentStatusResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_connect_agent_status"
+	resp.TypeName = req.ProviderTypeName + "_" + agentStatusResourceType
 }
 
 func (r *AgentStatusResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
-	resp.IdentitySchema = identityschema.Schema{
-		Attributes: map[string]identityschema.Attribute{
-			"arn": identityschema.StringAttribute{
-				OptionalForImport: true,
-			},
-			"agent_status_id": identityschema.StringAttribute{
-				RequiredForImport: true,
-			},
-		},
-	}
+	resp.IdentitySchema = connectIdentitySchema("agent_status_id")
 }
 
 func (r *AgentStatusResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Connect agent status resource",
+		Version:             schemaVersion(agentStatusStateUpgrades),
 
 		Attributes: map[string]schema.Attribute{
 			"arn": schema.StringAttribute{
@@ -81,11 +126,11 @@ func (r *AgentStatusResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"description": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
-				Default:  stringdefault.StaticString(""),
+				CustomType: NullableStringType{},
+				Optional:   true,
 				Validators: []validator.String{
-					stringvalidator.LengthBetween(1, 250),
+					// Empty is allowed and the same as no description
+					stringvalidator.LengthAtMost(250),
 				},
 			},
 			"agent_status_id": schema.StringAttribute{
@@ -95,14 +140,17 @@ func (r *AgentStatusResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"instance_id": schema.StringAttribute{
-				Required: true,
-			},
-			"name": schema.StringAttribute{
-				Required: true,
-				Validators: []validator.String{
-					stringvalidator.LengthBetween(1, 127),
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"instance_alias": instanceAliasAttribute(),
+			"name":           nameAttribute(127),
+			"name_prefix":    namePrefixAttribute(127),
 			"state": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
@@ -112,63 +160,120 @@ func (r *AgentStatusResource) Schema(ctx context.Context, req resource.SchemaReq
 			"display_order": schema.Int32Attribute{
 				Optional: true,
 				Computed: true,
-				Default:  int32default.StaticInt32(1),
+				Default:  int32default.StaticInt32(defaultAgentStatusDisplayOrder),
 				Validators: []validator.Int32{
 					int32validator.Between(1, 50),
 				},
 			},
-			"import_on_exists": schema.BoolAttribute{
+			"import_on_exists": importOnExistsAttribute(),
+			"enforce":          enforceAttribute(),
+			"on_destroy": schema.StringAttribute{
 				Optional:    true,
-				WriteOnly:   true,
-				Description: "If the resource already exists, import it to the state instead of erroring.",
+				Description: "What destroying the resource does, as agent statuses cannot be deleted: noop (the default) only removes it from the state, disable disables it, and disable_and_rename also prefixes its name with zzz_deleted_ so the teardown shows in the Connect console.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(agentStatusOnDestroyNoop, agentStatusOnDestroyDisable, agentStatusOnDestroyDisableAndRename),
+				},
 			},
-			// Unsupported by the API
-			// "tags": schema.MapAttribute{
-			// 	Optional: true,
-			// 	Elem:     &schema.Schema{Type: schema.TypeString},
-			// },
-			// "tags_all": schema.MapAttribute{
-			// 	Optional: true,
-			// 	Computed: true,
-			// 	Elem:     &schema.Schema{Type: schema.TypeString},
-			// },
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
 		},
 	}
 }
 
+func (r *AgentStatusResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(agentStatusStateUpgrades)
+}
+
 func (r *AgentStatusResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	config, ok := req.ProviderData.(aws.Config)
+	providerData, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *aws.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.config = config
+	r.providerData = providerData
+}
+
+func (r *AgentStatusResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanTags(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+	modifyPlanDrift(ctx, req, resp)
 }
 
 func (r *AgentStatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(agentStatusResourceType, resp) {
+		return
+	}
+
 	var data AgentStatusResourceModel
-	var importOnExists types.Bool
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("import_on_exists"), &importOnExists)...)
+
+	adopt, diags := r.providerData.importOnExists(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	tagsAll, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	conn := connect.NewFromConfig(r.config)
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(agentStatusResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.agentStatusClient(data.Override)
+
+	if data.Name.IsUnknown() {
+		prefix := defaultNamePrefix
+		if !data.NamePrefix.IsUnknown() && !data.NamePrefix.IsNull() {
+			prefix = data.NamePrefix.ValueString()
+		}
+
+		statuses, err := collectPages(ctx, searchAgentStatuses(conn, data.InstanceID.ValueString(), &conntypes.AgentStatusSearchCriteria{
+			StringCondition: stringCondition("name", conntypes.StringComparisonTypeStartsWith, prefix),
+		}), 0, nil)
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error searching Connect Agent Statuses", "Could not search Connect Agent Statuses", err))
+			return
+		}
+
+		data.Name = types.StringValue(uniqueName(prefix, func(name string) bool {
+			return slices.ContainsFunc(statuses, func(status conntypes.AgentStatus) bool {
+				return aws.ToString(status.Name) == name
+			})
+		}))
+
+		// Generated names are new, so there is nothing to adopt
+		adopt = false
+	}
+
+	if data.NamePrefix.IsUnknown() {
+		data.NamePrefix = types.StringNull()
+	}
+
 	input := &connect.CreateAgentStatusInput{
 		InstanceId:  aws.String(data.InstanceID.ValueString()),
 		Name:        aws.String(data.Name.ValueString()),
@@ -176,81 +281,116 @@ func (r *AgentStatusResource) Create(ctx context.Context, req resource.CreateReq
 		Description: aws.String(data.Description.ValueString()),
 	}
 
+	if len(tagsAll) > 0 {
+		input.Tags = tagsAll
+	}
+
 	if input.State == conntypes.AgentStatusStateEnabled {
 		input.DisplayOrder = data.DisplayOrder.ValueInt32Pointer()
 	}
 
-	if importOnExists.IsNull() || importOnExists.IsUnknown() || importOnExists.ValueBool() {
-		var nextToken *string
-		nextToken = nil
-		for {
-			listInput := &connect.ListAgentStatusesInput{
-				InstanceId: aws.String(data.InstanceID.ValueString()),
-				NextToken:  nextToken,
-			}
-
-			listResponse, listErr := conn.ListAgentStatuses(ctx, listInput)
-			if listErr != nil {
-				resp.Diagnostics.AddError("Error listing Connect Agent Statuses", fmt.Sprintf("Could not list Connect Agent Statuses, unexpected error: %s", listErr))
-				break
-			}
-
-			for _, status := range listResponse.AgentStatusSummaryList {
-				if aws.ToString(status.Name) == data.Name.ValueString() {
-					data.AgentStatusID = types.StringValue(aws.ToString(status.Id))
-					data.Arn = types.StringValue(aws.ToString(status.Arn))
-					tflog.Info(ctx, fmt.Sprintf("Imported Connect Agent Status with ID %s, updating...", data.AgentStatusID.ValueString()))
-
-					updateErr := updateAgentStatus(ctx, data, conn)
-					if updateErr != nil {
-						resp.Diagnostics.AddError("Error updating Connect Agent Status", fmt.Sprintf("Could not update Connect Agent Status, unexpected error: %s", updateErr))
-					}
-
-					// Save data into Terraform state
-					resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-
-					identity := AgentStatusResourceIdentityModel{
-						Arn:           data.Arn,
-						AgentStatusID: data.AgentStatusID,
-					}
-
-					// Save identity data into Terraform state
-					resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
-
-					return
+	if !enforcedValue(data.Enforce) {
+		r.observe(ctx, data, conn, resp)
+		return
+	}
+
+	if adopt {
+		adoption := adoption[conntypes.AgentStatusSummary]{
+			kind: "Connect Agent Statuses",
+			find: func(ctx context.Context) (conntypes.AgentStatusSummary, bool, error) {
+				return r.providerData.findAgentStatusByName(ctx, conn, data.Override, data.InstanceID.ValueString(), data.Name.ValueString())
+			},
+			update: func(ctx context.Context, status conntypes.AgentStatusSummary) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				data.AgentStatusID = types.StringValue(aws.ToString(status.Id))
+				data.Arn = types.StringValue(aws.ToString(status.Arn))
+				tflog.Info(ctx, fmt.Sprintf("Imported Connect Agent Status with ID %s, updating...", data.AgentStatusID.ValueString()))
+
+				r.providerData.invalidateDescribe(data.Arn.ValueString())
+				r.providerData.snapshots.forget(r.providerData.agentStatusSnapshotKey(data.Override, data.InstanceID.ValueString()))
+
+				if err := updateAgentStatus(ctx, data, conn); err != nil {
+					diags.Append(apiError("Error updating Connect Agent Status", "Could not update Connect Agent Status", err))
+					return diags
+				}
+
+				existingTags, err := connectResourceTags(ctx, conn, data.Arn.ValueString())
+				if err == nil {
+					err = updateConnectTags(ctx, conn, data.Arn.ValueString(), ignoreTags(existingTags, r.providerData.IgnoreTags), tagsAll)
 				}
-			}
 
-			nextToken = listResponse.NextToken
+				if err != nil {
+					diags.Append(apiError("Error updating Connect Agent Status tags", "Could not update Connect Agent Status tags", err))
+				}
 
-			if nextToken == nil {
-				break
-			}
+				return diags
+			},
+		}
+
+		if adoption.adopt(ctx, resp, &data, func() any { return data.identity() }) {
+			return
 		}
 	}
 
 	response, err := conn.CreateAgentStatus(ctx, input)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Connect Agent Status", fmt.Sprintf("Could not create Connect Agent Status, unexpected error: %s", err))
+		resp.Diagnostics.Append(apiError("Error creating Connect Agent Status", "Could not create Connect Agent Status", err))
 		return
 	}
 
 	tflog.Trace(ctx, "created a resource")
+	r.providerData.snapshots.forget(r.providerData.agentStatusSnapshotKey(data.Override, data.InstanceID.ValueString()))
 
 	data.AgentStatusID = types.StringValue(aws.ToString(response.AgentStatusId))
 	data.Arn = types.StringValue(aws.ToString(response.AgentStatusARN))
+	r.providerData.recordAgentStatusName(data.Override, data.InstanceID.ValueString(), data.summary())
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
 
-	identity := AgentStatusResourceIdentityModel{
-		Arn:           data.Arn,
-		AgentStatusID: data.AgentStatusID,
+// observe adds an existing agent status to the state without changing it in
+// AWS, when enforce is false. Drift is reported by the next plan.
+func (r *AgentStatusResource) observe(ctx context.Context, data AgentStatusResourceModel, conn AgentStatusAPI, resp *resource.CreateResponse) {
+	status, found, err := r.providerData.findAgentStatusByName(ctx, conn, data.Override, data.InstanceID.ValueString(), data.Name.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error searching Connect Agent Statuses", "Could not search Connect Agent Statuses", err))
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(enforceAttributeName),
+			"Connect Agent Status Not Found",
+			fmt.Sprintf("Agent status %s does not exist in Connect instance %s. Resources are not created when enforce is false.", data.Name.ValueString(), data.InstanceID.ValueString()),
+		)
+		return
 	}
 
-	// Save identity data into Terraform state
-	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	data.AgentStatusID = types.StringValue(aws.ToString(status.Id))
+	data.Arn = types.StringValue(aws.ToString(status.Arn))
+	tflog.Info(ctx, fmt.Sprintf("Observing Connect Agent Status with ID %s", data.AgentStatusID.ValueString()))
+
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+// listAgentStatuses returns a lister of the agent statuses of an instance.
+func listAgentStatuses(conn AgentStatusAPI, instanceID string) pageLister[conntypes.AgentStatusSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.AgentStatusSummary, *string, error) {
+		response, err := conn.ListAgentStatuses(ctx, &connect.ListAgentStatusesInput{
+			InstanceId: aws.String(instanceID),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.AgentStatusSummaryList, response.NextToken, nil
+	}
 }
 
 func (r *AgentStatusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -263,68 +403,214 @@ func (r *AgentStatusResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	var identity AgentStatusResourceIdentityModel
-	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(agentStatusResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
 
-	conn := connect.NewFromConfig(r.config)
-	input := &connect.DescribeAgentStatusInput{
-		AgentStatusId: aws.String(data.AgentStatusID.ValueString()),
-		InstanceId:    aws.String(data.InstanceID.ValueString()),
-	}
+	conn := r.providerData.agentStatusClient(data.Override)
+	status, err := r.readAgentStatus(ctx, conn, data)
 
-	response, err := conn.DescribeAgentStatus(ctx, input)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Connect Agent Status", fmt.Sprintf("Could not read Connect Agent Status, unexpected error: %s", err))
+		resp.Diagnostics.Append(apiError("Error reading Connect Agent Status", "Could not read Connect Agent Status", err))
 		return
 	}
 
-	if response == nil || response.AgentStatus == nil {
+	if status == nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	data.AgentStatusID = types.StringValue(aws.ToString(response.AgentStatus.AgentStatusId))
-	data.Arn = types.StringValue(aws.ToString(response.AgentStatus.AgentStatusARN))
-	data.Description = types.StringValue(aws.ToString(response.AgentStatus.Description))
-	data.Name = types.StringValue(aws.ToString(response.AgentStatus.Name))
-	data.State = types.StringValue(string(response.AgentStatus.State))
-	if response.AgentStatus.State == conntypes.AgentStatusStateEnabled && response.AgentStatus.DisplayOrder != nil {
-		data.DisplayOrder = types.Int32Value(aws.ToInt32(response.AgentStatus.DisplayOrder))
+	resp.Diagnostics.Append(data.flatten(ctx, r.providerData, status)...)
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+// readAgentStatus returns the agent status of the model, or nil if it does not
+// exist. With batch_refresh, it is read from the snapshot of all agent
+// statuses of the instance instead of being described.
+func (r *AgentStatusResource) readAgentStatus(ctx context.Context, conn AgentStatusAPI, data AgentStatusResourceModel) (*conntypes.AgentStatus, error) {
+	if r.providerData.batchRefresh {
+		statuses, err := r.providerData.agentStatusSnapshot(ctx, conn, data.Override, data.InstanceID.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		status, ok := statuses[data.AgentStatusID.ValueString()]
+		if !ok {
+			return nil, nil
+		}
+
+		return &status, nil
 	}
-	// data.Tags = types.MapValueFrom(context.Background(), types.StringType, response.AgentStatus.Tags)
-	// data.TagsAll = types.MapValueFrom(context.Background
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	response, err := cachedDescribe(r.providerData, data.Arn.ValueString(), func() (*connect.DescribeAgentStatusOutput, error) {
+		return conn.DescribeAgentStatus(ctx, &connect.DescribeAgentStatusInput{
+			AgentStatusId: aws.String(data.AgentStatusID.ValueString()),
+			InstanceId:    aws.String(data.InstanceID.ValueString()),
+		})
+	})
+	if err != nil || response == nil {
+		return nil, err
+	}
+
+	return response.AgentStatus, nil
+}
+
+// agentStatusSnapshotKey is the key of the snapshot of the agent statuses of
+// an instance, in the region and with the role of the override block.
+func (p *ProviderData) agentStatusSnapshotKey(override *OverrideModel, instanceID string) string {
+	return p.snapshotKey(agentStatusResourceType, override, instanceID)
+}
+
+// snapshotKey is the key of the snapshot of the resources of resourceType of
+// an instance, in the region and with the role of the override block.
+func (p *ProviderData) snapshotKey(resourceType string, override *OverrideModel, instanceID string) string {
+	key := resourceType + "/" + p.awsConfig(override).Region + "/" + instanceID
+	if override != nil {
+		key += "/" + override.RoleArn.ValueString()
+	}
+
+	return key
+}
+
+// agentStatusSnapshot returns all agent statuses of an instance by ID, from a
+// single search shared by the Reads of all agent statuses of the instance.
+func (p *ProviderData) agentStatusSnapshot(ctx context.Context, conn AgentStatusAPI, override *OverrideModel, instanceID string) (map[string]conntypes.AgentStatus, error) {
+	snapshot, err := p.snapshots.get(p.agentStatusSnapshotKey(override, instanceID), func() (any, error) {
+		statuses, err := collectPages(ctx, searchAgentStatuses(conn, instanceID, nil), 0, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		byID := make(map[string]conntypes.AgentStatus, len(statuses))
+		for _, status := range statuses {
+			byID[aws.ToString(status.AgentStatusId)] = status
+		}
+
+		return byID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot.(map[string]conntypes.AgentStatus), nil
+}
+
+// flatten sets the model from an agent status returned by the API.
+func (m *AgentStatusResourceModel) flatten(ctx context.Context, providerData *ProviderData, status *conntypes.AgentStatus) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.AgentStatusID = types.StringValue(aws.ToString(status.AgentStatusId))
+	m.Arn = types.StringValue(aws.ToString(status.AgentStatusARN))
+	m.Description = flattenNullableString(ctx, m.Description, status.Description)
+	m.Name = types.StringValue(aws.ToString(status.Name))
+	m.State = types.StringValue(string(status.State))
+	m.DisplayOrder = flattenDisplayOrder(m.DisplayOrder, *status)
+
+	// Imported statuses have no enforce in state, set to its default so the
+	// generated configuration plans no change
+	if m.Enforce.IsNull() || m.Enforce.IsUnknown() {
+		m.Enforce = types.BoolValue(true)
+	}
+
+	m.Tags, m.TagsAll, diags = providerData.readTags(ctx, status.Tags, m.Tags)
+
+	return diags
+}
+
+// flattenDisplayOrder returns the display_order of an agent status returned by
+// the API. The API omits the display order of disabled statuses, so the prior
+// value is kept for them, or the default when there is none, e.g. on import,
+// which keeps generated configuration free of changes.
+func flattenDisplayOrder(prior types.Int32, status conntypes.AgentStatus) types.Int32 {
+	if status.State == conntypes.AgentStatusStateEnabled && status.DisplayOrder != nil {
+		return types.Int32Value(aws.ToInt32(status.DisplayOrder))
+	}
+
+	if prior.IsNull() || prior.IsUnknown() {
+		return types.Int32Value(defaultAgentStatusDisplayOrder)
+	}
+
+	return prior
 }
 
 func (r *AgentStatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data AgentStatusResourceModel
+	if r.providerData.readOnlyUpdate(ctx, agentStatusResourceType, req, resp) {
+		return
+	}
 
-	// Read Terraform plan data into the model
+	var data, state AgentStatusResourceModel
+
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	conn := connect.NewFromConfig(r.config)
-	err := updateAgentStatus(ctx, data, conn)
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(agentStatusResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
 
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating Connect Agent Status", fmt.Sprintf("Could not update Connect Agent Status, unexpected error: %s", err))
+	if !enforcedValue(data.Enforce) {
+		// Observe-only: drift was reported at plan time
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
 
+	conn := r.providerData.agentStatusClient(data.Override)
+	r.providerData.invalidateDescribe(data.Arn.ValueString())
+	r.providerData.snapshots.forget(r.providerData.agentStatusSnapshotKey(data.Override, data.InstanceID.ValueString()))
+
+	if agentStatusChanged(data, state) {
+		if err := updateAgentStatus(ctx, data, conn); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Agent Status", "Could not update Connect Agent Status", err))
+			return
+		}
+
+		r.providerData.recordAgentStatusName(data.Override, data.InstanceID.ValueString(), data.summary())
+	} else {
+		tflog.Debug(ctx, "Skipping UpdateAgentStatus as no updatable attribute changed")
+	}
+
+	if !data.TagsAll.Equal(state.TagsAll) {
+		oldTags, diags := mapFromTags(ctx, state.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		newTags, diags := mapFromTags(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), oldTags, newTags); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Agent Status tags", "Could not update Connect Agent Status tags", err))
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func updateAgentStatus(ctx context.Context, data AgentStatusResourceModel, conn *connect.Client) error {
+// agentStatusChanged reports whether the plan changes an attribute sent by
+// updateAgentStatus, as opposed to e.g. tags, timeouts or write-only
+// attributes, so no-op updates do not call the API.
+func agentStatusChanged(plan, state AgentStatusResourceModel) bool {
+	return !plan.Name.Equal(state.Name) ||
+		!plan.State.Equal(state.State) ||
+		plan.Description.ValueString() != state.Description.ValueString() ||
+		(plan.State.ValueString() == string(conntypes.AgentStatusStateEnabled) && !plan.DisplayOrder.Equal(state.DisplayOrder))
+}
+
+func updateAgentStatus(ctx context.Context, data AgentStatusResourceModel, conn AgentStatusAPI) error {
 	input := &connect.UpdateAgentStatusInput{
 		AgentStatusId: aws.String(data.AgentStatusID.ValueString()),
 		InstanceId:    aws.String(data.InstanceID.ValueString()),
@@ -343,6 +629,10 @@ func updateAgentStatus(ctx context.Context, data AgentStatusResourceModel, conn
 }
 
 func (r *AgentStatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(agentStatusResourceType, resp) {
+		return
+	}
+
 	var data AgentStatusResourceModel
 
 	// Read Terraform prior state data into the model
@@ -352,24 +642,97 @@ func (r *AgentStatusResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	// Unsupported by the API
-	// conn := connect.NewFromConfig(r.config)
-	// input := &connect.DeleteAgentStatusInput{
-	// 	AgentStatusId: aws.String(data.AgentStatusID.ValueString()),
-	// 	InstanceId:    aws.String(data.InstanceID.ValueString()),
-	// 	Name:          aws.String(data.Name.ValueString()),
-	// 	State:         connect.AgentStatusState(data.State.ValueString()),
-	// 	Description:   aws.String(data.Description.ValueString()),
-	// }
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(agentStatusResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
 
-	// _, err := conn.DeleteAgentStatus(ctx, input)
+	// Agent statuses cannot be deleted, and observed ones are left as is
+	onDestroy := data.OnDestroy.ValueString()
+	if onDestroy == "" || onDestroy == agentStatusOnDestroyNoop || !enforcedValue(data.Enforce) {
+		return
+	}
+
+	conn := r.providerData.agentStatusClient(data.Override)
+	input := &connect.UpdateAgentStatusInput{
+		AgentStatusId: aws.String(data.AgentStatusID.ValueString()),
+		InstanceId:    aws.String(data.InstanceID.ValueString()),
+		State:         conntypes.AgentStatusStateDisabled,
+	}
+
+	if onDestroy == agentStatusOnDestroyDisableAndRename {
+		name, err := r.deletedAgentStatusName(ctx, conn, data)
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error searching Connect Agent Statuses", "Could not search Connect Agent Statuses", err))
+			return
+		}
+
+		input.Name = aws.String(name)
+	}
 
-	// if err != nil {
-	// 	resp.Diagnostics.AddError("Error deleting Connect Agent Status", fmt.Sprintf("Could not delete Connect Agent Status, unexpected error: %s", err))
-	// 	return
-	// }
+	_, err := conn.UpdateAgentStatus(ctx, input)
+
+	r.providerData.invalidateDescribe(data.Arn.ValueString())
+	r.providerData.snapshots.forget(r.providerData.agentStatusSnapshotKey(data.Override, data.InstanceID.ValueString()))
+
+	if err == nil && input.Name != nil {
+		data.Name = types.StringValue(aws.ToString(input.Name))
+		r.providerData.recordAgentStatusName(data.Override, data.InstanceID.ValueString(), data.summary())
+	}
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error updating Connect Agent Status", "Could not disable Connect Agent Status", err))
+	}
+}
+
+// deletedAgentStatusName returns the name of an agent status renamed on
+// destroy: its name with deletedAgentStatusPrefix, followed by the start of
+// its ID if a status destroyed before already has that name.
+func (r *AgentStatusResource) deletedAgentStatusName(ctx context.Context, conn AgentStatusAPI, data AgentStatusResourceModel) (string, error) {
+	name := truncateRunes(deletedAgentStatusPrefix+data.Name.ValueString(), 127)
+
+	status, found, err := r.providerData.findAgentStatusByName(ctx, conn, data.Override, data.InstanceID.ValueString(), name)
+	if err != nil || !found || aws.ToString(status.Id) == data.AgentStatusID.ValueString() {
+		return name, err
+	}
+
+	return deletedAgentStatusNameWithID(data.Name.ValueString(), data.AgentStatusID.ValueString()), nil
+}
+
+// deletedAgentStatusNameWithID returns the name of an agent status renamed on
+// destroy followed by the start of its ID, unique among the statuses.
+func deletedAgentStatusNameWithID(name, agentStatusID string) string {
+	suffix := "_" + agentStatusID
+	if len(suffix) > 9 {
+		suffix = suffix[:9]
+	}
+
+	return truncateRunes(deletedAgentStatusPrefix+name, 127-len(suffix)) + suffix
 }
 
 func (r *AgentStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importConnectResourceByName(ctx, req, resp, "agent-state", "agent_status_id", func(ctx context.Context, instanceID string, name string) (string, string, bool, error) {
+		conn := r.providerData.agentStatusClient(nil)
+
+		status, found, err := r.providerData.findAgentStatusByName(ctx, conn, nil, instanceID, name)
+
+		return aws.ToString(status.Id), aws.ToString(status.Arn), found, err
+	})
+}
+
+// searchAgentStatuses returns a lister of the agent statuses of an instance
+// matching criteria, filtered by the API instead of listing all statuses.
+func searchAgentStatuses(conn AgentStatusAPI, instanceID string, criteria *conntypes.AgentStatusSearchCriteria) pageLister[conntypes.AgentStatus] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.AgentStatus, *string, error) {
+		response, err := conn.SearchAgentStatuses(ctx, &connect.SearchAgentStatusesInput{
+			InstanceId:     aws.String(instanceID),
+			MaxResults:     aws.Int32(searchPageSize),
+			NextToken:      nextToken,
+			SearchCriteria: criteria,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.AgentStatuses, response.NextToken, nil
+	}
 }
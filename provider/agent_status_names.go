@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+)
+
+// agentStatusNames indexes the agent statuses of an instance by name. It is
+// listed once per run and shared by the name lookups of all agent statuses of
+// the instance, e.g. when adopting dozens of them, instead of each searching
+// the instance. Writes update the index in place rather than dropping it, so
+// creating many statuses does not list them all again after each create.
+type agentStatusNames struct {
+	mu     sync.Mutex
+	byName map[string]conntypes.AgentStatusSummary
+}
+
+// find returns the agent status with the given name.
+func (n *agentStatusNames) find(name string) (conntypes.AgentStatusSummary, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	status, ok := n.byName[name]
+
+	return status, ok
+}
+
+// record indexes status by its name, dropping its previous name if it was
+// renamed.
+func (n *agentStatusNames) record(status conntypes.AgentStatusSummary) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for name, existing := range n.byName {
+		if aws.ToString(existing.Id) == aws.ToString(status.Id) {
+			delete(n.byName, name)
+		}
+	}
+
+	n.byName[aws.ToString(status.Name)] = status
+}
+
+// agentStatusNamesKey is the key of the name index of the agent statuses of
+// an instance, in the region and with the role of the override block.
+func (p *ProviderData) agentStatusNamesKey(override *OverrideModel, instanceID string) string {
+	return p.agentStatusSnapshotKey(override, instanceID) + "/names"
+}
+
+// findAgentStatusByName returns the agent status of an instance with the
+// given name, from the name index of the instance.
+func (p *ProviderData) findAgentStatusByName(ctx context.Context, conn AgentStatusAPI, override *OverrideModel, instanceID string, name string) (conntypes.AgentStatusSummary, bool, error) {
+	key := p.agentStatusNamesKey(override, instanceID)
+
+	index, err := p.snapshots.get(key, func() (any, error) {
+		statuses, err := collectPages(ctx, listAgentStatuses(conn, instanceID), 0, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		names := &agentStatusNames{byName: make(map[string]conntypes.AgentStatusSummary, len(statuses))}
+		for _, status := range statuses {
+			names.byName[aws.ToString(status.Name)] = status
+		}
+
+		return names, nil
+	})
+	if err != nil {
+		return conntypes.AgentStatusSummary{}, false, err
+	}
+
+	status, found := index.(*agentStatusNames).find(name)
+
+	return status, found, nil
+}
+
+// recordAgentStatusName updates the name index of the instance of a created
+// or renamed agent status, if the index was listed.
+func (p *ProviderData) recordAgentStatusName(override *OverrideModel, instanceID string, status conntypes.AgentStatusSummary) {
+	if index, ok := p.snapshots.peek(p.agentStatusNamesKey(override, instanceID)); ok {
+		index.(*agentStatusNames).record(status)
+	}
+}
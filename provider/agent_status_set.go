@@ -0,0 +1,434 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &AgentStatusSetResource{}
+var _ resource.ResourceWithImportState = &AgentStatusSetResource{}
+var _ resource.ResourceWithModifyPlan = &AgentStatusSetResource{}
+
+// agentStatusSetResourceType is the type of the agent status set resource
+// without the provider prefix, e.g. in operation_policies.
+const agentStatusSetResourceType = "connect_agent_status_set"
+
+func NewAgentStatusSetResource() resource.Resource {
+	return &AgentStatusSetResource{}
+}
+
+// AgentStatusSetResource manages all custom agent statuses of an instance:
+// enabled custom statuses missing from the configuration are disabled, as
+// agent statuses cannot be deleted.
+type AgentStatusSetResource struct {
+	providerData *ProviderData
+}
+
+type AgentStatusSetResourceModel struct {
+	InstanceID    types.String                   `tfsdk:"instance_id"`
+	InstanceAlias types.String                   `tfsdk:"instance_alias"`
+	Statuses      map[string]AgentStatusSetEntry `tfsdk:"statuses"`
+	Timeouts      timeouts.Value                 `tfsdk:"timeouts"`
+	Override      *OverrideModel                 `tfsdk:"override"`
+}
+
+type AgentStatusSetEntry struct {
+	AgentStatusID types.String   `tfsdk:"agent_status_id"`
+	Arn           types.String   `tfsdk:"arn"`
+	Description   NullableString `tfsdk:"description"`
+	State         types.String   `tfsdk:"state"`
+	DisplayOrder  types.Int32    `tfsdk:"display_order"`
+}
+
+func (r *AgentStatusSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + agentStatusSetResourceType
+}
+
+func (r *AgentStatusSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete set of custom agent statuses of a Connect instance, keyed by name. Enabled custom statuses missing from the set are disabled. Do not combine with awsext_connect_agent_status resources of the same instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"statuses": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Custom agent statuses by name.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 127)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"agent_status_id": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"arn": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"description": schema.StringAttribute{
+							CustomType: NullableStringType{},
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(250),
+							},
+						},
+						"state": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("ENABLED", "DISABLED"),
+							},
+						},
+						"display_order": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int32default.StaticInt32(defaultAgentStatusDisplayOrder),
+							Validators: []validator.Int32{
+								int32validator.Between(1, 50),
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *AgentStatusSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *AgentStatusSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *AgentStatusSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(agentStatusSetResourceType, resp) {
+		return
+	}
+
+	var data AgentStatusSetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(agentStatusSetResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(agentStatusSetResourceType, data.Override)
+	existing, err := customAgentStatuses(ctx, conn, data.InstanceID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error searching Connect Agent Statuses", "Could not search Connect Agent Statuses", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, conn, &data, existing)...)
+
+	// Save the statuses reconciled, even on error, into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgentStatusSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, agentStatusSetResourceType, req, resp) {
+		return
+	}
+
+	var data AgentStatusSetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(agentStatusSetResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(agentStatusSetResourceType, data.Override)
+	existing, err := customAgentStatuses(ctx, conn, data.InstanceID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error searching Connect Agent Statuses", "Could not search Connect Agent Statuses", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, conn, &data, existing)...)
+
+	// Save the statuses reconciled, even on error, into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// reconcile creates the planned statuses missing from the existing ones,
+// updates the drifted ones and disables the other enabled custom statuses.
+// The statuses of the model are replaced with the planned statuses that were
+// reconciled, so they can be saved even on error.
+func (r *AgentStatusSetResource) reconcile(ctx context.Context, conn AgentStatusAPI, data *AgentStatusSetResourceModel, existing map[string]conntypes.AgentStatus) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Statuses, diags = r.providerData.reconcileAgentStatuses(ctx, conn, data.InstanceID.ValueString(), data.Override, data.Statuses, slices.Sorted(maps.Keys(data.Statuses)), existing, true)
+
+	return diags
+}
+
+// reconcileAgentStatuses creates the planned statuses missing from the
+// existing ones and updates the drifted ones, in the order of names, then
+// disables the other enabled custom statuses if disableUnmanaged is true. It
+// returns the planned statuses that were reconciled, so they can be saved even
+// on error.
+func (p *ProviderData) reconcileAgentStatuses(ctx context.Context, conn AgentStatusAPI, instanceID string, override *OverrideModel, planned map[string]AgentStatusSetEntry, names []string, existing map[string]conntypes.AgentStatus, disableUnmanaged bool) (map[string]AgentStatusSetEntry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	defer p.snapshots.forget(p.agentStatusSnapshotKey(override, instanceID))
+
+	reconciled := make(map[string]AgentStatusSetEntry, len(planned))
+
+	for _, name := range names {
+		entry := planned[name]
+		model := entry.model(instanceID, name)
+
+		status, ok := existing[name]
+		if !ok {
+			input := &connect.CreateAgentStatusInput{
+				InstanceId:  aws.String(instanceID),
+				Name:        aws.String(name),
+				State:       conntypes.AgentStatusState(entry.State.ValueString()),
+				Description: aws.String(entry.Description.ValueString()),
+			}
+
+			if input.State == conntypes.AgentStatusStateEnabled {
+				input.DisplayOrder = entry.DisplayOrder.ValueInt32Pointer()
+			}
+
+			response, err := conn.CreateAgentStatus(ctx, input)
+			if err != nil {
+				diags.Append(apiError("Error creating Connect Agent Status", fmt.Sprintf("Could not create Connect Agent Status %s", name), err))
+				return reconciled, diags
+			}
+
+			entry.AgentStatusID = types.StringValue(aws.ToString(response.AgentStatusId))
+			entry.Arn = types.StringValue(aws.ToString(response.AgentStatusARN))
+			reconciled[name] = entry
+
+			// Statuses are matched by name, so only creates change the names
+			// of the instance
+			p.recordAgentStatusName(override, instanceID, entry.model(instanceID, name).summary())
+
+			continue
+		}
+
+		entry.AgentStatusID = types.StringValue(aws.ToString(status.AgentStatusId))
+		entry.Arn = types.StringValue(aws.ToString(status.AgentStatusARN))
+		model.AgentStatusID = entry.AgentStatusID
+
+		var current AgentStatusSetEntry
+		current.flatten(ctx, status)
+
+		if agentStatusChanged(model, current.model(instanceID, name)) {
+			p.invalidateDescribe(entry.Arn.ValueString())
+
+			if err := updateAgentStatus(ctx, model, conn); err != nil {
+				diags.Append(apiError("Error updating Connect Agent Status", fmt.Sprintf("Could not update Connect Agent Status %s", name), err))
+				return reconciled, diags
+			}
+		} else {
+			tflog.Debug(ctx, fmt.Sprintf("Skipping UpdateAgentStatus of %s as no updatable attribute changed", name))
+		}
+
+		reconciled[name] = entry
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(existing)) {
+		status := existing[name]
+		if _, ok := planned[name]; ok || !disableUnmanaged || status.State != conntypes.AgentStatusStateEnabled {
+			continue
+		}
+
+		p.invalidateDescribe(aws.ToString(status.AgentStatusARN))
+
+		_, err := conn.UpdateAgentStatus(ctx, &connect.UpdateAgentStatusInput{
+			AgentStatusId: status.AgentStatusId,
+			InstanceId:    aws.String(instanceID),
+			State:         conntypes.AgentStatusStateDisabled,
+		})
+		if err != nil {
+			diags.Append(apiError("Error disabling Connect Agent Status", fmt.Sprintf("Could not disable Connect Agent Status %s", name), err))
+			return reconciled, diags
+		}
+	}
+
+	return reconciled, diags
+}
+
+func (r *AgentStatusSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AgentStatusSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(agentStatusSetResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(agentStatusSetResourceType, data.Override)
+	existing, err := customAgentStatuses(ctx, conn, data.InstanceID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error searching Connect Agent Statuses", "Could not search Connect Agent Statuses", err))
+		return
+	}
+
+	prior := data.Statuses
+	data.Statuses = map[string]AgentStatusSetEntry{}
+
+	// Managed statuses are kept whatever their state, and enabled statuses
+	// missing from the set are reported so the next apply disables them
+	for name, status := range existing {
+		entry, managed := prior[name]
+		if !managed && status.State != conntypes.AgentStatusStateEnabled {
+			continue
+		}
+
+		if !managed {
+			entry = AgentStatusSetEntry{}
+		}
+
+		entry.flatten(ctx, status)
+		data.Statuses[name] = entry
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgentStatusSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(agentStatusSetResourceType, resp) {
+		return
+	}
+
+	// Agent statuses cannot be deleted, so they are left as they are, as with
+	// awsext_connect_agent_status
+}
+
+func (r *AgentStatusSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Importing by instance ID manages all enabled custom statuses of the
+	// instance
+	resource.ImportStatePassthroughID(ctx, path.Root("instance_id"), req, resp)
+}
+
+// customAgentStatuses returns the custom agent statuses of an instance by
+// name. System statuses, e.g. Available and Offline, cannot be managed.
+func customAgentStatuses(ctx context.Context, conn AgentStatusAPI, instanceID string) (map[string]conntypes.AgentStatus, error) {
+	criteria := &conntypes.AgentStatusSearchCriteria{
+		StringCondition: stringCondition("type", conntypes.StringComparisonTypeExact, string(conntypes.AgentStatusTypeCustom)),
+	}
+
+	statuses, err := collectPages(ctx, searchAgentStatuses(conn, instanceID, criteria), 0, func(status conntypes.AgentStatus) bool {
+		return status.Type == conntypes.AgentStatusTypeCustom
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]conntypes.AgentStatus, len(statuses))
+	for _, status := range statuses {
+		byName[aws.ToString(status.Name)] = status
+	}
+
+	return byName, nil
+}
+
+// model returns the entry as the model of an agent status resource, to share
+// its update logic.
+func (e AgentStatusSetEntry) model(instanceID string, name string) AgentStatusResourceModel {
+	return AgentStatusResourceModel{
+		AgentStatusID: e.AgentStatusID,
+		Arn:           e.Arn,
+		InstanceID:    types.StringValue(instanceID),
+		Name:          types.StringValue(name),
+		Description:   e.Description,
+		State:         e.State,
+		DisplayOrder:  e.DisplayOrder,
+	}
+}
+
+// flatten sets the entry from an agent status returned by the API.
+func (e *AgentStatusSetEntry) flatten(ctx context.Context, status conntypes.AgentStatus) {
+	e.AgentStatusID = types.StringValue(aws.ToString(status.AgentStatusId))
+	e.Arn = types.StringValue(aws.ToString(status.AgentStatusARN))
+	e.Description = flattenNullableString(ctx, e.Description, status.Description)
+	e.State = types.StringValue(string(status.State))
+	e.DisplayOrder = flattenDisplayOrder(e.DisplayOrder, status)
+}
@@ -0,0 +1,463 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFlattenDisplayOrder(t *testing.T) {
+	tests := map[string]struct {
+		prior  types.Int32
+		status conntypes.AgentStatus
+		want   types.Int32
+	}{
+		"enabled": {
+			prior:  types.Int32Value(3),
+			status: conntypes.AgentStatus{State: conntypes.AgentStatusStateEnabled, DisplayOrder: aws.Int32(7)},
+			want:   types.Int32Value(7),
+		},
+		"disabled keeps prior": {
+			prior:  types.Int32Value(3),
+			status: conntypes.AgentStatus{State: conntypes.AgentStatusStateDisabled},
+			want:   types.Int32Value(3),
+		},
+		"disabled on import": {
+			prior:  types.Int32Null(),
+			status: conntypes.AgentStatus{State: conntypes.AgentStatusStateDisabled},
+			want:   types.Int32Value(defaultAgentStatusDisplayOrder),
+		},
+		"disabled while unknown": {
+			prior:  types.Int32Unknown(),
+			status: conntypes.AgentStatus{State: conntypes.AgentStatusStateDisabled},
+			want:   types.Int32Value(defaultAgentStatusDisplayOrder),
+		},
+		"enabled without order": {
+			prior:  types.Int32Value(3),
+			status: conntypes.AgentStatus{State: conntypes.AgentStatusStateEnabled},
+			want:   types.Int32Value(3),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := flattenDisplayOrder(test.prior, test.status); !got.Equal(test.want) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFlattenNullableString(t *testing.T) {
+	null := NullableString{StringValue: basetypes.NewStringNull()}
+	empty := NullableString{StringValue: basetypes.NewStringValue("")}
+
+	tests := map[string]struct {
+		prior NullableString
+		value *string
+		want  NullableString
+	}{
+		"null stays null":          {prior: null, value: aws.String(""), want: null},
+		"empty stays empty":        {prior: empty, value: nil, want: empty},
+		"omitted on import":        {prior: NullableString{}, value: nil, want: null},
+		"changed outside":          {prior: null, value: aws.String("Lunch"), want: NullableString{StringValue: basetypes.NewStringValue("Lunch")}},
+		"removed outside":          {prior: NullableString{StringValue: basetypes.NewStringValue("Lunch")}, value: aws.String(""), want: null},
+		"same value is kept as is": {prior: NullableString{StringValue: basetypes.NewStringValue("Lunch")}, value: aws.String("Lunch"), want: NullableString{StringValue: basetypes.NewStringValue("Lunch")}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := flattenNullableString(context.Background(), test.prior, test.value); !got.Equal(test.want) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAgentStatusFlattenDisabled(t *testing.T) {
+	conn := newMockConnectClient(t, map[string]mockConnectResponse{
+		"GET /agent-status/instance/status": {body: `{"AgentStatus": {
+			"AgentStatusARN": "arn:aws:connect:us-east-1:123456789012:instance/instance/agent-state/status",
+			"AgentStatusId": "status",
+			"Name": "Lunch",
+			"Description": "",
+			"State": "DISABLED",
+			"Type": "CUSTOM"
+		}}`},
+	})
+
+	response, err := conn.DescribeAgentStatus(context.Background(), &connect.DescribeAgentStatusInput{
+		InstanceId:    aws.String("instance"),
+		AgentStatusId: aws.String("status"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := AgentStatusResourceModel{
+		Description:  NullableString{StringValue: basetypes.NewStringNull()},
+		DisplayOrder: types.Int32Value(3),
+		Tags:         types.MapNull(types.StringType),
+	}
+
+	if diags := data.flatten(context.Background(), &ProviderData{}, response.AgentStatus); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if !data.Description.IsNull() {
+		t.Errorf("description: got %s, want null", data.Description)
+	}
+
+	if !data.DisplayOrder.Equal(types.Int32Value(3)) {
+		t.Errorf("display_order: got %s, want 3", data.DisplayOrder)
+	}
+
+	if data.State.ValueString() != "DISABLED" {
+		t.Errorf("state: got %s, want DISABLED", data.State)
+	}
+}
+
+func TestAgentStatusFlattenDefaults(t *testing.T) {
+	status := &conntypes.AgentStatus{
+		AgentStatusId: aws.String("status"),
+		Name:          aws.String("Lunch"),
+		State:         conntypes.AgentStatusStateDisabled,
+	}
+
+	tests := map[string]struct {
+		enforce types.Bool
+		want    types.Bool
+	}{
+		"sets the default of an imported status": {types.BoolNull(), types.BoolValue(true)},
+		"keeps observe-only":                     {types.BoolValue(false), types.BoolValue(false)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := AgentStatusResourceModel{
+				Description:  NullableString{StringValue: basetypes.NewStringNull()},
+				DisplayOrder: types.Int32Null(),
+				Enforce:      test.enforce,
+				Tags:         types.MapNull(types.StringType),
+			}
+
+			if diags := data.flatten(context.Background(), &ProviderData{}, status); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			if !data.Enforce.Equal(test.want) {
+				t.Errorf("enforce: got %s, want %s", data.Enforce, test.want)
+			}
+
+			if !data.DisplayOrder.Equal(types.Int32Value(defaultAgentStatusDisplayOrder)) {
+				t.Errorf("display_order: got %s, want %d", data.DisplayOrder, defaultAgentStatusDisplayOrder)
+			}
+
+			if !data.OnDestroy.IsNull() {
+				t.Errorf("on_destroy: got %s, want null", data.OnDestroy)
+			}
+		})
+	}
+}
+
+// fakeAgentStatusAPI is an in-memory AgentStatusAPI listing pageSize statuses
+// per page. Operations listed in errs fail with their error, and the other
+// operations not implemented here panic.
+type fakeAgentStatusAPI struct {
+	AgentStatusAPI
+
+	statuses []conntypes.AgentStatusSummary
+	pageSize int
+	errs     map[string]error
+	calls    []string
+}
+
+func (f *fakeAgentStatusAPI) call(operation string) error {
+	f.calls = append(f.calls, operation)
+
+	return f.errs[operation]
+}
+
+func (f *fakeAgentStatusAPI) ListAgentStatuses(ctx context.Context, params *connect.ListAgentStatusesInput, optFns ...func(*connect.Options)) (*connect.ListAgentStatusesOutput, error) {
+	if err := f.call("ListAgentStatuses"); err != nil {
+		return nil, err
+	}
+
+	start := 0
+	if params.NextToken != nil {
+		start, _ = strconv.Atoi(*params.NextToken)
+	}
+
+	end := min(start+f.pageSize, len(f.statuses))
+	output := &connect.ListAgentStatusesOutput{AgentStatusSummaryList: f.statuses[start:end]}
+	if end < len(f.statuses) {
+		output.NextToken = aws.String(strconv.Itoa(end))
+	}
+
+	return output, nil
+}
+
+func (f *fakeAgentStatusAPI) CreateAgentStatus(ctx context.Context, params *connect.CreateAgentStatusInput, optFns ...func(*connect.Options)) (*connect.CreateAgentStatusOutput, error) {
+	if err := f.call("CreateAgentStatus"); err != nil {
+		return nil, err
+	}
+
+	for _, status := range f.statuses {
+		if aws.ToString(status.Name) == aws.ToString(params.Name) {
+			return nil, &smithy.GenericAPIError{Code: "DuplicateResourceException", Message: "duplicate name"}
+		}
+	}
+
+	return &connect.CreateAgentStatusOutput{
+		AgentStatusId:  aws.String("created"),
+		AgentStatusARN: aws.String("arn:aws:connect:us-east-1:123456789012:instance/instance/agent-state/created"),
+	}, nil
+}
+
+func (f *fakeAgentStatusAPI) UpdateAgentStatus(ctx context.Context, params *connect.UpdateAgentStatusInput, optFns ...func(*connect.Options)) (*connect.UpdateAgentStatusOutput, error) {
+	return &connect.UpdateAgentStatusOutput{}, f.call("UpdateAgentStatus")
+}
+
+func (f *fakeAgentStatusAPI) ListTagsForResource(ctx context.Context, params *connect.ListTagsForResourceInput, optFns ...func(*connect.Options)) (*connect.ListTagsForResourceOutput, error) {
+	return &connect.ListTagsForResourceOutput{}, f.call("ListTagsForResource")
+}
+
+// fakeAgentStatuses returns count agent statuses named status-<n>.
+func fakeAgentStatuses(count int) []conntypes.AgentStatusSummary {
+	statuses := []conntypes.AgentStatusSummary{}
+	for i := range count {
+		id := strconv.Itoa(i)
+		statuses = append(statuses, conntypes.AgentStatusSummary{
+			Id:   aws.String(id),
+			Arn:  aws.String("arn:aws:connect:us-east-1:123456789012:instance/instance/agent-state/" + id),
+			Name: aws.String("status-" + id),
+		})
+	}
+
+	return statuses
+}
+
+// agentStatusCreateRequest returns the request and response of a Create of
+// the agent status of data.
+func agentStatusCreateRequest(t *testing.T, r *AgentStatusResource, data AgentStatusResourceModel) (resource.CreateRequest, *resource.CreateResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	var identityResponse resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResponse)
+
+	plan := tfsdk.Plan{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	req := resource.CreateRequest{
+		Plan:   plan,
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+	}
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identityResponse.IdentitySchema,
+			Raw:    tftypes.NewValue(identityResponse.IdentitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	return req, resp
+}
+
+func TestAgentStatusCreate(t *testing.T) {
+	tests := map[string]struct {
+		name           string
+		importOnExists bool
+		statuses       int
+		errs           map[string]error
+		wantID         string
+		wantError      string
+		wantCalls      []string
+	}{
+		"creates a new status": {
+			name:           "Lunch",
+			importOnExists: true,
+			statuses:       3,
+			wantID:         "created",
+			wantCalls:      []string{"ListAgentStatuses", "ListAgentStatuses", "CreateAgentStatus"},
+		},
+		"adopts an existing status": {
+			name:           "status-1",
+			importOnExists: true,
+			statuses:       2,
+			wantID:         "1",
+			wantCalls:      []string{"ListAgentStatuses", "UpdateAgentStatus", "ListTagsForResource"},
+		},
+		"adopts a status listed on a later page": {
+			name:           "status-4",
+			importOnExists: true,
+			statuses:       5,
+			wantID:         "4",
+			wantCalls:      []string{"ListAgentStatuses", "ListAgentStatuses", "ListAgentStatuses", "UpdateAgentStatus", "ListTagsForResource"},
+		},
+		"fails on a duplicate without import_on_exists": {
+			name:      "status-1",
+			statuses:  2,
+			wantError: "Error creating Connect Agent Status",
+			wantCalls: []string{"CreateAgentStatus"},
+		},
+		"fails when the statuses cannot be listed": {
+			name:           "Lunch",
+			importOnExists: true,
+			errs:           map[string]error{"ListAgentStatuses": &smithy.GenericAPIError{Code: "AccessDeniedException"}},
+			wantError:      "Error searching Connect Agent Statuses",
+			wantCalls:      []string{"ListAgentStatuses"},
+		},
+		"fails when the adopted status cannot be updated": {
+			name:           "status-0",
+			importOnExists: true,
+			statuses:       1,
+			errs:           map[string]error{"UpdateAgentStatus": &smithy.GenericAPIError{Code: "InvalidParameterException"}},
+			wantError:      "Error updating Connect Agent Status",
+			wantCalls:      []string{"ListAgentStatuses", "UpdateAgentStatus"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			api := &fakeAgentStatusAPI{statuses: fakeAgentStatuses(test.statuses), pageSize: 2, errs: test.errs}
+			r := &AgentStatusResource{providerData: &ProviderData{
+				agentStatusAPI: func(*OverrideModel) AgentStatusAPI { return api },
+			}}
+
+			req, resp := agentStatusCreateRequest(t, r, AgentStatusResourceModel{
+				InstanceID:     types.StringValue("instance"),
+				Name:           types.StringValue(test.name),
+				State:          types.StringValue("ENABLED"),
+				DisplayOrder:   types.Int32Value(1),
+				ImportOnExists: types.BoolValue(test.importOnExists),
+				Enforce:        types.BoolValue(true),
+				Tags:           types.MapNull(types.StringType),
+				TagsAll:        types.MapNull(types.StringType),
+				Timeouts:       nullTimeouts(ctx),
+			})
+
+			r.Create(ctx, req, resp)
+
+			if !slices.Equal(api.calls, test.wantCalls) {
+				t.Errorf("calls: got %v, want %v", api.calls, test.wantCalls)
+			}
+
+			if test.wantError != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != test.wantError {
+					t.Fatalf("got diagnostics %v, want error %q", resp.Diagnostics, test.wantError)
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var id types.String
+			resp.State.GetAttribute(ctx, path.Root("agent_status_id"), &id)
+			if id.ValueString() != test.wantID {
+				t.Errorf("agent_status_id: got %s, want %s", id, test.wantID)
+			}
+		})
+	}
+}
+
+func TestAgentStatusUpgradeState(t *testing.T) {
+	tests := map[string]struct {
+		description string
+		want        any
+	}{
+		"empty description": {
+			description: `""`,
+			want:        nil,
+		},
+		"description": {
+			description: `"Lunch break"`,
+			want:        "Lunch break",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			upgrader, ok := (&AgentStatusResource{}).UpgradeState(ctx)[0]
+			if !ok {
+				t.Fatal("no state upgrader for version 0")
+			}
+
+			req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{
+				JSON: []byte(`{"name": "Lunch", "description": ` + test.description + `}`),
+			}}
+			var resp resource.UpgradeStateResponse
+
+			upgrader.StateUpgrader(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var state map[string]any
+			if err := json.Unmarshal(resp.DynamicValue.JSON, &state); err != nil {
+				t.Fatal(err)
+			}
+
+			if state["description"] != test.want {
+				t.Errorf("description: got %#v, want %#v", state["description"], test.want)
+			}
+		})
+	}
+}
+
+func TestReconcileAgentStatusesRecordsNames(t *testing.T) {
+	ctx := context.Background()
+	api := &fakeAgentStatusAPI{statuses: fakeAgentStatuses(1), pageSize: 2}
+	p := &ProviderData{}
+
+	if _, found, err := p.findAgentStatusByName(ctx, api, nil, "instance", "new"); err != nil || found {
+		t.Fatalf("got found %t and error %v before the create", found, err)
+	}
+
+	planned := map[string]AgentStatusSetEntry{
+		"new": {
+			Description:  NullableString{StringValue: basetypes.NewStringNull()},
+			State:        types.StringValue("ENABLED"),
+			DisplayOrder: types.Int32Value(1),
+		},
+	}
+
+	if _, diags := p.reconcileAgentStatuses(ctx, api, "instance", nil, planned, []string{"new"}, nil, false); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	status, found, err := p.findAgentStatusByName(ctx, api, nil, "instance", "new")
+	if err != nil || !found || aws.ToString(status.Id) != "created" {
+		t.Errorf("got status %v, found %t and error %v after the create", status.Id, found, err)
+	}
+
+	if want := []string{"ListAgentStatuses", "CreateAgentStatus"}; !slices.Equal(api.calls, want) {
+		t.Errorf("calls: got %v, want %v", api.calls, want)
+	}
+}
@@ -0,0 +1,448 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AgentStatusesResource{}
+var _ resource.ResourceWithImportState = &AgentStatusesResource{}
+var _ resource.ResourceWithModifyPlan = &AgentStatusesResource{}
+
+// agentStatusesResourceType is the type of the agent statuses resource without
+// the provider prefix, e.g. in operation_policies.
+const agentStatusesResourceType = "connect_agent_statuses"
+
+// maxAgentStatusDisplayOrder is the highest display order of an enabled agent
+// status accepted by Connect.
+const maxAgentStatusDisplayOrder = 50
+
+func NewAgentStatusesResource() resource.Resource {
+	return &AgentStatusesResource{}
+}
+
+// AgentStatusesResource manages the custom agent statuses of an instance from
+// an ordered list, the display order of the enabled statuses following the
+// list. It is reconciled like awsext_connect_agent_status_set, with one search
+// of the instance, and only disables the statuses missing from the list if
+// disable_unmanaged is true.
+type AgentStatusesResource struct {
+	providerData *ProviderData
+}
+
+type AgentStatusesResourceModel struct {
+	InstanceID       types.String         `tfsdk:"instance_id"`
+	InstanceAlias    types.String         `tfsdk:"instance_alias"`
+	Statuses         []AgentStatusesEntry `tfsdk:"statuses"`
+	DisableUnmanaged types.Bool           `tfsdk:"disable_unmanaged"`
+	Timeouts         timeouts.Value       `tfsdk:"timeouts"`
+	Override         *OverrideModel       `tfsdk:"override"`
+}
+
+type AgentStatusesEntry struct {
+	Name          types.String   `tfsdk:"name"`
+	AgentStatusID types.String   `tfsdk:"agent_status_id"`
+	Arn           types.String   `tfsdk:"arn"`
+	Description   NullableString `tfsdk:"description"`
+	State         types.String   `tfsdk:"state"`
+	DisplayOrder  types.Int32    `tfsdk:"display_order"`
+}
+
+func (r *AgentStatusesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + agentStatusesResourceType
+}
+
+func (r *AgentStatusesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the custom agent statuses of a Connect instance from an ordered list, reconciled with a single search of the instance. The display order of the enabled statuses follows the list. Do not combine with awsext_connect_agent_status or awsext_connect_agent_status_set resources of the same instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"statuses": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Custom agent statuses, in the display order of the enabled ones.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 127),
+							},
+						},
+						"agent_status_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							CustomType: NullableStringType{},
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(250),
+							},
+						},
+						"state": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(string(conntypes.AgentStatusStateEnabled)),
+							Validators: []validator.String{
+								stringvalidator.OneOf("ENABLED", "DISABLED"),
+							},
+						},
+						"display_order": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Position of the status among the enabled statuses of the list, null for disabled statuses.",
+						},
+					},
+				},
+			},
+			"disable_unmanaged": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Disable the enabled custom statuses of the instance missing from the list, as agent statuses cannot be deleted. Defaults to false",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *AgentStatusesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *AgentStatusesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.modifyPlanStatuses(ctx, req, resp)
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+// modifyPlanStatuses plans the display order of the statuses from their
+// position in the list, and their IDs and ARNs from the prior state by name,
+// as the position of a status in the list can change.
+func (r *AgentStatusesResource) modifyPlanStatuses(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var statuses types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("statuses"), &statuses)...)
+
+	if resp.Diagnostics.HasError() || statuses.IsUnknown() {
+		return
+	}
+
+	var plan AgentStatusesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The display orders depend on the names and states of all statuses
+	for _, entry := range plan.Statuses {
+		if entry.Name.IsUnknown() || entry.State.IsUnknown() {
+			return
+		}
+	}
+
+	prior := map[string]AgentStatusesEntry{}
+	if !req.State.Raw.IsNull() {
+		var state AgentStatusesResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		for _, entry := range state.Statuses {
+			prior[entry.Name.ValueString()] = entry
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	displayOrder := int32(0)
+
+	for i, entry := range plan.Statuses {
+		name := entry.Name.ValueString()
+		if seen[name] {
+			resp.Diagnostics.AddAttributeError(path.Root("statuses").AtListIndex(i).AtName("name"), "Duplicate Agent Status Name", fmt.Sprintf("The agent status %s is listed more than once.", name))
+			continue
+		}
+
+		seen[name] = true
+
+		entry.DisplayOrder = types.Int32Null()
+		if entry.State.ValueString() == string(conntypes.AgentStatusStateEnabled) {
+			displayOrder++
+			entry.DisplayOrder = types.Int32Value(displayOrder)
+		}
+
+		entry.AgentStatusID = types.StringUnknown()
+		entry.Arn = types.StringUnknown()
+		if state, ok := prior[name]; ok {
+			entry.AgentStatusID = state.AgentStatusID
+			entry.Arn = state.Arn
+		}
+
+		plan.Statuses[i] = entry
+	}
+
+	if displayOrder > maxAgentStatusDisplayOrder {
+		resp.Diagnostics.AddAttributeError(path.Root("statuses"), "Too Many Enabled Agent Statuses", fmt.Sprintf("At most %d agent statuses can be enabled, got %d.", maxAgentStatusDisplayOrder, displayOrder))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *AgentStatusesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(agentStatusesResourceType, resp) {
+		return
+	}
+
+	var data AgentStatusesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(agentStatusesResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data)...)
+
+	// Save the statuses reconciled, even on error, into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgentStatusesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, agentStatusesResourceType, req, resp) {
+		return
+	}
+
+	var data AgentStatusesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(agentStatusesResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data)...)
+
+	// Save the statuses reconciled, even on error, into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// reconcile reconciles the listed statuses with the custom statuses of the
+// instance in list order, so moving a status to its display order never
+// shifts the statuses before it. The statuses of the model are replaced with
+// the listed statuses that were reconciled, so they can be saved even on
+// error.
+func (r *AgentStatusesResource) reconcile(ctx context.Context, data *AgentStatusesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := r.providerData.resourceConnectClient(agentStatusesResourceType, data.Override)
+	existing, err := customAgentStatuses(ctx, conn, data.InstanceID.ValueString())
+
+	if err != nil {
+		diags.Append(apiError("Error searching Connect Agent Statuses", "Could not search Connect Agent Statuses", err))
+		return diags
+	}
+
+	planned := make(map[string]AgentStatusSetEntry, len(data.Statuses))
+	names := make([]string, 0, len(data.Statuses))
+
+	for _, entry := range data.Statuses {
+		planned[entry.Name.ValueString()] = AgentStatusSetEntry{
+			AgentStatusID: entry.AgentStatusID,
+			Arn:           entry.Arn,
+			Description:   entry.Description,
+			State:         entry.State,
+			DisplayOrder:  entry.DisplayOrder,
+		}
+		names = append(names, entry.Name.ValueString())
+	}
+
+	reconciled, diags := r.providerData.reconcileAgentStatuses(ctx, conn, data.InstanceID.ValueString(), data.Override, planned, names, existing, data.DisableUnmanaged.ValueBool())
+
+	statuses := make([]AgentStatusesEntry, 0, len(reconciled))
+	for _, entry := range data.Statuses {
+		status, ok := reconciled[entry.Name.ValueString()]
+		if !ok {
+			continue
+		}
+
+		entry.AgentStatusID = status.AgentStatusID
+		entry.Arn = status.Arn
+		statuses = append(statuses, entry)
+	}
+
+	data.Statuses = statuses
+
+	return diags
+}
+
+func (r *AgentStatusesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AgentStatusesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(agentStatusesResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(agentStatusesResourceType, data.Override)
+	existing, err := customAgentStatuses(ctx, conn, data.InstanceID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error searching Connect Agent Statuses", "Could not search Connect Agent Statuses", err))
+		return
+	}
+
+	// Listed statuses deleted outside Terraform are removed, so the next apply
+	// creates them again
+	statuses := []AgentStatusesEntry{}
+	listed := map[string]bool{}
+
+	for _, entry := range data.Statuses {
+		status, ok := existing[entry.Name.ValueString()]
+		if !ok {
+			continue
+		}
+
+		entry.flatten(ctx, status)
+		statuses = append(statuses, entry)
+		listed[entry.Name.ValueString()] = true
+	}
+
+	// Enabled statuses missing from the list are reported when they would be
+	// disabled, and all of them on import, where disable_unmanaged is null
+	if data.DisableUnmanaged.IsNull() || data.DisableUnmanaged.ValueBool() {
+		var unmanaged []conntypes.AgentStatus
+		for name, status := range existing {
+			if !listed[name] && status.State == conntypes.AgentStatusStateEnabled {
+				unmanaged = append(unmanaged, status)
+			}
+		}
+
+		slices.SortFunc(unmanaged, func(a, b conntypes.AgentStatus) int {
+			return cmp.Or(cmp.Compare(aws.ToInt32(a.DisplayOrder), aws.ToInt32(b.DisplayOrder)), cmp.Compare(aws.ToString(a.Name), aws.ToString(b.Name)))
+		})
+
+		for _, status := range unmanaged {
+			entry := AgentStatusesEntry{Name: types.StringValue(aws.ToString(status.Name))}
+			entry.flatten(ctx, status)
+			statuses = append(statuses, entry)
+		}
+	}
+
+	data.Statuses = statuses
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgentStatusesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(agentStatusesResourceType, resp) {
+		return
+	}
+
+	// Agent statuses cannot be deleted, so they are left as they are, as with
+	// awsext_connect_agent_status
+}
+
+func (r *AgentStatusesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Importing by instance ID lists all enabled custom statuses of the
+	// instance in their display order
+	resource.ImportStatePassthroughID(ctx, path.Root("instance_id"), req, resp)
+}
+
+// flatten sets the entry from an agent status returned by the API.
+func (e *AgentStatusesEntry) flatten(ctx context.Context, status conntypes.AgentStatus) {
+	e.AgentStatusID = types.StringValue(aws.ToString(status.AgentStatusId))
+	e.Arn = types.StringValue(aws.ToString(status.AgentStatusARN))
+	e.Description = flattenNullableString(ctx, e.Description, status.Description)
+	e.State = types.StringValue(string(status.State))
+
+	e.DisplayOrder = types.Int32Null()
+	if status.State == conntypes.AgentStatusStateEnabled {
+		e.DisplayOrder = types.Int32PointerValue(status.DisplayOrder)
+	}
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AgentStatusesDataSource{}
+
+func NewAgentStatusesDataSource() datasource.DataSource {
+	return &AgentStatusesDataSource{}
+}
+
+type AgentStatusesDataSource struct {
+	providerData *ProviderData
+}
+
+type AgentStatusesDataSourceModel struct {
+	InstanceID    types.String              `tfsdk:"instance_id"`
+	InstanceAlias types.String              `tfsdk:"instance_alias"`
+	Types         types.Set                 `tfsdk:"types"`
+	AgentStatuses []AgentStatusSummaryModel `tfsdk:"agent_statuses"`
+}
+
+type AgentStatusSummaryModel struct {
+	AgentStatusID types.String `tfsdk:"agent_status_id"`
+	Arn           types.String `tfsdk:"arn"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+}
+
+func (d *AgentStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_agent_statuses"
+}
+
+func (d *AgentStatusesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the agent statuses of a Connect instance, including the system statuses Available and Offline",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"types": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return the agent statuses of these types, ROUTABLE, CUSTOM or OFFLINE. Agent statuses of all types are returned if unset.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						string(conntypes.AgentStatusTypeRoutable),
+						string(conntypes.AgentStatusTypeCustom),
+						string(conntypes.AgentStatusTypeOffline),
+					)),
+				},
+			},
+			"agent_statuses": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"agent_status_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the agent status: ROUTABLE for Available, OFFLINE for Offline and CUSTOM for the others.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AgentStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *AgentStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AgentStatusesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	var statusTypes []string
+	resp.Diagnostics.Append(data.Types.ElementsAs(ctx, &statusTypes, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The system statuses are listed with the custom ones
+	conn := d.providerData.connectClient(nil)
+	statuses, err := collectPages(ctx, listAgentStatuses(conn, data.InstanceID.ValueString()), 0, func(status conntypes.AgentStatusSummary) bool {
+		return len(statusTypes) == 0 || slices.Contains(statusTypes, string(status.Type))
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Agent Statuses", "Could not list Connect Agent Statuses", err))
+		return
+	}
+
+	data.AgentStatuses = []AgentStatusSummaryModel{}
+	for _, status := range statuses {
+		data.AgentStatuses = append(data.AgentStatuses, AgentStatusSummaryModel{
+			AgentStatusID: types.StringPointerValue(status.Id),
+			Arn:           types.StringPointerValue(status.Arn),
+			Name:          types.StringPointerValue(status.Name),
+			Type:          types.StringValue(string(status.Type)),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
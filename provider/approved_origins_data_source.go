@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ApprovedOriginsDataSource{}
+
+func NewApprovedOriginsDataSource() datasource.DataSource {
+	return &ApprovedOriginsDataSource{}
+}
+
+type ApprovedOriginsDataSource struct {
+	providerData *ProviderData
+}
+
+type ApprovedOriginsDataSourceModel struct {
+	InstanceID    types.String `tfsdk:"instance_id"`
+	InstanceAlias types.String `tfsdk:"instance_alias"`
+	MaxResults    types.Int64  `tfsdk:"max_results"`
+	Origins       types.List   `tfsdk:"origins"`
+}
+
+func (d *ApprovedOriginsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_approved_origins"
+}
+
+func (d *ApprovedOriginsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connect approved origins data source",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"max_results":    maxResultsAttribute(),
+			"origins": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Origins (e.g. https://example.com) approved for embedding the contact control panel.",
+			},
+		},
+	}
+}
+
+func (d *ApprovedOriginsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *ApprovedOriginsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApprovedOriginsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.providerData.connectClient(nil)
+	origins, err := collectPages(ctx, listApprovedOrigins(conn, data.InstanceID.ValueString(), data.MaxResults.ValueInt64()), data.MaxResults.ValueInt64(), nil)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Approved Origins", "Could not list Connect Approved Origins", err))
+		return
+	}
+
+	value, diags := types.ListValueFrom(ctx, types.StringType, origins)
+	resp.Diagnostics.Append(diags...)
+	data.Origins = value
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func listApprovedOrigins(conn *connect.Client, instanceID string, maxResults int64) pageLister[string] {
+	return func(ctx context.Context, nextToken *string) ([]string, *string, error) {
+		response, err := conn.ListApprovedOrigins(ctx, &connect.ListApprovedOriginsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: pageSize(maxResults, 25),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Origins, response.NextToken, nil
+	}
+}
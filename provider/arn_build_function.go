@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ArnBuildFunction{}
+
+func NewArnBuildFunction() function.Function {
+	return &ArnBuildFunction{}
+}
+
+type ArnBuildFunction struct{}
+
+func (f *ArnBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_build"
+}
+
+func (f *ArnBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build an ARN from its components",
+		MarkdownDescription: "Builds an ARN from its components. `region` and `account` may be empty for global resources.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "partition",
+				MarkdownDescription: "Partition, e.g. `aws`.",
+			},
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service namespace, e.g. `connect`.",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region, e.g. `us-east-1`.",
+			},
+			function.StringParameter{
+				Name:                "account",
+				MarkdownDescription: "Account ID.",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "Resource, e.g. `instance/<instance-id>/queue/<queue-id>`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ArnBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var partition, service, region, account, resource string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &partition, &service, &region, &account, &resource))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if partition == "" {
+		resp.Error = function.NewArgumentFuncError(0, "partition must not be empty")
+		return
+	}
+
+	if service == "" {
+		resp.Error = function.NewArgumentFuncError(1, "service must not be empty")
+		return
+	}
+
+	if resource == "" {
+		resp.Error = function.NewArgumentFuncError(4, "resource must not be empty")
+		return
+	}
+
+	result := arn.ARN{
+		Partition: partition,
+		Service:   service,
+		Region:    region,
+		AccountID: account,
+		Resource:  resource,
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+}
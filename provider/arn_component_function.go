@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ArnComponentFunction{}
+
+func NewArnRegionFunction() function.Function {
+	return &ArnComponentFunction{
+		name:      "arn_region",
+		component: "region",
+		value:     func(parsed arn.ARN) string { return parsed.Region },
+	}
+}
+
+func NewArnPartitionFunction() function.Function {
+	return &ArnComponentFunction{
+		name:      "arn_partition",
+		component: "partition",
+		value:     func(parsed arn.ARN) string { return parsed.Partition },
+	}
+}
+
+func NewArnAccountFunction() function.Function {
+	return &ArnComponentFunction{
+		name:      "arn_account",
+		component: "account ID",
+		value:     func(parsed arn.ARN) string { return parsed.AccountID },
+	}
+}
+
+// ArnComponentFunction returns a single component of an ARN.
+type ArnComponentFunction struct {
+	name      string
+	component string
+	value     func(arn.ARN) string
+}
+
+func (f *ArnComponentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *ArnComponentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Extract the " + f.component + " from an ARN",
+		MarkdownDescription: "Returns the " + f.component + " of an ARN, which is empty for ARNs of global resources without one.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN to read the " + f.component + " from.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ArnComponentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := arn.Parse(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, f.value(parsed)))
+}
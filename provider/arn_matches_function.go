@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ArnMatchesFunction{}
+
+func NewArnMatchesFunction() function.Function {
+	return &ArnMatchesFunction{}
+}
+
+type ArnMatchesFunction struct{}
+
+func (f *ArnMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_matches"
+}
+
+func (f *ArnMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Test an ARN against a pattern with wildcards",
+		MarkdownDescription: "Returns whether an ARN matches a pattern the way IAM matches the `Resource` of a policy statement. The six colon separated segments of the ARN are compared separately, and in each segment of the pattern `*` matches any sequence of characters and `?` any single character, e.g. `arn:aws:connect:*:123456789012:instance/abcd/*` matches every resource of an instance in any region. As in IAM, the pattern `*` matches every ARN.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN to test.",
+			},
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, with `*` and `?` wildcards in any segment, or `*`.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *ArnMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, pattern string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &pattern))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := arn.Parse(input); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if pattern == "*" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, true))
+		return
+	}
+
+	// The resource is the sixth segment and may itself contain colons
+	segments := strings.SplitN(input, ":", 6)
+	patternSegments := strings.SplitN(pattern, ":", 6)
+
+	if len(patternSegments) != 6 || patternSegments[0] != "arn" {
+		resp.Error = function.NewArgumentFuncError(1, "pattern must be * or have the six segments of an ARN, arn:partition:service:region:account:resource")
+		return
+	}
+
+	matches := true
+	for i := range segments {
+		if !wildcardMatch(patternSegments[i], segments[i]) {
+			matches = false
+			break
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, matches))
+}
+
+// wildcardMatch reports whether value matches pattern, in which * matches any
+// sequence of characters and ? any single character.
+func wildcardMatch(pattern, value string) bool {
+	p, v := 0, 0
+	star, starValue := -1, 0
+
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, starValue = p, v
+			p++
+		case star >= 0:
+			// Let the last * match one more character
+			starValue++
+			p, v = star+1, starValue
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
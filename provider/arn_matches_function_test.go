@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestArnMatches(t *testing.T) {
+	const queueArn = "arn:aws:connect:us-east-1:123456789012:instance/abcd/queue/1234"
+
+	tests := map[string]struct {
+		arn       string
+		pattern   string
+		want      bool
+		wantError string
+	}{
+		"exact": {
+			arn:     queueArn,
+			pattern: queueArn,
+			want:    true,
+		},
+		"any region": {
+			arn:     queueArn,
+			pattern: "arn:aws:connect:*:123456789012:instance/abcd/*",
+			want:    true,
+		},
+		"other instance": {
+			arn:     queueArn,
+			pattern: "arn:aws:connect:*:123456789012:instance/efgh/*",
+		},
+		"wildcards do not span segments": {
+			arn:     queueArn,
+			pattern: "arn:*:us-east-1:123456789012:instance/abcd/queue/1234:*",
+		},
+		"question mark": {
+			arn:     queueArn,
+			pattern: "arn:aws:connect:us-east-?:123456789012:instance/abcd/queue/123?",
+			want:    true,
+		},
+		"question mark matches one character": {
+			arn:     queueArn,
+			pattern: "arn:aws:connect:us-east-?:123456789012:instance/abcd/queue/12?",
+		},
+		"other partition": {
+			arn:     "arn:aws-us-gov:connect:us-gov-west-1:123456789012:instance/abcd",
+			pattern: "arn:aws:connect:*:*:*",
+		},
+		"resource with colons": {
+			arn:     "arn:aws:lambda:us-east-1:123456789012:function:contact-router:live",
+			pattern: "arn:aws:lambda:*:*:function:contact-router*",
+			want:    true,
+		},
+		"empty region": {
+			arn:     "arn:aws:s3:::bucket/key",
+			pattern: "arn:aws:s3:::bucket/*",
+			want:    true,
+		},
+		"star matches every ARN": {
+			arn:     queueArn,
+			pattern: "*",
+			want:    true,
+		},
+		"several stars": {
+			arn:     queueArn,
+			pattern: "arn:aws:connect:*:*:*/abcd/*/*4",
+			want:    true,
+		},
+		"case sensitive": {
+			arn:     queueArn,
+			pattern: "arn:aws:connect:us-east-1:123456789012:Instance/abcd/*",
+		},
+		"invalid ARN": {
+			arn:       "instance/abcd",
+			pattern:   "*",
+			wantError: "arn: invalid prefix",
+		},
+		"invalid pattern": {
+			arn:       queueArn,
+			pattern:   "arn:aws:connect:*",
+			wantError: "pattern must be * or have the six segments of an ARN, arn:partition:service:region:account:resource",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(NewArnMatchesFunction(), types.BoolUnknown(), types.StringValue(test.arn), types.StringValue(test.pattern))
+
+			if test.wantError != "" {
+				if err == nil || err.Text != test.wantError {
+					t.Fatalf("got error %v, want %q", err, test.wantError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !got.Equal(types.BoolValue(test.want)) {
+				t.Errorf("got %s, want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ArnParseFunction{}
+
+var arnParseReturnAttrTypes = map[string]attr.Type{
+	"partition":     types.StringType,
+	"service":       types.StringType,
+	"region":        types.StringType,
+	"account":       types.StringType,
+	"resource":      types.StringType,
+	"resource_type": types.StringType,
+	"resource_id":   types.StringType,
+}
+
+func NewArnParseFunction() function.Function {
+	return &ArnParseFunction{}
+}
+
+type ArnParseFunction struct{}
+
+func (f *ArnParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_parse"
+}
+
+func (f *ArnParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse an ARN into its components",
+		MarkdownDescription: "Parses an ARN and returns an object with its `partition`, `service`, `region`, `account` and `resource`. The resource is further split on the first `/` or `:` into `resource_type` and `resource_id`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: arnParseReturnAttrTypes,
+		},
+	}
+}
+
+func (f *ArnParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := arn.Parse(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resourceType, resourceID := splitArnResource(parsed.Resource)
+
+	result, diags := types.ObjectValue(arnParseReturnAttrTypes, map[string]attr.Value{
+		"partition":     types.StringValue(parsed.Partition),
+		"service":       types.StringValue(parsed.Service),
+		"region":        types.StringValue(parsed.Region),
+		"account":       types.StringValue(parsed.AccountID),
+		"resource":      types.StringValue(parsed.Resource),
+		"resource_type": types.StringValue(resourceType),
+		"resource_id":   types.StringValue(resourceID),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// splitArnResource splits the resource part of an ARN into its type and id,
+// e.g. "instance/abc/queue/def" becomes "instance" and "abc/queue/def". A
+// resource without a type separator is returned as the id.
+func splitArnResource(resource string) (string, string) {
+	index := strings.IndexAny(resource, "/:")
+	if index < 0 {
+		return "", resource
+	}
+
+	return resource[:index], resource[index+1:]
+}
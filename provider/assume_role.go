@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AssumeRoleModel describes an assume_role block of the provider. The blocks
+// are assumed in order, each with the credentials of the previous one, to
+// chain roles across accounts.
+type AssumeRoleModel struct {
+	RoleArn           types.String      `tfsdk:"role_arn"`
+	SessionName       types.String      `tfsdk:"session_name"`
+	ExternalID        types.String      `tfsdk:"external_id"`
+	Duration          types.String      `tfsdk:"duration"`
+	Policy            types.String      `tfsdk:"policy"`
+	PolicyArns        []string          `tfsdk:"policy_arns"`
+	SourceIdentity    types.String      `tfsdk:"source_identity"`
+	Tags              map[string]string `tfsdk:"tags"`
+	TransitiveTagKeys []string          `tfsdk:"transitive_tag_keys"`
+}
+
+// AssumeRoleWithWebIdentityModel describes the assume_role_with_web_identity
+// block of the provider, whose credentials are used by the assume_role blocks
+// if any.
+type AssumeRoleWithWebIdentityModel struct {
+	RoleArn              types.String `tfsdk:"role_arn"`
+	SessionName          types.String `tfsdk:"session_name"`
+	WebIdentityToken     types.String `tfsdk:"web_identity_token"`
+	WebIdentityTokenFile types.String `tfsdk:"web_identity_token_file"`
+	Duration             types.String `tfsdk:"duration"`
+	Policy               types.String `tfsdk:"policy"`
+	PolicyArns           []string     `tfsdk:"policy_arns"`
+}
+
+// defaultSessionName is the session name of the roles assumed by the provider
+// when their block does not set one.
+const defaultSessionName = "terraform-provider-awsext"
+
+// assumeRoleBlock returns the assume_role block of the provider.
+func assumeRoleBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Role to assume. Several blocks chain the roles, each assumed with the credentials of the previous one",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"role_arn": schema.StringAttribute{
+					Description: "ARN of the role to assume",
+					Required:    true,
+					Validators: []validator.String{
+						validArn("iam"),
+					},
+				},
+				"session_name": schema.StringAttribute{
+					Description: "Session name of the role, defaults to terraform-provider-awsext",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(2, 64),
+					},
+				},
+				"external_id": schema.StringAttribute{
+					Description: "External ID required by the trust policy of the role",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(2, 1224),
+					},
+				},
+				"duration": schema.StringAttribute{
+					Description: "Duration of the role session, e.g. 1h, between 15m and 12h. Defaults to 15m",
+					Optional:    true,
+				},
+				"policy": schema.StringAttribute{
+					Description: "IAM policy JSON further restricting the permissions of the session",
+					Optional:    true,
+				},
+				"policy_arns": schema.SetAttribute{
+					Description: "ARNs of managed policies further restricting the permissions of the session",
+					Optional:    true,
+					ElementType: types.StringType,
+					Validators: []validator.Set{
+						setvalidator.ValueStringsAre(validArn("iam")),
+					},
+				},
+				"source_identity": schema.StringAttribute{
+					Description: "Source identity of the session, kept by the roles chained from it",
+					Optional:    true,
+				},
+				"tags": schema.MapAttribute{
+					Description: "Session tags",
+					Optional:    true,
+					ElementType: types.StringType,
+				},
+				"transitive_tag_keys": schema.SetAttribute{
+					Description: "Keys of the session tags passed on to the roles chained from the session",
+					Optional:    true,
+					ElementType: types.StringType,
+				},
+			},
+		},
+	}
+}
+
+// assumeRoleWithWebIdentityBlock returns the assume_role_with_web_identity
+// block of the provider.
+func assumeRoleWithWebIdentityBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		Description: "Role to assume with an OIDC web identity token, e.g. in CI. The assume_role blocks are assumed with its credentials",
+		Attributes: map[string]schema.Attribute{
+			"role_arn": schema.StringAttribute{
+				Description: "ARN of the role to assume",
+				Optional:    true,
+				Validators: []validator.String{
+					validArn("iam"),
+				},
+			},
+			"session_name": schema.StringAttribute{
+				Description: "Session name of the role, defaults to terraform-provider-awsext",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+			},
+			"web_identity_token": schema.StringAttribute{
+				Description: "OIDC token of the web identity",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("web_identity_token_file")),
+				},
+			},
+			"web_identity_token_file": schema.StringAttribute{
+				Description: "Path of a file containing the OIDC token of the web identity, read again whenever the credentials are refreshed",
+				Optional:    true,
+			},
+			"duration": schema.StringAttribute{
+				Description: "Duration of the role session, e.g. 1h, between 15m and 12h. Defaults to 15m",
+				Optional:    true,
+			},
+			"policy": schema.StringAttribute{
+				Description: "IAM policy JSON further restricting the permissions of the session",
+				Optional:    true,
+			},
+			"policy_arns": schema.SetAttribute{
+				Description: "ARNs of managed policies further restricting the permissions of the session",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validArn("iam")),
+				},
+			},
+		},
+	}
+}
+
+// webIdentityToken is a web identity token set in the configuration.
+type webIdentityToken string
+
+func (t webIdentityToken) GetIdentityToken() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// assumeRoleWithWebIdentity returns the credentials of the
+// assume_role_with_web_identity block, assumed with cfg. Like the AWS
+// provider, the role and token file default to the AWS_ROLE_ARN and
+// AWS_WEB_IDENTITY_TOKEN_FILE environment variables.
+func assumeRoleWithWebIdentity(cfg aws.Config, model AssumeRoleWithWebIdentityModel) (aws.CredentialsProvider, error) {
+	duration, err := parseSessionDuration(model.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	roleArn := model.RoleArn.ValueString()
+	if roleArn == "" {
+		roleArn = os.Getenv("AWS_ROLE_ARN")
+	}
+
+	if roleArn == "" {
+		return nil, fmt.Errorf("role_arn is required when AWS_ROLE_ARN is not set")
+	}
+
+	var token stscreds.IdentityTokenRetriever
+	switch {
+	case model.WebIdentityToken.ValueString() != "":
+		token = webIdentityToken(model.WebIdentityToken.ValueString())
+	case model.WebIdentityTokenFile.ValueString() != "":
+		token = stscreds.IdentityTokenFile(model.WebIdentityTokenFile.ValueString())
+	case os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE") != "":
+		token = stscreds.IdentityTokenFile(os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"))
+	default:
+		return nil, fmt.Errorf("one of web_identity_token or web_identity_token_file is required when AWS_WEB_IDENTITY_TOKEN_FILE is not set")
+	}
+
+	provider := stscreds.NewWebIdentityRoleProvider(sts.NewFromConfig(cfg), roleArn, token, func(o *stscreds.WebIdentityRoleOptions) {
+		o.RoleSessionName = sessionName(model.SessionName)
+		o.Duration = duration
+		o.Policy = model.Policy.ValueStringPointer()
+		o.PolicyARNs = policyDescriptors(model.PolicyArns)
+	})
+
+	return aws.NewCredentialsCache(provider), nil
+}
+
+// assumeRole returns the credentials of an assume_role block, assumed with
+// cfg.
+func assumeRole(cfg aws.Config, model AssumeRoleModel) (aws.CredentialsProvider, error) {
+	duration, err := parseSessionDuration(model.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), model.RoleArn.ValueString(), func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = sessionName(model.SessionName)
+		o.Duration = duration
+		o.ExternalID = model.ExternalID.ValueStringPointer()
+		o.Policy = model.Policy.ValueStringPointer()
+		o.PolicyARNs = policyDescriptors(model.PolicyArns)
+		o.SourceIdentity = model.SourceIdentity.ValueStringPointer()
+		o.TransitiveTagKeys = model.TransitiveTagKeys
+
+		for key, value := range model.Tags {
+			o.Tags = append(o.Tags, ststypes.Tag{Key: aws.String(key), Value: aws.String(value)})
+		}
+	})
+
+	return aws.NewCredentialsCache(provider), nil
+}
+
+// parseSessionDuration parses the duration of a role session, zero leaving
+// the STS default of 15 minutes.
+func parseSessionDuration(value types.String) (time.Duration, error) {
+	if value.ValueString() == "" {
+		return 0, nil
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration: %w", err)
+	}
+
+	if duration < 15*time.Minute || duration > 12*time.Hour {
+		return 0, fmt.Errorf("duration %s is not between 15m and 12h", duration)
+	}
+
+	return duration, nil
+}
+
+func sessionName(value types.String) string {
+	if value.ValueString() == "" {
+		return defaultSessionName
+	}
+
+	return value.ValueString()
+}
+
+func policyDescriptors(arns []string) []ststypes.PolicyDescriptorType {
+	var descriptors []ststypes.PolicyDescriptorType
+	for _, arn := range arns {
+		descriptors = append(descriptors, ststypes.PolicyDescriptorType{Arn: aws.String(arn)})
+	}
+
+	return descriptors
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &AssumeRoleCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AssumeRoleCredentialsEphemeralResource{}
+
+func NewAssumeRoleCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &AssumeRoleCredentialsEphemeralResource{}
+}
+
+type AssumeRoleCredentialsEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type AssumeRoleCredentialsEphemeralResourceModel struct {
+	RoleArn           types.String `tfsdk:"role_arn"`
+	SessionName       types.String `tfsdk:"session_name"`
+	DurationSeconds   types.Int32  `tfsdk:"duration_seconds"`
+	ExternalID        types.String `tfsdk:"external_id"`
+	Policy            types.String `tfsdk:"policy"`
+	Tags              types.Map    `tfsdk:"tags"`
+	TransitiveTagKeys types.Set    `tfsdk:"transitive_tag_keys"`
+	AccessKeyID       types.String `tfsdk:"access_key_id"`
+	SecretAccessKey   types.String `tfsdk:"secret_access_key"`
+	SessionToken      types.String `tfsdk:"session_token"`
+	Expiration        types.String `tfsdk:"expiration"`
+}
+
+func (r *AssumeRoleCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assume_role_credentials"
+}
+
+func (r *AssumeRoleCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Temporary credentials obtained with STS AssumeRole",
+
+		Attributes: map[string]schema.Attribute{
+			"role_arn": schema.StringAttribute{
+				Required:    true,
+				Description: "ARN of the role to assume.",
+				Validators: []validator.String{
+					validArn("iam"),
+				},
+			},
+			"session_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Session name to use when assuming the role. Defaults to `terraform-provider-awsext`.",
+			},
+			"duration_seconds": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Duration, in seconds, of the role session.",
+				Validators: []validator.Int32{
+					int32validator.Between(900, 43200),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "External identifier to use when assuming the role.",
+			},
+			"policy": schema.StringAttribute{
+				Optional:    true,
+				Description: "IAM policy JSON further restricting the permissions of the session.",
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Session tags, e.g. for attribute-based access control in the policies of the role.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtMost(50),
+				},
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Keys of the session tags passed on to the roles assumed with the credentials.",
+			},
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp at which the credentials expire.",
+			},
+		},
+	}
+}
+
+func (r *AssumeRoleCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *AssumeRoleCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AssumeRoleCredentialsEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	var tags map[string]string
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+	var transitiveTagKeys []string
+	resp.Diagnostics.Append(data.TransitiveTagKeys.ElementsAs(ctx, &transitiveTagKeys, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.providerData.stsClient()
+	input := &sts.AssumeRoleInput{
+		RoleArn:           aws.String(data.RoleArn.ValueString()),
+		RoleSessionName:   aws.String(sessionName(data.SessionName)),
+		DurationSeconds:   data.DurationSeconds.ValueInt32Pointer(),
+		TransitiveTagKeys: transitiveTagKeys,
+	}
+
+	for key, value := range tags {
+		input.Tags = append(input.Tags, ststypes.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+
+	if data.ExternalID.ValueString() != "" {
+		input.ExternalId = aws.String(data.ExternalID.ValueString())
+	}
+
+	if data.Policy.ValueString() != "" {
+		input.Policy = aws.String(data.Policy.ValueString())
+	}
+
+	response, err := conn.AssumeRole(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error assuming role", fmt.Sprintf("Could not assume role %s", data.RoleArn.ValueString()), err))
+		return
+	}
+
+	data.AccessKeyID = types.StringValue(aws.ToString(response.Credentials.AccessKeyId))
+	data.SecretAccessKey = types.StringValue(aws.ToString(response.Credentials.SecretAccessKey))
+	data.SessionToken = types.StringValue(aws.ToString(response.Credentials.SessionToken))
+	data.Expiration = types.StringValue(aws.ToTime(response.Credentials.Expiration).Format(time.RFC3339))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &BotAssociationResource{}
+var _ resource.ResourceWithImportState = &BotAssociationResource{}
+var _ resource.ResourceWithModifyPlan = &BotAssociationResource{}
+
+// botAssociationResourceType is the type of the bot association resource
+// without the provider prefix, e.g. in operation_policies.
+const botAssociationResourceType = "connect_bot_association"
+
+func NewBotAssociationResource() resource.Resource {
+	return &BotAssociationResource{}
+}
+
+type BotAssociationResource struct {
+	providerData *ProviderData
+}
+
+type BotAssociationResourceModel struct {
+	InstanceID    types.String   `tfsdk:"instance_id"`
+	InstanceAlias types.String   `tfsdk:"instance_alias"`
+	AliasArn      types.String   `tfsdk:"alias_arn"`
+	SkipDestroy   types.Bool     `tfsdk:"skip_destroy"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	Override      *OverrideModel `tfsdk:"override"`
+}
+
+func (r *BotAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + botAssociationResourceType
+}
+
+func (r *BotAssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Associates a Lex V2 bot alias with a Connect instance",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"alias_arn": schema.StringAttribute{
+				Required:    true,
+				Description: "ARN of the Lex V2 bot alias, e.g. arn:aws:lex:us-east-1:123456789012:bot-alias/ABCDEFGHIJ/TSTALIASID.",
+				Validators: []validator.String{
+					validArn("lex"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			skipDestroyAttributeName: skipDestroyAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *BotAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *BotAssociationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *BotAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(botAssociationResourceType, resp) {
+		return
+	}
+
+	var data BotAssociationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(botAssociationResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(botAssociationResourceType, data.Override)
+	_, err := conn.AssociateBot(ctx, &connect.AssociateBotInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		LexV2Bot: &conntypes.LexV2Bot{
+			AliasArn: aws.String(data.AliasArn.ValueString()),
+		},
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error associating Connect Bot", fmt.Sprintf("Could not associate Lex V2 bot alias %s", data.AliasArn.ValueString()), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BotAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BotAssociationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(botAssociationResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	// There is no API describing a single association
+	conn := r.providerData.resourceConnectClient(botAssociationResourceType, data.Override)
+	bots, err := collectPages(ctx, listBots(conn, data.InstanceID.ValueString(), conntypes.LexVersionV2), 0, func(bot conntypes.LexBotConfig) bool {
+		return bot.LexV2Bot != nil && aws.ToString(bot.LexV2Bot.AliasArn) == data.AliasArn.ValueString()
+	})
+
+	if isNotFound(err) || (err == nil && len(bots) == 0) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Bots", "Could not list Lex V2 bots", err))
+		return
+	}
+
+	// skip_destroy is not set on import
+	if data.SkipDestroy.IsNull() {
+		data.SkipDestroy = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BotAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BotAssociationResourceModel
+
+	// Only skip_destroy and timeouts can change without replacement
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BotAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(botAssociationResourceType, resp) {
+		return
+	}
+
+	var data BotAssociationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	skip, diags := skipDestroy(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || skip {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(botAssociationResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(botAssociationResourceType, data.Override)
+	_, err := conn.DisassociateBot(ctx, &connect.DisassociateBotInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		LexV2Bot: &conntypes.LexV2Bot{
+			AliasArn: aws.String(data.AliasArn.ValueString()),
+		},
+	})
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error disassociating Connect Bot", fmt.Sprintf("Could not disassociate Lex V2 bot alias %s", data.AliasArn.ValueString()), err))
+	}
+}
+
+func (r *BotAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The alias ARN contains colons, instance IDs do not
+	instanceID, aliasArn, found := strings.Cut(req.ID, ":")
+
+	if !found || instanceID == "" || aliasArn == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with format <instance_id>:<alias_arn>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alias_arn"), aliasArn)...)
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &BotAssociationsDataSource{}
+
+func NewBotAssociationsDataSource() datasource.DataSource {
+	return &BotAssociationsDataSource{}
+}
+
+type BotAssociationsDataSource struct {
+	providerData *ProviderData
+}
+
+type BotAssociationsDataSourceModel struct {
+	InstanceID    types.String          `tfsdk:"instance_id"`
+	InstanceAlias types.String          `tfsdk:"instance_alias"`
+	LexVersion    types.String          `tfsdk:"lex_version"`
+	Bots          []BotAssociationModel `tfsdk:"bots"`
+}
+
+type BotAssociationModel struct {
+	LexVersion types.String `tfsdk:"lex_version"`
+	Name       types.String `tfsdk:"name"`
+	Region     types.String `tfsdk:"region"`
+	AliasArn   types.String `tfsdk:"alias_arn"`
+}
+
+func (d *BotAssociationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_bot_associations"
+}
+
+func (d *BotAssociationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Lex and Lex V2 bots associated with a Connect instance",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"lex_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the bots of this Lex version, V1 or V2. Bots of both versions are returned if unset.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(conntypes.LexVersionV1), string(conntypes.LexVersionV2)),
+				},
+			},
+			"bots": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"lex_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Lex version of the bot, V1 or V2.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the bot, null for Lex V2 bots which are identified by their alias ARN.",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Region of the bot.",
+						},
+						"alias_arn": schema.StringAttribute{
+							Computed:    true,
+							Description: "ARN of the alias of the bot, null for Lex V1 bots.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BotAssociationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *BotAssociationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BotAssociationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions := []conntypes.LexVersion{conntypes.LexVersionV1, conntypes.LexVersionV2}
+	if !data.LexVersion.IsNull() {
+		versions = []conntypes.LexVersion{conntypes.LexVersion(data.LexVersion.ValueString())}
+	}
+
+	conn := d.providerData.connectClient(nil)
+	data.Bots = []BotAssociationModel{}
+
+	for _, version := range versions {
+		bots, err := collectPages(ctx, listBots(conn, data.InstanceID.ValueString(), version), 0, nil)
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error listing Connect Bots", fmt.Sprintf("Could not list Lex %s bots", version), err))
+			return
+		}
+
+		for _, bot := range bots {
+			model := BotAssociationModel{
+				LexVersion: types.StringValue(string(version)),
+				Name:       types.StringNull(),
+				Region:     types.StringNull(),
+				AliasArn:   types.StringNull(),
+			}
+
+			if bot.LexBot != nil {
+				model.Name = types.StringPointerValue(bot.LexBot.Name)
+				model.Region = types.StringPointerValue(bot.LexBot.LexRegion)
+			}
+
+			if bot.LexV2Bot != nil {
+				model.AliasArn = types.StringPointerValue(bot.LexV2Bot.AliasArn)
+
+				// Lex V2 bots only have an alias ARN, which holds their region
+				if parsed, err := arn.Parse(aws.ToString(bot.LexV2Bot.AliasArn)); err == nil {
+					model.Region = types.StringValue(parsed.Region)
+				}
+			}
+
+			data.Bots = append(data.Bots, model)
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func listBots(conn *connect.Client, instanceID string, version conntypes.LexVersion) pageLister[conntypes.LexBotConfig] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.LexBotConfig, *string, error) {
+		response, err := conn.ListBots(ctx, &connect.ListBotsInput{
+			InstanceId: aws.String(instanceID),
+			LexVersion: version,
+			MaxResults: aws.Int32(25),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.LexBots, response.NextToken, nil
+	}
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &CallerIdentityEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &CallerIdentityEphemeralResource{}
+
+func NewCallerIdentityEphemeralResource() ephemeral.EphemeralResource {
+	return &CallerIdentityEphemeralResource{}
+}
+
+type CallerIdentityEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type CallerIdentityEphemeralResourceModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	Arn       types.String `tfsdk:"arn"`
+	UserID    types.String `tfsdk:"user_id"`
+	Partition types.String `tfsdk:"partition"`
+	Region    types.String `tfsdk:"region"`
+}
+
+func (r *CallerIdentityEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caller_identity"
+}
+
+func (r *CallerIdentityEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Identity of the credentials the provider is configured with",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"user_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"partition": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Computed:    true,
+				Description: "Region the provider is configured for.",
+			},
+		},
+	}
+}
+
+func (r *CallerIdentityEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *CallerIdentityEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data CallerIdentityEphemeralResourceModel
+
+	conn := r.providerData.stsClient()
+	response, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error getting caller identity", "Could not get STS caller identity", err))
+		return
+	}
+
+	data.AccountID = types.StringValue(aws.ToString(response.Account))
+	data.Arn = types.StringValue(aws.ToString(response.Arn))
+	data.UserID = types.StringValue(aws.ToString(response.UserId))
+	data.Region = types.StringValue(r.providerData.Config.Region)
+
+	parsed, err := arn.Parse(aws.ToString(response.Arn))
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing caller identity ARN", fmt.Sprintf("Could not parse caller identity ARN %s: %s", aws.ToString(response.Arn), err))
+		return
+	}
+
+	data.Partition = types.StringValue(parsed.Partition)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// clientKey identifies a cached service client by the service, the region
+// and role of the override and the retry budget it was built for.
+type clientKey struct {
+	service     string
+	region      string
+	roleArn     string
+	maxAttempts int
+}
+
+// clientCache holds the service clients built by the provider, so resources
+// share their middleware, retryers and credential caches instead of building
+// a client per operation.
+type clientCache struct {
+	mu      sync.Mutex
+	clients map[clientKey]any
+}
+
+// cachedClient returns the client of service for the given override block,
+// which may be nil, building it with build on first use. A positive
+// maxAttempts overrides the maximum attempts of the provider's retryer.
+func cachedClient[C any](p *ProviderData, service string, override *OverrideModel, maxAttempts int, build func(aws.Config) C) C {
+	key := clientKey{service: service, maxAttempts: maxAttempts}
+	if override != nil {
+		key.region = override.Region.ValueString()
+		key.roleArn = override.RoleArn.ValueString()
+	}
+
+	p.clients.mu.Lock()
+	if client, ok := p.clients.clients[key]; ok {
+		p.clients.mu.Unlock()
+		return client.(C)
+	}
+	p.clients.mu.Unlock()
+
+	// Built outside the lock as awsConfig takes the assumed role lock.
+	client := build(p.serviceConfig(service, override, maxAttempts))
+
+	p.clients.mu.Lock()
+	defer p.clients.mu.Unlock()
+
+	if p.clients.clients == nil {
+		p.clients.clients = map[clientKey]any{}
+	}
+
+	if existing, ok := p.clients.clients[key]; ok {
+		return existing.(C)
+	}
+
+	p.clients.clients[key] = client
+
+	return client
+}
+
+// serviceConfig returns the AWS config of the clients of service for the
+// given override block, which may be nil: its custom endpoint, concurrency
+// limit and shared retryer. A positive maxAttempts overrides the maximum
+// attempts of the retryer. Clients not cached, e.g. with per-call
+// credentials, are built from it to behave like the cached ones.
+func (p *ProviderData) serviceConfig(service string, override *OverrideModel, maxAttempts int) aws.Config {
+	cfg := withEndpoint(p.awsConfig(override), p.endpoints, service)
+	if semaphore, ok := p.semaphores[service]; ok {
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), concurrencyLimit(semaphore))
+	}
+
+	cfg.Retryer = func() aws.Retryer {
+		return p.serviceRetryer(service)
+	}
+
+	if maxAttempts > 0 {
+		cfg.RetryMaxAttempts = maxAttempts
+	}
+
+	return cfg
+}
+
+// buildClients builds the clients of the provider's own configuration in
+// Configure, so every resource, data source and action shares them and their
+// connections from the first call.
+func (p *ProviderData) buildClients() {
+	p.connectClient(nil)
+	p.stsClient()
+}
+
+func (p *ProviderData) connectClient(override *OverrideModel) *connect.Client {
+	return cachedClient(p, "connect", override, 0, func(cfg aws.Config) *connect.Client {
+		return connect.NewFromConfig(cfg)
+	})
+}
+
+// resourceConnectClient returns the Connect client of a resource type, e.g.
+// connect_agent_status, applying the retry budget of its operation policy.
+func (p *ProviderData) resourceConnectClient(resourceType string, override *OverrideModel) *connect.Client {
+	return cachedClient(p, "connect", override, p.operationPolicies[resourceType].maxAttempts, func(cfg aws.Config) *connect.Client {
+		return connect.NewFromConfig(cfg)
+	})
+}
+
+func (p *ProviderData) ecrClient() *ecr.Client {
+	return cachedClient(p, "ecr", nil, 0, func(cfg aws.Config) *ecr.Client {
+		return ecr.NewFromConfig(cfg)
+	})
+}
+
+func (p *ProviderData) kmsClient() *kms.Client {
+	return cachedClient(p, "kms", nil, 0, func(cfg aws.Config) *kms.Client {
+		return kms.NewFromConfig(cfg)
+	})
+}
+
+func (p *ProviderData) s3Client() *s3.Client {
+	return cachedClient(p, "s3", nil, 0, func(cfg aws.Config) *s3.Client {
+		return s3.NewFromConfig(cfg)
+	})
+}
+
+func (p *ProviderData) secretsManagerClient() *secretsmanager.Client {
+	return cachedClient(p, "secretsmanager", nil, 0, func(cfg aws.Config) *secretsmanager.Client {
+		return secretsmanager.NewFromConfig(cfg)
+	})
+}
+
+func (p *ProviderData) ssmClient() *ssm.Client {
+	return cachedClient(p, "ssm", nil, 0, func(cfg aws.Config) *ssm.Client {
+		return ssm.NewFromConfig(cfg)
+	})
+}
+
+func (p *ProviderData) stsClient() *sts.Client {
+	return cachedClient(p, "sts", nil, 0, func(cfg aws.Config) *sts.Client {
+		return sts.NewFromConfig(cfg)
+	})
+}
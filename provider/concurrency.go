@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// defaultMaxConcurrentRequests limits the concurrent requests per service when
+// max_concurrent_requests does not. Connect throttles aggressively, so running
+// Terraform with a high -parallelism would otherwise cause throttling storms.
+var defaultMaxConcurrentRequests = map[string]int64{
+	"connect": 5,
+}
+
+// newSemaphores returns a semaphore per service limited by the configured
+// maximum number of concurrent requests, merged over the defaults. A limit of
+// 0 disables the semaphore of a service.
+func newSemaphores(limits map[string]int64) map[string]chan struct{} {
+	semaphores := map[string]chan struct{}{}
+
+	for service, limit := range defaultMaxConcurrentRequests {
+		if _, ok := limits[service]; !ok && limit > 0 {
+			semaphores[service] = make(chan struct{}, limit)
+		}
+	}
+
+	for service, limit := range limits {
+		if limit > 0 {
+			semaphores[service] = make(chan struct{}, limit)
+		}
+	}
+
+	return semaphores
+}
+
+// concurrencyLimit returns the middleware holding a slot of semaphore for the
+// duration of an API call, including its retries.
+func concurrencyLimit(semaphore chan struct{}) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("AwsExtConcurrencyLimit", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return middleware.InitializeOutput{}, middleware.Metadata{}, ctx.Err()
+			}
+			defer func() { <-semaphore }()
+
+			return next.HandleInitialize(ctx, in)
+		}), middleware.Before)
+	}
+}
+
+// forEachConcurrently calls fn for every item with at most limit concurrent
+// calls, e.g. to create the users of a bulk resource, and joins the errors.
+// All items are processed even if some fail.
+func forEachConcurrently[S any](items []S, limit int, fn func(S) error) error {
+	semaphore := make(chan struct{}, limit)
+	errs := make([]error, len(items))
+
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			errs[i] = fn(item)
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ConfigBackupResource{}
+var _ resource.ResourceWithModifyPlan = &ConfigBackupResource{}
+
+// configBackupResourceType is the type of the configuration backup resource
+// without the provider prefix, e.g. in operation_policies.
+const configBackupResourceType = "connect_config_backup"
+
+// configBackupSections are the configuration sections a backup may include,
+// each read with one Connect search of the instance.
+var configBackupSections = []string{
+	"agent_statuses",
+	"contact_flows",
+	"hours_of_operations",
+	"queues",
+	"routing_profiles",
+}
+
+func NewConfigBackupResource() resource.Resource {
+	return &ConfigBackupResource{}
+}
+
+// ConfigBackupResource writes a JSON bundle of the configuration of an
+// instance to S3 on every apply. Destroying it keeps the bundles.
+type ConfigBackupResource struct {
+	providerData *ProviderData
+}
+
+type ConfigBackupResourceModel struct {
+	InstanceID    types.String   `tfsdk:"instance_id"`
+	InstanceAlias types.String   `tfsdk:"instance_alias"`
+	Bucket        types.String   `tfsdk:"bucket"`
+	Prefix        types.String   `tfsdk:"prefix"`
+	KmsKeyID      types.String   `tfsdk:"kms_key_id"`
+	Include       types.Set      `tfsdk:"include"`
+	ObjectKey     types.String   `tfsdk:"object_key"`
+	TakenAt       types.String   `tfsdk:"taken_at"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// configBackupBundle is the JSON document written to S3. Sections hold the
+// resources as returned by the Connect search APIs.
+type configBackupBundle struct {
+	InstanceID string         `json:"instance_id"`
+	TakenAt    string         `json:"taken_at"`
+	Sections   map[string]any `json:"sections"`
+}
+
+func (r *ConfigBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + configBackupResourceType
+}
+
+func (r *ConfigBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	sections := make([]attr.Value, 0, len(configBackupSections))
+	for _, section := range configBackupSections {
+		sections = append(sections, types.StringValue(section))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Writes a JSON bundle of the configuration of a Connect instance to S3 on every apply, for disaster recovery and audit. Destroying the resource keeps the bundles.",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"bucket": schema.StringAttribute{
+				Required:    true,
+				Description: "Bucket the bundles are written to.",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Prefix of the bundle keys, e.g. connect-backups/. Bundles are written to <prefix><instance_id>/<timestamp>.json.",
+			},
+			"kms_key_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "KMS key the bundles are encrypted with. The bucket default encryption applies if unset.",
+			},
+			"include": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, sections)),
+				Description: "Configuration sections included in the bundles. Defaults to all sections.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(configBackupSections...)),
+				},
+			},
+			"object_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Key of the latest bundle.",
+			},
+			"taken_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp of the latest bundle.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *ConfigBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *ConfigBackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+
+	// Every apply writes a new bundle
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("object_key"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("taken_at"), types.StringUnknown())...)
+}
+
+func (r *ConfigBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(configBackupResourceType, resp) {
+		return
+	}
+
+	var data ConfigBackupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(configBackupResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.backup(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Nothing to refresh: the bundles are immutable and the next apply writes
+	// a new one anyway.
+}
+
+func (r *ConfigBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, configBackupResourceType, req, resp) {
+		return
+	}
+
+	var data ConfigBackupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(configBackupResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.backup(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(configBackupResourceType, resp) {
+		return
+	}
+
+	// The bundles are kept for disaster recovery and audit, so there is nothing
+	// to delete.
+}
+
+// backup writes a bundle of the included sections to S3 and sets the key and
+// time of the bundle in the model.
+func (r *ConfigBackupResource) backup(ctx context.Context, data *ConfigBackupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var include []string
+
+	diags.Append(data.Include.ElementsAs(ctx, &include, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	conn := r.providerData.resourceConnectClient(configBackupResourceType, nil)
+	instanceID := data.InstanceID.ValueString()
+	takenAt := time.Now().UTC()
+
+	bundle := configBackupBundle{
+		InstanceID: instanceID,
+		TakenAt:    takenAt.Format(time.RFC3339),
+		Sections:   map[string]any{},
+	}
+
+	for _, section := range configBackupSections {
+		if !slices.Contains(include, section) {
+			continue
+		}
+
+		items, err := configBackupSection(ctx, conn, instanceID, section)
+		if err != nil {
+			diags.Append(apiError("Error reading Connect configuration", fmt.Sprintf("Could not read the %s of instance %s", section, instanceID), err))
+			return diags
+		}
+
+		bundle.Sections[section] = items
+	}
+
+	body, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		diags.AddError("Error encoding Connect configuration", fmt.Sprintf("Could not encode the configuration bundle: %s", err))
+		return diags
+	}
+
+	key := fmt.Sprintf("%s%s/%s.json", data.Prefix.ValueString(), instanceID, takenAt.Format("20060102T150405Z"))
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(data.Bucket.ValueString()),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String("application/json"),
+	}
+
+	if !data.KmsKeyID.IsNull() {
+		input.ServerSideEncryption = s3types.ServerSideEncryptionAwsKms
+		input.SSEKMSKeyId = aws.String(data.KmsKeyID.ValueString())
+	}
+
+	if _, err := r.providerData.s3Client().PutObject(ctx, input); err != nil {
+		diags.Append(apiError("Error writing Connect configuration backup", fmt.Sprintf("Could not write s3://%s/%s", data.Bucket.ValueString(), key), err))
+		return diags
+	}
+
+	data.ObjectKey = types.StringValue(key)
+	data.TakenAt = types.StringValue(bundle.TakenAt)
+
+	return diags
+}
+
+// configBackupSection returns the resources of a section of the instance
+// configuration.
+func configBackupSection(ctx context.Context, conn *connect.Client, instanceID string, section string) (any, error) {
+	switch section {
+	case "agent_statuses":
+		return collectPages(ctx, searchAgentStatuses(conn, instanceID, nil), 0, nil)
+	case "contact_flows":
+		return collectPages(ctx, func(ctx context.Context, nextToken *string) ([]conntypes.ContactFlow, *string, error) {
+			response, err := conn.SearchContactFlows(ctx, &connect.SearchContactFlowsInput{
+				InstanceId: aws.String(instanceID),
+				MaxResults: aws.Int32(searchPageSize),
+				NextToken:  nextToken,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return response.ContactFlows, response.NextToken, nil
+		}, 0, nil)
+	case "hours_of_operations":
+		return collectPages(ctx, func(ctx context.Context, nextToken *string) ([]conntypes.HoursOfOperation, *string, error) {
+			response, err := conn.SearchHoursOfOperations(ctx, &connect.SearchHoursOfOperationsInput{
+				InstanceId: aws.String(instanceID),
+				MaxResults: aws.Int32(searchPageSize),
+				NextToken:  nextToken,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return response.HoursOfOperations, response.NextToken, nil
+		}, 0, nil)
+	case "queues":
+		return collectPages(ctx, func(ctx context.Context, nextToken *string) ([]conntypes.Queue, *string, error) {
+			response, err := conn.SearchQueues(ctx, &connect.SearchQueuesInput{
+				InstanceId: aws.String(instanceID),
+				MaxResults: aws.Int32(searchPageSize),
+				NextToken:  nextToken,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return response.Queues, response.NextToken, nil
+		}, 0, nil)
+	case "routing_profiles":
+		return collectPages(ctx, func(ctx context.Context, nextToken *string) ([]conntypes.RoutingProfile, *string, error) {
+			response, err := conn.SearchRoutingProfiles(ctx, &connect.SearchRoutingProfilesInput{
+				InstanceId: aws.String(instanceID),
+				MaxResults: aws.Int32(searchPageSize),
+				NextToken:  nextToken,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return response.RoutingProfiles, response.NextToken, nil
+		}, 0, nil)
+	}
+
+	return nil, fmt.Errorf("unknown section %s", section)
+}
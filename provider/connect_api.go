@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+)
+
+var _ AgentStatusAPI = (*connect.Client)(nil)
+var _ QueueAPI = (*connect.Client)(nil)
+
+// ConnectTaggingAPI is the part of the Connect API tagging resources, see
+// updateConnectTags.
+type ConnectTaggingAPI interface {
+	ListTagsForResource(ctx context.Context, params *connect.ListTagsForResourceInput, optFns ...func(*connect.Options)) (*connect.ListTagsForResourceOutput, error)
+	TagResource(ctx context.Context, params *connect.TagResourceInput, optFns ...func(*connect.Options)) (*connect.TagResourceOutput, error)
+	UntagResource(ctx context.Context, params *connect.UntagResourceInput, optFns ...func(*connect.Options)) (*connect.UntagResourceOutput, error)
+}
+
+// AgentStatusAPI is the part of the Connect API managing agent statuses. It
+// is implemented by *connect.Client, and by fakes in unit tests.
+type AgentStatusAPI interface {
+	ConnectTaggingAPI
+
+	CreateAgentStatus(ctx context.Context, params *connect.CreateAgentStatusInput, optFns ...func(*connect.Options)) (*connect.CreateAgentStatusOutput, error)
+	DescribeAgentStatus(ctx context.Context, params *connect.DescribeAgentStatusInput, optFns ...func(*connect.Options)) (*connect.DescribeAgentStatusOutput, error)
+	ListAgentStatuses(ctx context.Context, params *connect.ListAgentStatusesInput, optFns ...func(*connect.Options)) (*connect.ListAgentStatusesOutput, error)
+	SearchAgentStatuses(ctx context.Context, params *connect.SearchAgentStatusesInput, optFns ...func(*connect.Options)) (*connect.SearchAgentStatusesOutput, error)
+	UpdateAgentStatus(ctx context.Context, params *connect.UpdateAgentStatusInput, optFns ...func(*connect.Options)) (*connect.UpdateAgentStatusOutput, error)
+}
+
+// QueueAPI is the part of the Connect API managing standard queues. It is
+// implemented by *connect.Client, and by fakes in unit tests.
+type QueueAPI interface {
+	ConnectTaggingAPI
+
+	CreateQueue(ctx context.Context, params *connect.CreateQueueInput, optFns ...func(*connect.Options)) (*connect.CreateQueueOutput, error)
+	DescribeQueue(ctx context.Context, params *connect.DescribeQueueInput, optFns ...func(*connect.Options)) (*connect.DescribeQueueOutput, error)
+	ListQueues(ctx context.Context, params *connect.ListQueuesInput, optFns ...func(*connect.Options)) (*connect.ListQueuesOutput, error)
+	SearchQueues(ctx context.Context, params *connect.SearchQueuesInput, optFns ...func(*connect.Options)) (*connect.SearchQueuesOutput, error)
+	UpdateQueueHoursOfOperation(ctx context.Context, params *connect.UpdateQueueHoursOfOperationInput, optFns ...func(*connect.Options)) (*connect.UpdateQueueHoursOfOperationOutput, error)
+	UpdateQueueMaxContacts(ctx context.Context, params *connect.UpdateQueueMaxContactsInput, optFns ...func(*connect.Options)) (*connect.UpdateQueueMaxContactsOutput, error)
+	UpdateQueueName(ctx context.Context, params *connect.UpdateQueueNameInput, optFns ...func(*connect.Options)) (*connect.UpdateQueueNameOutput, error)
+	UpdateQueueOutboundCallerConfig(ctx context.Context, params *connect.UpdateQueueOutboundCallerConfigInput, optFns ...func(*connect.Options)) (*connect.UpdateQueueOutboundCallerConfigOutput, error)
+	UpdateQueueStatus(ctx context.Context, params *connect.UpdateQueueStatusInput, optFns ...func(*connect.Options)) (*connect.UpdateQueueStatusOutput, error)
+}
+
+// agentStatusClient returns the Connect API of the agent status resource,
+// from the factory set in agentStatusAPI if any.
+func (p *ProviderData) agentStatusClient(override *OverrideModel) AgentStatusAPI {
+	if p.agentStatusAPI != nil {
+		return p.agentStatusAPI(override)
+	}
+
+	return p.resourceConnectClient(agentStatusResourceType, override)
+}
+
+// queueClient returns the Connect API of the queue resource, from the factory
+// set in queueAPI if any.
+func (p *ProviderData) queueClient(override *OverrideModel) QueueAPI {
+	if p.queueAPI != nil {
+		return p.queueAPI(override)
+	}
+
+	return p.resourceConnectClient(queueResourceType, override)
+}
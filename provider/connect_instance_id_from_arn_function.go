@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ConnectInstanceIDFromArnFunction{}
+var _ function.Function = &ConnectResourceIDFromArnFunction{}
+
+func NewConnectInstanceIDFromArnFunction() function.Function {
+	return &ConnectInstanceIDFromArnFunction{}
+}
+
+func NewConnectResourceIDFromArnFunction() function.Function {
+	return &ConnectResourceIDFromArnFunction{}
+}
+
+type ConnectInstanceIDFromArnFunction struct{}
+
+type ConnectResourceIDFromArnFunction struct{}
+
+func (f *ConnectInstanceIDFromArnFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "connect_instance_id_from_arn"
+}
+
+func (f *ConnectInstanceIDFromArnFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Extract the instance ID from a Connect ARN",
+		MarkdownDescription: "Returns the instance ID of a Connect instance ARN or of any instance scoped Connect resource ARN (queue, agent status, contact flow, ...).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "Connect ARN.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ConnectInstanceIDFromArnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+
+	if resp.Error != nil {
+		return
+	}
+
+	instanceID, _, _, err := parseConnectArn(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, instanceID))
+}
+
+func (f *ConnectResourceIDFromArnFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "connect_resource_id_from_arn"
+}
+
+func (f *ConnectResourceIDFromArnFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Extract the sub-resource ID from a Connect ARN",
+		MarkdownDescription: "Returns the ID of the sub-resource of an instance scoped Connect resource ARN, e.g. the queue ID of a queue ARN.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "Connect ARN.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ConnectResourceIDFromArnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+
+	if resp.Error != nil {
+		return
+	}
+
+	_, _, resourceID, err := parseConnectArn(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if resourceID == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%s is an instance ARN and has no sub-resource", input))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, resourceID))
+}
+
+// parseConnectArn splits an instance scoped Connect ARN of the form
+// arn:<partition>:connect:<region>:<account>:instance/<instance-id>[/<type>/<id>]
+// into the instance ID, sub-resource type and sub-resource ID.
+func parseConnectArn(input string) (string, string, string, error) {
+	parsed, err := arn.Parse(input)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if parsed.Service != "connect" {
+		return "", "", "", fmt.Errorf("%s is not a Connect ARN", input)
+	}
+
+	parts := strings.Split(parsed.Resource, "/")
+	if parts[0] != "instance" || len(parts) < 2 || parts[1] == "" {
+		return "", "", "", fmt.Errorf("%s is not an instance scoped Connect ARN", input)
+	}
+
+	switch len(parts) {
+	case 2:
+		return parts[1], "", "", nil
+	case 3:
+		return "", "", "", fmt.Errorf("%s has a sub-resource type but no sub-resource ID", input)
+	default:
+		return parts[1], parts[2], strings.Join(parts[3:], "/"), nil
+	}
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+)
+
+// mockConnectResponse is the canned response of a Connect API route.
+type mockConnectResponse struct {
+	status int
+	body   string
+}
+
+// newMockConnectClient returns a Connect client whose calls are answered by
+// responses, keyed by method and path, e.g. "GET /agent-status/i/s". Calls of
+// other routes fail the test.
+func newMockConnectClient(t *testing.T, responses map[string]mockConnectResponse) *connect.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+
+		response, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected Connect call %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if response.status != 0 {
+			w.WriteHeader(response.status)
+		}
+		_, _ = io.WriteString(w, response.body)
+	}))
+	t.Cleanup(server.Close)
+
+	return connect.New(connect.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "secret", ""),
+		HTTPClient:   server.Client(),
+	})
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ConnectNameSanitizeFunction{}
+
+var (
+	connectNameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9 _.-]+`)
+	connectNameSeparators   = regexp.MustCompile(`[ _.-]*-[ _.-]*`)
+)
+
+// connectNameHashLength is the number of hex characters of the name hash
+// appended to truncated names.
+const connectNameHashLength = 8
+
+func NewConnectNameSanitizeFunction() function.Function {
+	return &ConnectNameSanitizeFunction{}
+}
+
+type ConnectNameSanitizeFunction struct{}
+
+func (f *ConnectNameSanitizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "connect_name_sanitize"
+}
+
+func (f *ConnectNameSanitizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Sanitize a string into a valid Connect resource name",
+		MarkdownDescription: "Replaces characters other than letters, digits, spaces, `_`, `.` and `-` with `-`, collapses repeated " +
+			"separators and trims leading and trailing separators. Names longer than `max_length` are truncated and suffixed with " +
+			"`-` and the first 8 hex characters of the SHA-256 of the input, so distinct inputs keep distinct names and the same " +
+			"input always yields the same name.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "String to sanitize, e.g. a `for_each` key.",
+			},
+			function.Int64Parameter{
+				Name:                "max_length",
+				MarkdownDescription: "Maximum length of the name, `127` for most Connect resources and `64` for security profiles.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ConnectNameSanitizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	var maxLength int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &maxLength))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if maxLength <= connectNameHashLength+1 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("max_length must be greater than %d", connectNameHashLength+1))
+		return
+	}
+
+	sanitized, err := sanitizeConnectName(name, int(maxLength))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, sanitized))
+}
+
+// sanitizeConnectName converts name into a valid Connect resource name of at
+// most maxLength characters.
+func sanitizeConnectName(name string, maxLength int) (string, error) {
+	sanitized := connectNameInvalidChars.ReplaceAllString(name, "-")
+	sanitized = connectNameSeparators.ReplaceAllString(sanitized, "-")
+	sanitized = strings.Trim(sanitized, " _.-")
+
+	if sanitized == "" {
+		return "", fmt.Errorf("%q does not contain any character allowed in a Connect name", name)
+	}
+
+	if len(sanitized) <= maxLength {
+		return sanitized, nil
+	}
+
+	hash := sha256.Sum256([]byte(name))
+	suffix := "-" + hex.EncodeToString(hash[:])[:connectNameHashLength]
+
+	truncated := strings.TrimRight(sanitized[:maxLength-len(suffix)], " _.-")
+
+	return truncated + suffix, nil
+}
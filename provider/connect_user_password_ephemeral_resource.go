@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &ConnectUserPasswordEphemeralResource{}
+
+// Connect requires user passwords of 8 to 64 characters with at least one
+// upper case letter, one lower case letter and one number.
+const (
+	connectPasswordMinLength     = 8
+	connectPasswordMaxLength     = 64
+	connectPasswordDefaultLength = 16
+)
+
+// connectPasswordSpecialCharacters are the special characters used in
+// generated passwords, avoiding quotes and backslashes which are awkward to
+// pass around in scripts.
+const connectPasswordSpecialCharacters = "!#$%&*+-.:=?@^_~"
+
+// connectPasswordClasses are the character classes of generated passwords,
+// each used at least once.
+var connectPasswordClasses = []string{
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"abcdefghijklmnopqrstuvwxyz",
+	"0123456789",
+}
+
+func NewConnectUserPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &ConnectUserPasswordEphemeralResource{}
+}
+
+type ConnectUserPasswordEphemeralResource struct{}
+
+type ConnectUserPasswordEphemeralResourceModel struct {
+	Length   types.Int32  `tfsdk:"length"`
+	Special  types.Bool   `tfsdk:"special"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (r *ConnectUserPasswordEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_user_password"
+}
+
+func (r *ConnectUserPasswordEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Random password satisfying the Connect user password policy, for the write-only password of users created in instances managing their own users",
+
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Length of the password, between 8 and 64. Defaults to 16.",
+				Validators: []validator.Int32{
+					int32validator.Between(connectPasswordMinLength, connectPasswordMaxLength),
+				},
+			},
+			"special": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the password contains at least one special character. Defaults to true.",
+			},
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *ConnectUserPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ConnectUserPasswordEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := connectPasswordDefaultLength
+	if !data.Length.IsNull() {
+		length = int(data.Length.ValueInt32())
+	}
+
+	password, err := generateConnectPassword(length, data.Special.IsNull() || data.Special.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating Connect User Password", err.Error())
+		return
+	}
+
+	data.Password = types.StringValue(password)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// generateConnectPassword returns a random password of the given length
+// containing every character class of connectPasswordClasses, and a special
+// character if special is set.
+func generateConnectPassword(length int, special bool) (string, error) {
+	classes := connectPasswordClasses
+	if special {
+		classes = append(classes[:len(classes):len(classes)], connectPasswordSpecialCharacters)
+	}
+
+	var all string
+	password := make([]byte, 0, length)
+
+	// One character of every class first, the rest from all classes
+	for _, class := range classes {
+		all += class
+
+		c, err := randomCharacter(class)
+		if err != nil {
+			return "", err
+		}
+
+		password = append(password, c)
+	}
+
+	for len(password) < length {
+		c, err := randomCharacter(all)
+		if err != nil {
+			return "", err
+		}
+
+		password = append(password, c)
+	}
+
+	// Shuffle so that the required characters are not always first
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+func randomCharacter(characters string) (byte, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(characters))))
+	if err != nil {
+		return 0, err
+	}
+
+	return characters[i.Int64()], nil
+}
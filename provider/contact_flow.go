@@ -0,0 +1,548 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ContactFlowResource{}
+var _ resource.ResourceWithImportState = &ContactFlowResource{}
+var _ resource.ResourceWithModifyPlan = &ContactFlowResource{}
+var _ resource.ResourceWithIdentity = &ContactFlowResource{}
+var _ resource.ResourceWithMoveState = &ContactFlowResource{}
+
+// contactFlowResourceType is the type of the contact flow resource without
+// the provider prefix, e.g. in operation_policies.
+const contactFlowResourceType = "connect_contact_flow"
+
+// savedFlowQualifier selects the saved (draft) content of a flow rather than
+// its published content when appended to the flow ID.
+const savedFlowQualifier = ":$SAVED"
+
+func NewContactFlowResource() resource.Resource {
+	return &ContactFlowResource{}
+}
+
+type ContactFlowResource struct {
+	providerData *ProviderData
+}
+
+type ContactFlowResourceModel struct {
+	Arn           types.String   `tfsdk:"arn"`
+	ContactFlowID types.String   `tfsdk:"contact_flow_id"`
+	InstanceID    types.String   `tfsdk:"instance_id"`
+	InstanceAlias types.String   `tfsdk:"instance_alias"`
+	Name          types.String   `tfsdk:"name"`
+	Description   NullableString `tfsdk:"description"`
+	Type          types.String   `tfsdk:"type"`
+	Content       FlowContent    `tfsdk:"content"`
+	Status        types.String   `tfsdk:"status"`
+	CreateVersion types.Bool     `tfsdk:"create_version"`
+	Version       types.Int64    `tfsdk:"version"`
+	Tags          types.Map      `tfsdk:"tags"`
+	TagsAll       types.Map      `tfsdk:"tags_all"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	Override      *OverrideModel `tfsdk:"override"`
+}
+
+type ContactFlowResourceIdentityModel struct {
+	Arn           types.String `tfsdk:"arn"`
+	ContactFlowID types.String `tfsdk:"contact_flow_id"`
+}
+
+func (m ContactFlowResourceModel) identity() ContactFlowResourceIdentityModel {
+	return ContactFlowResourceIdentityModel{
+		Arn:           m.Arn,
+		ContactFlowID: m.ContactFlowID,
+	}
+}
+
+// qualifiedID returns the ID of the flow qualified to select the content of
+// its status.
+func (m ContactFlowResourceModel) qualifiedID() string {
+	if m.Status.ValueString() == string(conntypes.ContactFlowStatusSaved) {
+		return m.ContactFlowID.ValueString() + savedFlowQualifier
+	}
+
+	return m.ContactFlowID.ValueString()
+}
+
+// publishesVersion reports whether applying the model creates a flow version.
+func (m ContactFlowResourceModel) publishesVersion() bool {
+	return m.CreateVersion.ValueBool() && m.Status.ValueString() == string(conntypes.ContactFlowStatusPublished)
+}
+
+func (r *ContactFlowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + contactFlowResourceType
+}
+
+func (r *ContactFlowResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = connectIdentitySchema("contact_flow_id")
+}
+
+func (r *ContactFlowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	flowTypes := []string{}
+	for _, flowType := range conntypes.ContactFlowType("").Values() {
+		flowTypes = append(flowTypes, string(flowType))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connect flow resource. The flow content is compared after normalization, so formatting and canvas layout changes made by Connect or the flow designer cause no diff",
+
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"contact_flow_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 127),
+				},
+			},
+			"description": schema.StringAttribute{
+				CustomType: NullableStringType{},
+				Optional:   true,
+				Validators: []validator.String{
+					// Empty is allowed and the same as no description
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(conntypes.ContactFlowTypeContactFlow)),
+				Description: "Type of the flow, e.g. CONTACT_FLOW or CUSTOMER_QUEUE. Changing it replaces the flow.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(flowTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				CustomType:  FlowContentType{},
+				Required:    true,
+				Description: "Flow language JSON of the flow, e.g. exported from the flow designer. Differences in key ordering, whitespace, canvas layout or action ordering are ignored.",
+				Validators: []validator.String{
+					validFlowContent(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(conntypes.ContactFlowStatusPublished)),
+				Description: "PUBLISHED to publish the content, or SAVED to only save it as a draft, leaving the published content, if any, in use. The content of the status is read back.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(conntypes.ContactFlowStatusPublished), string(conntypes.ContactFlowStatusSaved)),
+				},
+			},
+			"create_version": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Create an immutable flow version whenever the content is published.",
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Latest version of the flow, when create_version is set.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *ContactFlowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *ContactFlowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanTags(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+
+	// Nothing to compare on create or destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ContactFlowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new version is created whenever the content is published
+	if plan.publishesVersion() && (contentChanged(ctx, state.Content, plan.Content) || !plan.Status.Equal(state.Status)) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version"), types.Int64Unknown())...)
+	}
+}
+
+// contentChanged reports whether the planned flow content differs from the
+// prior one other than by formatting.
+func contentChanged(ctx context.Context, prior, planned FlowContent) bool {
+	equal, _ := prior.StringSemanticEquals(ctx, planned)
+
+	return !equal
+}
+
+func (r *ContactFlowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(contactFlowResourceType, resp) {
+		return
+	}
+
+	var data ContactFlowResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	tagsAll, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(contactFlowResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(contactFlowResourceType, data.Override)
+	input := &connect.CreateContactFlowInput{
+		InstanceId:  aws.String(data.InstanceID.ValueString()),
+		Name:        aws.String(data.Name.ValueString()),
+		Description: aws.String(data.Description.ValueString()),
+		Type:        conntypes.ContactFlowType(data.Type.ValueString()),
+		Content:     aws.String(data.Content.ValueString()),
+		Status:      conntypes.ContactFlowStatus(data.Status.ValueString()),
+	}
+
+	if len(tagsAll) > 0 {
+		input.Tags = tagsAll
+	}
+
+	response, err := conn.CreateContactFlow(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect Flow", "Could not create Connect Flow", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	data.ContactFlowID = types.StringValue(aws.ToString(response.ContactFlowId))
+	data.Arn = types.StringValue(aws.ToString(response.ContactFlowArn))
+	data.Version = types.Int64Null()
+
+	// Save the flow before creating its version, so it is not orphaned if
+	// that fails
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+
+	if resp.Diagnostics.HasError() || !data.publishesVersion() {
+		return
+	}
+
+	version, err := createContactFlowVersion(ctx, conn, data)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect Flow version", fmt.Sprintf("Could not create a version of Connect Flow %s", data.ContactFlowID.ValueString()), err))
+		return
+	}
+
+	data.Version = types.Int64Value(version)
+
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *ContactFlowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ContactFlowResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(contactFlowResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(contactFlowResourceType, data.Override)
+	response, err := conn.DescribeContactFlow(ctx, &connect.DescribeContactFlowInput{
+		InstanceId:    aws.String(data.InstanceID.ValueString()),
+		ContactFlowId: aws.String(data.qualifiedID()),
+	})
+
+	// Flows published since they were saved may have no saved content left
+	if isNotFound(err) && data.qualifiedID() != data.ContactFlowID.ValueString() {
+		response, err = conn.DescribeContactFlow(ctx, &connect.DescribeContactFlowInput{
+			InstanceId:    aws.String(data.InstanceID.ValueString()),
+			ContactFlowId: aws.String(data.ContactFlowID.ValueString()),
+		})
+	}
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Flow", "Could not read Connect Flow", err))
+		return
+	}
+
+	flow := response.ContactFlow
+
+	data.ContactFlowID = types.StringValue(aws.ToString(flow.Id))
+	data.Arn = types.StringValue(aws.ToString(flow.Arn))
+	data.Name = types.StringValue(aws.ToString(flow.Name))
+	data.Description = flattenNullableString(ctx, data.Description, flow.Description)
+	data.Type = types.StringValue(string(flow.Type))
+	data.Status = types.StringValue(string(flow.Status))
+
+	// The content is kept as configured when it only differs by formatting
+	// or layout, by the semantic equality of FlowContent
+	data.Content = FlowContent{StringValue: types.StringValue(aws.ToString(flow.Content))}
+
+	if data.CreateVersion.ValueBool() {
+		versions, err := collectPages(ctx, listContactFlowVersions(conn, data.InstanceID.ValueString(), data.ContactFlowID.ValueString()), 0, nil)
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error reading Connect Flow", "Could not list the versions of Connect Flow", err))
+			return
+		}
+
+		data.Version = types.Int64Null()
+		for _, version := range versions {
+			if aws.ToInt64(version.Version) > data.Version.ValueInt64() {
+				data.Version = types.Int64Value(aws.ToInt64(version.Version))
+			}
+		}
+	}
+
+	data.Tags, data.TagsAll, diags = r.providerData.readTags(ctx, flow.Tags, data.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *ContactFlowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, contactFlowResourceType, req, resp) {
+		return
+	}
+
+	var data, state ContactFlowResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(contactFlowResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(contactFlowResourceType, data.Override)
+
+	if !data.Name.Equal(state.Name) || !data.Description.Equal(state.Description) {
+		_, err := conn.UpdateContactFlowName(ctx, &connect.UpdateContactFlowNameInput{
+			InstanceId:    aws.String(data.InstanceID.ValueString()),
+			ContactFlowId: aws.String(data.ContactFlowID.ValueString()),
+			Name:          aws.String(data.Name.ValueString()),
+			Description:   aws.String(data.Description.ValueString()),
+		})
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Flow", "Could not update the name of Connect Flow", err))
+			return
+		}
+	}
+
+	// Publishing a saved flow sends its content again, unqualified
+	if contentChanged(ctx, state.Content, data.Content) || !data.Status.Equal(state.Status) {
+		_, err := conn.UpdateContactFlowContent(ctx, &connect.UpdateContactFlowContentInput{
+			InstanceId:    aws.String(data.InstanceID.ValueString()),
+			ContactFlowId: aws.String(data.qualifiedID()),
+			Content:       aws.String(data.Content.ValueString()),
+		})
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Flow", "Could not update the content of Connect Flow", err))
+			return
+		}
+
+		if data.publishesVersion() {
+			version, err := createContactFlowVersion(ctx, conn, data)
+
+			if err != nil {
+				resp.Diagnostics.Append(apiError("Error creating Connect Flow version", fmt.Sprintf("Could not create a version of Connect Flow %s", data.ContactFlowID.ValueString()), err))
+				return
+			}
+
+			data.Version = types.Int64Value(version)
+		}
+	}
+
+	if data.Version.IsUnknown() {
+		data.Version = types.Int64Null()
+	}
+
+	if !data.TagsAll.Equal(state.TagsAll) {
+		oldTags, diags := mapFromTags(ctx, state.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		newTags, diags := mapFromTags(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), oldTags, newTags); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Flow tags", "Could not update Connect Flow tags", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContactFlowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(contactFlowResourceType, resp) {
+		return
+	}
+
+	var data ContactFlowResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(contactFlowResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(contactFlowResourceType, data.Override)
+	_, err := conn.DeleteContactFlow(ctx, &connect.DeleteContactFlowInput{
+		InstanceId:    aws.String(data.InstanceID.ValueString()),
+		ContactFlowId: aws.String(data.ContactFlowID.ValueString()),
+	})
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error deleting Connect Flow", "Could not delete Connect Flow", err))
+	}
+}
+
+func (r *ContactFlowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importConnectResource(ctx, req, resp, "contact-flow", "contact_flow_id")
+}
+
+// MoveState migrates the state of aws_connect_contact_flow in moved blocks.
+func (r *ContactFlowResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{connectStateMover("aws_connect_contact_flow", "contact_flow_id")}
+}
+
+// createContactFlowVersion creates a version from the published content of
+// the flow and returns its number.
+func createContactFlowVersion(ctx context.Context, conn *connect.Client, data ContactFlowResourceModel) (int64, error) {
+	response, err := conn.CreateContactFlowVersion(ctx, &connect.CreateContactFlowVersionInput{
+		InstanceId:    aws.String(data.InstanceID.ValueString()),
+		ContactFlowId: aws.String(data.ContactFlowID.ValueString()),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return aws.ToInt64(response.Version), nil
+}
+
+func listContactFlowVersions(conn *connect.Client, instanceID, contactFlowID string) pageLister[conntypes.ContactFlowVersionSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.ContactFlowVersionSummary, *string, error) {
+		response, err := conn.ListContactFlowVersions(ctx, &connect.ListContactFlowVersionsInput{
+			InstanceId:    aws.String(instanceID),
+			ContactFlowId: aws.String(contactFlowID),
+			MaxResults:    aws.Int32(100),
+			NextToken:     nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.ContactFlowVersionSummaryList, response.NextToken, nil
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ContactFlowNormalizeFunction{}
+
+func NewContactFlowNormalizeFunction() function.Function {
+	return &ContactFlowNormalizeFunction{}
+}
+
+type ContactFlowNormalizeFunction struct{}
+
+func (f *ContactFlowNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "contact_flow_normalize"
+}
+
+func (f *ContactFlowNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Canonicalize Connect flow JSON",
+		MarkdownDescription: "Returns the flow language JSON with sorted keys and no insignificant whitespace, " +
+			"with the flow designer layout (action positions, sizes, entry point position) removed from `Metadata` " +
+			"and with `Actions` ordered by `Identifier`, so that diffs only show semantic changes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "Flow language JSON.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ContactFlowNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &content))
+
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeFlowContent(content)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ContactFlowReferencesFunction{}
+
+func NewContactFlowReferencesFunction() function.Function {
+	return &ContactFlowReferencesFunction{}
+}
+
+type ContactFlowReferencesFunction struct{}
+
+func (f *ContactFlowReferencesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "contact_flow_references"
+}
+
+func (f *ContactFlowReferencesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "List the ARNs referenced by Connect flow JSON",
+		MarkdownDescription: "Returns the set of ARNs (queues, prompts, Lambda functions, flow modules, Lex bots, ...) referenced anywhere in the flow language JSON.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "Flow language JSON.",
+			},
+		},
+		Return: function.SetReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ContactFlowReferencesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &content))
+
+	if resp.Error != nil {
+		return
+	}
+
+	references, err := flowReferences(content)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, references))
+}
@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CurrentMetricDataDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &CurrentMetricDataDataSource{}
+
+func NewCurrentMetricDataDataSource() datasource.DataSource {
+	return &CurrentMetricDataDataSource{}
+}
+
+type CurrentMetricDataDataSource struct {
+	providerData *ProviderData
+}
+
+type CurrentMetricDataDataSourceModel struct {
+	InstanceID        types.String               `tfsdk:"instance_id"`
+	InstanceAlias     types.String               `tfsdk:"instance_alias"`
+	QueueIDs          types.Set                  `tfsdk:"queue_ids"`
+	RoutingProfileIDs types.Set                  `tfsdk:"routing_profile_ids"`
+	Channels          types.Set                  `tfsdk:"channels"`
+	Groupings         types.List                 `tfsdk:"groupings"`
+	Metrics           types.List                 `tfsdk:"metrics"`
+	MaxResults        types.Int64                `tfsdk:"max_results"`
+	DataSnapshotTime  types.String               `tfsdk:"data_snapshot_time"`
+	Results           []CurrentMetricResultModel `tfsdk:"results"`
+}
+
+type CurrentMetricResultModel struct {
+	Dimensions types.Map `tfsdk:"dimensions"`
+	Metrics    types.Map `tfsdk:"metrics"`
+}
+
+func (d *CurrentMetricDataDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_current_metric_data"
+}
+
+func (d *CurrentMetricDataDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Real-time metrics of a Connect instance from GetCurrentMetricData, e.g. the agents online or the contacts in queue of each queue",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"queue_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Queues to return metrics for.",
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 100),
+				},
+			},
+			"routing_profile_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Routing profiles to return metrics for.",
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 100),
+				},
+			},
+			"channels": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Channels to return metrics for, e.g. VOICE.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("VOICE", "CHAT", "TASK", "EMAIL")),
+				},
+			},
+			"groupings": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Dimensions the metrics are grouped by, e.g. QUEUE.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("QUEUE", "CHANNEL", "ROUTING_PROFILE", "ROUTING_STEP_EXPRESSION", "AGENT_STATUS")),
+				},
+			},
+			"metrics": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Metrics to return, e.g. AGENTS_ONLINE or CONTACTS_IN_QUEUE.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(
+						"AGENTS_ONLINE", "AGENTS_AVAILABLE", "AGENTS_ON_CALL", "AGENTS_NON_PRODUCTIVE", "AGENTS_AFTER_CONTACT_WORK",
+						"AGENTS_ERROR", "AGENTS_STAFFED", "CONTACTS_IN_QUEUE", "OLDEST_CONTACT_AGE", "CONTACTS_SCHEDULED",
+						"AGENTS_ON_CONTACT", "SLOTS_ACTIVE", "SLOTS_AVAILABLE",
+					)),
+				},
+			},
+			"max_results": maxResultsAttribute(),
+			"data_snapshot_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp at which the metrics were retrieved and cached for pagination.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dimensions": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Values of the groupings of the result, e.g. the queue ID by QUEUE.",
+						},
+						"metrics": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.Float64Type,
+							Description: "Metric values by name. OLDEST_CONTACT_AGE is in seconds.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CurrentMetricDataDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		// The API requires a queue or routing profile filter
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("queue_ids"),
+			path.MatchRoot("routing_profile_ids"),
+		),
+	}
+}
+
+func (d *CurrentMetricDataDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *CurrentMetricDataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CurrentMetricDataDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.input(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.providerData.connectClient(nil)
+
+	// Pages are served from the snapshot taken by the first request
+	var snapshotTime *time.Time
+	results, err := collectPages(ctx, func(ctx context.Context, nextToken *string) ([]conntypes.CurrentMetricResult, *string, error) {
+		pageInput := *input
+		pageInput.MaxResults = pageSize(data.MaxResults.ValueInt64(), 100)
+		pageInput.NextToken = nextToken
+
+		response, err := conn.GetCurrentMetricData(ctx, &pageInput)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if snapshotTime == nil {
+			snapshotTime = response.DataSnapshotTime
+		}
+
+		return response.MetricResults, response.NextToken, nil
+	}, data.MaxResults.ValueInt64(), nil)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error getting Connect Current Metric Data", "Could not get Connect current metric data", err))
+		return
+	}
+
+	data.DataSnapshotTime = types.StringNull()
+	if snapshotTime != nil {
+		data.DataSnapshotTime = types.StringValue(snapshotTime.Format(time.RFC3339))
+	}
+
+	data.Results = make([]CurrentMetricResultModel, 0, len(results))
+	for _, result := range results {
+		model, diags := flattenCurrentMetricResult(ctx, result)
+		resp.Diagnostics.Append(diags...)
+		data.Results = append(data.Results, model)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// input returns the GetCurrentMetricData input of the configuration.
+func (m CurrentMetricDataDataSourceModel) input(ctx context.Context) (*connect.GetCurrentMetricDataInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var channels, groupings, metrics []string
+
+	input := &connect.GetCurrentMetricDataInput{
+		InstanceId: aws.String(m.InstanceID.ValueString()),
+		Filters:    &conntypes.Filters{},
+	}
+
+	diags.Append(m.QueueIDs.ElementsAs(ctx, &input.Filters.Queues, false)...)
+	diags.Append(m.RoutingProfileIDs.ElementsAs(ctx, &input.Filters.RoutingProfiles, false)...)
+	diags.Append(m.Channels.ElementsAs(ctx, &channels, false)...)
+	diags.Append(m.Groupings.ElementsAs(ctx, &groupings, false)...)
+	diags.Append(m.Metrics.ElementsAs(ctx, &metrics, false)...)
+
+	for _, channel := range channels {
+		input.Filters.Channels = append(input.Filters.Channels, conntypes.Channel(channel))
+	}
+
+	for _, grouping := range groupings {
+		input.Groupings = append(input.Groupings, conntypes.Grouping(grouping))
+	}
+
+	for _, metric := range metrics {
+		unit := conntypes.UnitCount
+		if metric == string(conntypes.CurrentMetricNameOldestContactAge) {
+			unit = conntypes.UnitSeconds
+		}
+
+		input.CurrentMetrics = append(input.CurrentMetrics, conntypes.CurrentMetric{
+			Name: conntypes.CurrentMetricName(metric),
+			Unit: unit,
+		})
+	}
+
+	return input, diags
+}
+
+// flattenCurrentMetricResult converts a metric result of GetCurrentMetricData,
+// keying its dimensions by grouping and its values by metric name.
+func flattenCurrentMetricResult(ctx context.Context, result conntypes.CurrentMetricResult) (CurrentMetricResultModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	dimensions := map[string]string{}
+	if result.Dimensions != nil {
+		if result.Dimensions.Queue != nil {
+			dimensions[string(conntypes.GroupingQueue)] = aws.ToString(result.Dimensions.Queue.Id)
+		}
+
+		if result.Dimensions.Channel != "" {
+			dimensions[string(conntypes.GroupingChannel)] = string(result.Dimensions.Channel)
+		}
+
+		if result.Dimensions.RoutingProfile != nil {
+			dimensions[string(conntypes.GroupingRoutingProfile)] = aws.ToString(result.Dimensions.RoutingProfile.Id)
+		}
+
+		if result.Dimensions.RoutingStepExpression != nil {
+			dimensions[string(conntypes.GroupingRoutingStepExpression)] = aws.ToString(result.Dimensions.RoutingStepExpression)
+		}
+
+		if result.Dimensions.AgentStatus != nil {
+			dimensions[string(conntypes.GroupingAgentStatus)] = aws.ToString(result.Dimensions.AgentStatus.Id)
+		}
+	}
+
+	model := CurrentMetricResultModel{}
+
+	value, d := types.MapValueFrom(ctx, types.StringType, dimensions)
+	diags.Append(d...)
+	model.Dimensions = value
+
+	values := make(map[string]attr.Value, len(result.Collections))
+	for _, collection := range result.Collections {
+		if collection.Metric == nil {
+			continue
+		}
+
+		values[string(collection.Metric.Name)] = types.Float64PointerValue(collection.Value)
+	}
+
+	value, d = types.MapValue(types.Float64Type, values)
+	diags.Append(d...)
+	model.Metrics = value
+
+	return model, diags
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// deferOnUnknownInstance defers the planned resource, when Terraform allows
+// it, if its instance_id or instance_alias is unknown, e.g. because the
+// Connect instance is created in the same plan. An unknown instance_id is
+// expected when the instance_alias is known, as it is only resolved by
+// modifyPlanInstanceAlias. It reports whether the resource was deferred, in
+// which case the rest of the plan modification must be skipped.
+func deferOnUnknownInstance(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) bool {
+	// Nothing to defer on destroy
+	if req.Plan.Raw.IsNull() || !req.ClientCapabilities.DeferralAllowed {
+		return false
+	}
+
+	var instanceID types.String
+	var instanceAlias types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("instance_id"), &instanceID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("instance_alias"), &instanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return false
+	}
+
+	if !instanceAlias.IsUnknown() && (!instanceID.IsUnknown() || !instanceAlias.IsNull()) {
+		return false
+	}
+
+	tflog.Debug(ctx, "Deferring resource with an unknown instance_id or instance_alias")
+
+	resp.Deferred = &resource.Deferred{
+		Reason: resource.DeferredReasonResourceConfigUnknown,
+	}
+
+	return true
+}
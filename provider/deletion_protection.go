@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttributeName is the name of the attribute protecting
+// destructive resources, e.g. instances and phone numbers, from deletion.
+const deletionProtectionAttributeName = "deletion_protection"
+
+// deletionProtectionAttribute returns the schema of the deletion_protection
+// attribute shared by destructive resources.
+func deletionProtectionAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+		Description: "Prevent the resource from being destroyed. It must be set to false and applied before the resource can be destroyed.",
+	}
+}
+
+// checkDeletionProtection returns an error diagnostic when the deletion
+// protection of the resource in state is enabled. Delete must not proceed if
+// the returned diagnostics contain an error.
+func checkDeletionProtection(ctx context.Context, state tfsdk.State, resourceName string) diag.Diagnostics {
+	var enabled types.Bool
+
+	diags := state.GetAttribute(ctx, path.Root(deletionProtectionAttributeName), &enabled)
+	if diags.HasError() || !enabled.ValueBool() {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root(deletionProtectionAttributeName),
+		"Deletion Protection Enabled",
+		"Cannot destroy "+resourceName+" while deletion_protection is enabled. Set deletion_protection to false and apply before destroying it.",
+	)
+
+	return diags
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"sync"
+	"time"
+)
+
+// describeCacheTTL bounds how long a described resource is reused. The
+// provider process lives for a single plan or apply, so the TTL only matters
+// for long runs.
+const describeCacheTTL = 5 * time.Minute
+
+// describeCache holds the results of Describe calls by ARN, so resources and
+// data sources describing the same resource in one run call the API once.
+type describeCache struct {
+	mu      sync.Mutex
+	entries map[string]describeCacheEntry
+}
+
+type describeCacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+// cachedDescribe returns the cached description of the resource with the
+// given ARN, calling describe on a miss. Errors are not cached, nor are
+// resources without an ARN, e.g. in a partial state.
+func cachedDescribe[T any](p *ProviderData, arn string, describe func() (T, error)) (T, error) {
+	if arn == "" {
+		return describe()
+	}
+
+	p.describeCache.mu.Lock()
+	entry, ok := p.describeCache.entries[arn]
+	p.describeCache.mu.Unlock()
+
+	if value, isT := entry.value.(T); ok && isT && time.Now().Before(entry.expires) {
+		return value, nil
+	}
+
+	value, err := describe()
+	if err != nil {
+		return value, err
+	}
+
+	p.describeCache.mu.Lock()
+	defer p.describeCache.mu.Unlock()
+
+	if p.describeCache.entries == nil {
+		p.describeCache.entries = map[string]describeCacheEntry{}
+	}
+
+	p.describeCache.entries[arn] = describeCacheEntry{
+		value:   value,
+		expires: time.Now().Add(describeCacheTTL),
+	}
+
+	return value, nil
+}
+
+// invalidateDescribe drops the cached description of a resource, which must
+// be done whenever the resource is changed.
+func (p *ProviderData) invalidateDescribe(arn string) {
+	p.describeCache.mu.Lock()
+	defer p.describeCache.mu.Unlock()
+
+	delete(p.describeCache.entries, arn)
+}
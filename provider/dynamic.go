@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// dynamicToGo converts a known Terraform value of any type into plain Go
+// values: nil, string, bool, *big.Float, []any and map[string]any. It is used
+// by functions taking free form objects as arguments.
+func dynamicToGo(value attr.Value) (any, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return dynamicToGo(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		return v.ValueBigFloat(), nil
+	case basetypes.Int64Value:
+		return v.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.ObjectValue:
+		return dynamicMapToGo(v.Attributes())
+	case basetypes.MapValue:
+		return dynamicMapToGo(v.Elements())
+	case basetypes.ListValue:
+		return dynamicListToGo(v.Elements())
+	case basetypes.TupleValue:
+		return dynamicListToGo(v.Elements())
+	case basetypes.SetValue:
+		return dynamicListToGo(v.Elements())
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func dynamicMapToGo(elements map[string]attr.Value) (map[string]any, error) {
+	result := make(map[string]any, len(elements))
+
+	for key, element := range elements {
+		value, err := dynamicToGo(element)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		result[key] = value
+	}
+
+	return result, nil
+}
+
+func dynamicListToGo(elements []attr.Value) ([]any, error) {
+	result := make([]any, 0, len(elements))
+
+	for i, element := range elements {
+		value, err := dynamicToGo(element)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+// sortedKeys returns the keys of m in lexical order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &E164NormalizeFunction{}
+
+var (
+	e164Pattern        = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	phoneSeparators    = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "/", "", "\t", "")
+	countryCodePattern = regexp.MustCompile(`^[1-9][0-9]{0,2}$`)
+
+	// trunkZeroCountryCodes lists the countries whose national numbers keep
+	// their leading 0 in E.164, e.g. +39 06 for Rome.
+	trunkZeroCountryCodes = map[string]bool{"39": true, "378": true}
+)
+
+func NewE164NormalizeFunction() function.Function {
+	return &E164NormalizeFunction{}
+}
+
+type E164NormalizeFunction struct{}
+
+func (f *E164NormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "e164_normalize"
+}
+
+func (f *E164NormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a phone number to E.164",
+		MarkdownDescription: "Strips formatting characters from a phone number and returns it in E.164 format (`+<country code><number>`). " +
+			"Numbers without an international prefix (`+` or `00`) are assumed to be national numbers of `default_country_code`, " +
+			"in which case a leading trunk prefix `0` is removed, except for Italy and San Marino where it is part of the number. " +
+			"A trunk prefix written `(0)` after the country code, e.g. `+44 (0)20 7946 0958`, is removed. An error is returned when the result is not a valid E.164 number.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "number",
+				MarkdownDescription: "Phone number to normalize, e.g. `(555) 123-4567` or `+44 20 7946 0958`.",
+			},
+			function.StringParameter{
+				Name:                "default_country_code",
+				MarkdownDescription: "Country calling code used for national numbers, e.g. `1` or `44`. A leading `+` is ignored.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *E164NormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var number, countryCode string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &number, &countryCode))
+
+	if resp.Error != nil {
+		return
+	}
+
+	countryCode = strings.TrimPrefix(strings.TrimSpace(countryCode), "+")
+	if !countryCodePattern.MatchString(countryCode) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("%q is not a valid country calling code", countryCode))
+		return
+	}
+
+	normalized := normalizeE164(number, countryCode)
+	if !e164Pattern.MatchString(normalized) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid phone number", number))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalized))
+}
+
+// normalizeE164 converts number to E.164 using countryCode for national
+// numbers. The result is not validated.
+func normalizeE164(number string, countryCode string) string {
+	number = strings.TrimSpace(number)
+
+	// The trunk prefix of international numbers is dialed from within the
+	// country only, e.g. +44 (0)20 for London
+	if strings.HasPrefix(number, "+") || strings.HasPrefix(number, "00") {
+		number = strings.Replace(number, "(0)", "", 1)
+	}
+
+	digits := phoneSeparators.Replace(number)
+
+	switch {
+	case strings.HasPrefix(digits, "+"):
+		return digits
+	case strings.HasPrefix(digits, "00"):
+		return "+" + digits[2:]
+	case countryCode == "1" && strings.HasPrefix(digits, "011"):
+		return "+" + digits[3:]
+	case countryCode == "1" && len(digits) == 11 && strings.HasPrefix(digits, "1"):
+		// NANP numbers are commonly written with the country code but no +
+		return "+" + digits
+	case countryCode != "1" && !trunkZeroCountryCodes[countryCode] && strings.HasPrefix(digits, "0"):
+		return "+" + countryCode + digits[1:]
+	default:
+		return "+" + countryCode + digits
+	}
+}
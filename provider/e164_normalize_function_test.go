@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls f with args, result being the unknown value of its
+// return type, and returns its result or error.
+func runFunction(f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData(args)}
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+
+	f.Run(context.Background(), req, &resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestE164Normalize(t *testing.T) {
+	tests := map[string]struct {
+		number      string
+		countryCode string
+		want        string
+		wantError   string
+	}{
+		"international": {
+			number:      "+44 20 7946 0958",
+			countryCode: "1",
+			want:        "+442079460958",
+		},
+		"international with 00": {
+			number:      "0044 20 7946 0958",
+			countryCode: "1",
+			want:        "+442079460958",
+		},
+		"international with a trunk prefix": {
+			number:      "+44 (0)20 7946 0958",
+			countryCode: "1",
+			want:        "+442079460958",
+		},
+		"NANP national": {
+			number:      "(555) 123-4567",
+			countryCode: "1",
+			want:        "+15551234567",
+		},
+		"NANP with country code": {
+			number:      "1-555-123-4567",
+			countryCode: "+1",
+			want:        "+15551234567",
+		},
+		"NANP international prefix": {
+			number:      "011 44 20 7946 0958",
+			countryCode: "1",
+			want:        "+442079460958",
+		},
+		"national with a trunk prefix": {
+			number:      "020 7946 0958",
+			countryCode: "44",
+			want:        "+442079460958",
+		},
+		"Italian national keeps its leading 0": {
+			number:      "06 6988 3145",
+			countryCode: "39",
+			want:        "+390669883145",
+		},
+		"dots and slashes": {
+			number:      "030/1234.5678",
+			countryCode: "49",
+			want:        "+493012345678",
+		},
+		"too short": {
+			number:      "12345",
+			countryCode: "1",
+			wantError:   `"12345" is not a valid phone number`,
+		},
+		"too long": {
+			number:      "+1234567890123456",
+			countryCode: "1",
+			wantError:   `"+1234567890123456" is not a valid phone number`,
+		},
+		"letters": {
+			number:      "1-800-FLOWERS",
+			countryCode: "1",
+			wantError:   `"1-800-FLOWERS" is not a valid phone number`,
+		},
+		"invalid country code": {
+			number:      "555 123 4567",
+			countryCode: "0",
+			wantError:   `"0" is not a valid country calling code`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := runFunction(NewE164NormalizeFunction(), types.StringUnknown(), types.StringValue(test.number), types.StringValue(test.countryCode))
+
+			if test.wantError != "" {
+				if err == nil || err.Text != test.wantError {
+					t.Fatalf("got error %v, want %q", err, test.wantError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !got.Equal(types.StringValue(test.want)) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &EcrAuthorizationTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &EcrAuthorizationTokenEphemeralResource{}
+
+var accountIDPattern = regexp.MustCompile(`^[0-9]{12}$`)
+
+func NewEcrAuthorizationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &EcrAuthorizationTokenEphemeralResource{}
+}
+
+type EcrAuthorizationTokenEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type EcrAuthorizationTokenEphemeralResourceModel struct {
+	RegistryID         types.String `tfsdk:"registry_id"`
+	RegistryURL        types.String `tfsdk:"registry_url"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password"`
+	AuthorizationToken types.String `tfsdk:"authorization_token"`
+	Expiration         types.String `tfsdk:"expiration"`
+}
+
+func (r *EcrAuthorizationTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ecr_authorization_token"
+}
+
+func (r *EcrAuthorizationTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "ECR registry credentials obtained with GetAuthorizationToken, e.g. to push container images without storing docker credentials in state",
+
+		Attributes: map[string]schema.Attribute{
+			"registry_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Account ID of the registry. Defaults to the registry of the account of the provider credentials.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(accountIDPattern, "must be a 12 digit account ID"),
+				},
+			},
+			"registry_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the registry, e.g. `https://123456789012.dkr.ecr.us-east-1.amazonaws.com`.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "User name to log in to the registry with, always `AWS`.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Password to log in to the registry with.",
+			},
+			"authorization_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded `username:password`, as used in the `auth` of docker configuration files.",
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp at which the credentials expire, 12 hours after they are issued.",
+			},
+		},
+	}
+}
+
+func (r *EcrAuthorizationTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *EcrAuthorizationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EcrAuthorizationTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.providerData.ecrClient()
+	input := &ecr.GetAuthorizationTokenInput{}
+
+	if data.RegistryID.ValueString() != "" {
+		input.RegistryIds = []string{data.RegistryID.ValueString()}
+	}
+
+	response, err := conn.GetAuthorizationToken(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error getting ECR authorization token", "Could not get ECR authorization token", err))
+		return
+	}
+
+	if len(response.AuthorizationData) == 0 {
+		resp.Diagnostics.AddError("Error getting ECR authorization token", "GetAuthorizationToken returned no authorization data.")
+		return
+	}
+
+	authorization := response.AuthorizationData[0]
+	token := aws.ToString(authorization.AuthorizationToken)
+
+	// The token is the base64 encoded user name and password, separated by a
+	// colon
+	decoded, err := base64.StdEncoding.DecodeString(token)
+	username, password, ok := strings.Cut(string(decoded), ":")
+
+	if err != nil || !ok {
+		resp.Diagnostics.AddError("Error getting ECR authorization token", "GetAuthorizationToken returned an authorization token not in the username:password format.")
+		return
+	}
+
+	data.RegistryURL = types.StringValue(aws.ToString(authorization.ProxyEndpoint))
+	data.Username = types.StringValue(username)
+	data.Password = types.StringValue(password)
+	data.AuthorizationToken = types.StringValue(token)
+	data.Expiration = types.StringValue(aws.ToTime(authorization.ExpiresAt).Format(time.RFC3339))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EmailAddressesDataSource{}
+
+func NewEmailAddressesDataSource() datasource.DataSource {
+	return &EmailAddressesDataSource{}
+}
+
+type EmailAddressesDataSource struct {
+	providerData *ProviderData
+}
+
+type EmailAddressesDataSourceModel struct {
+	InstanceID     types.String        `tfsdk:"instance_id"`
+	InstanceAlias  types.String        `tfsdk:"instance_alias"`
+	EmailAddress   types.String        `tfsdk:"email_address"`
+	MaxResults     types.Int64         `tfsdk:"max_results"`
+	EmailAddresses []EmailAddressModel `tfsdk:"email_addresses"`
+}
+
+type EmailAddressModel struct {
+	Arn            types.String `tfsdk:"arn"`
+	Description    types.String `tfsdk:"description"`
+	DisplayName    types.String `tfsdk:"display_name"`
+	EmailAddress   types.String `tfsdk:"email_address"`
+	EmailAddressID types.String `tfsdk:"email_address_id"`
+}
+
+func (d *EmailAddressesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_email_addresses"
+}
+
+func (d *EmailAddressesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connect email addresses data source",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"email_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the email address matching this value exactly.",
+			},
+			"max_results": maxResultsAttribute(),
+			"email_addresses": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"email_address": schema.StringAttribute{
+							Computed: true,
+						},
+						"email_address_id": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EmailAddressesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *EmailAddressesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EmailAddressesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.providerData.connectClient(nil)
+	input := &connect.SearchEmailAddressesInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		MaxResults: pageSize(data.MaxResults.ValueInt64(), searchPageSize),
+	}
+
+	if !data.EmailAddress.IsNull() {
+		input.SearchCriteria = &conntypes.EmailAddressSearchCriteria{
+			StringCondition: stringCondition("email_address", conntypes.StringComparisonTypeExact, data.EmailAddress.ValueString()),
+		}
+	}
+
+	addresses, err := collectPages(ctx, searchEmailAddresses(conn, input), data.MaxResults.ValueInt64(), func(address conntypes.EmailAddressMetadata) bool {
+		// Only keep exact matches as searches are not case sensitive
+		return data.EmailAddress.IsNull() || aws.ToString(address.EmailAddress) == data.EmailAddress.ValueString()
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error searching Connect Email Addresses", "Could not search Connect Email Addresses", err))
+		return
+	}
+
+	data.EmailAddresses = make([]EmailAddressModel, 0, len(addresses))
+	for _, address := range addresses {
+		data.EmailAddresses = append(data.EmailAddresses, EmailAddressModel{
+			Arn:            types.StringValue(aws.ToString(address.EmailAddressArn)),
+			Description:    types.StringValue(aws.ToString(address.Description)),
+			DisplayName:    types.StringValue(aws.ToString(address.DisplayName)),
+			EmailAddress:   types.StringValue(aws.ToString(address.EmailAddress)),
+			EmailAddressID: types.StringValue(aws.ToString(address.EmailAddressId)),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// searchEmailAddresses returns the pages of a search of email addresses.
+func searchEmailAddresses(conn *connect.Client, input *connect.SearchEmailAddressesInput) pageLister[conntypes.EmailAddressMetadata] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.EmailAddressMetadata, *string, error) {
+		page := *input
+		page.NextToken = nextToken
+
+		response, err := conn.SearchEmailAddresses(ctx, &page)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.EmailAddresses, response.NextToken, nil
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EndpointsModel describes the endpoints block of the provider, which
+// overrides the URLs of the AWS services, e.g. to test against LocalStack or
+// to call them through interface VPC endpoints.
+type EndpointsModel struct {
+	Connect        types.String `tfsdk:"connect"`
+	Ecr            types.String `tfsdk:"ecr"`
+	Kms            types.String `tfsdk:"kms"`
+	S3             types.String `tfsdk:"s3"`
+	SecretsManager types.String `tfsdk:"secretsmanager"`
+	Ssm            types.String `tfsdk:"ssm"`
+	Sts            types.String `tfsdk:"sts"`
+}
+
+// endpointsBlock returns the endpoints block of the provider.
+func endpointsBlock() schema.Block {
+	attributes := map[string]schema.Attribute{}
+	for _, service := range []string{"connect", "ecr", "kms", "s3", "secretsmanager", "ssm", "sts"} {
+		attributes[service] = schema.StringAttribute{
+			Description: "URL of the " + service + " endpoint, e.g. http://localhost:4566",
+			Optional:    true,
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "Custom endpoints by service, e.g. for LocalStack or interface VPC endpoints. The AWS_ENDPOINT_URL and AWS_ENDPOINT_URL_<SERVICE> environment variables are used for the services not set here",
+		Attributes:  attributes,
+	}
+}
+
+// byService returns the endpoints set in the block by service name, as used
+// by the client cache, e.g. connect.
+func (m *EndpointsModel) byService() map[string]string {
+	endpoints := map[string]string{}
+	if m == nil {
+		return endpoints
+	}
+
+	for service, value := range map[string]types.String{
+		"connect":        m.Connect,
+		"ecr":            m.Ecr,
+		"kms":            m.Kms,
+		"s3":             m.S3,
+		"secretsmanager": m.SecretsManager,
+		"ssm":            m.Ssm,
+		"sts":            m.Sts,
+	} {
+		if value.ValueString() != "" {
+			endpoints[service] = value.ValueString()
+		}
+	}
+
+	return endpoints
+}
+
+// withEndpoint returns cfg with the custom endpoint of service, if any.
+func withEndpoint(cfg aws.Config, endpoints map[string]string, service string) aws.Config {
+	if endpoint, ok := endpoints[service]; ok {
+		cfg.BaseEndpoint = aws.String(endpoint)
+	}
+
+	return cfg
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// enforceAttributeName is the name of the attribute switching a resource to
+// observe-only mode, in which Terraform reports drift without correcting it.
+const enforceAttributeName = "enforce"
+
+// unenforcedAttributes are not reported as drift in observe-only mode, as they
+// only configure the provider's behaviour.
+var unenforcedAttributes = map[string]bool{
+	enforceAttributeName:        true,
+	importOnExistsAttributeName: true,
+	"on_destroy":                true,
+	"timeouts":                  true,
+	"override":                  true,
+}
+
+// enforceAttribute returns the schema of the enforce attribute.
+func enforceAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+		Description: "Set to false to observe the resource without managing it: it must already exist, updates and destroys make no changes in AWS, and drift from the configuration is reported as warnings.",
+	}
+}
+
+// attributeGetter is implemented by the plan, state and config.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// enforced reads the enforce attribute from the plan or state and reports
+// whether changes should be applied in AWS.
+func enforced(ctx context.Context, data attributeGetter) (bool, diag.Diagnostics) {
+	var value types.Bool
+
+	diags := data.GetAttribute(ctx, path.Root(enforceAttributeName), &value)
+
+	return enforcedValue(value), diags
+}
+
+// enforcedValue reports whether changes should be applied in AWS for a value
+// of the enforce attribute. Null and unknown values are enforced, e.g. in
+// imported states or states saved before the attribute existed.
+func enforcedValue(value types.Bool) bool {
+	return value.IsNull() || value.IsUnknown() || value.ValueBool()
+}
+
+// modifyPlanDrift warns about every attribute of an observe-only resource
+// whose value in AWS differs from the configuration, as the update will not
+// apply it.
+func modifyPlanDrift(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to report on create or destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	enforce, diags := enforced(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || enforce {
+		return
+	}
+
+	diffs, err := req.State.Raw.Diff(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Error comparing state and plan", err.Error())
+		return
+	}
+
+	reported := map[string]bool{}
+	for _, diff := range diffs {
+		steps := diff.Path.Steps()
+		if len(steps) == 0 {
+			continue
+		}
+
+		name, ok := steps[0].(tftypes.AttributeName)
+		if !ok || unenforcedAttributes[string(name)] || reported[string(name)] {
+			continue
+		}
+
+		// Computed values are only known after apply and are not drift
+		if diff.Value2 != nil && !diff.Value2.IsFullyKnown() {
+			continue
+		}
+
+		reported[string(name)] = true
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(string(name)),
+			"Drift Not Enforced",
+			fmt.Sprintf("The value of %s in AWS differs from the configuration. The change will not be applied as enforce is false.", name),
+		)
+	}
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEnforcedValue(t *testing.T) {
+	tests := map[string]struct {
+		value types.Bool
+		want  bool
+	}{
+		"null":    {types.BoolNull(), true},
+		"unknown": {types.BoolUnknown(), true},
+		"true":    {types.BoolValue(true), true},
+		"false":   {types.BoolValue(false), false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := enforcedValue(test.value); got != test.want {
+				t.Errorf("got %t, want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// retryableErrorCodes are the error codes, besides the throttling and
+// transient errors the SDK retries by default, on which the provider retries
+// AWS API calls. Connect reports concurrent modifications of an instance with
+// these codes.
+var retryableErrorCodes = []string{
+	"ResourceConflictException",
+	"ConflictException",
+	"InternalServiceException",
+}
+
+// errorRemediations maps the error codes of AWS APIs to a hint shown with the
+// error diagnostic.
+var errorRemediations = map[string]string{
+	"ResourceNotFoundException":     "The resource does not exist. Check the instance and resource IDs, and the region and account of the provider.",
+	"DuplicateResourceException":    "A resource with the same name already exists. Import it, or set import_on_exists to adopt it.",
+	"ResourceInUseException":        "The resource is still in use, e.g. by child hierarchy groups or by users. Remove them first.",
+	"ResourceConflictException":     "The resource was modified concurrently. Apply again once the other change completed.",
+	"InvalidRequestException":       "The request was rejected as invalid. Check the arguments of the resource.",
+	"InvalidParameterException":     "The request was rejected as invalid. Check the arguments of the resource.",
+	"LimitExceededException":        "A service quota is exhausted. Remove unused resources or request a quota increase.",
+	"ServiceQuotaExceededException": "A service quota is exhausted. Remove unused resources or request a quota increase.",
+	"ThrottlingException":           "The request was still throttled after retrying. Lower the -parallelism of Terraform or apply again later.",
+	"TooManyRequestsException":      "The request was still throttled after retrying. Lower the -parallelism of Terraform or apply again later.",
+	"AccessDeniedException":         "The provider credentials are not allowed to perform the operation. Check their IAM permissions.",
+}
+
+// errorCode returns the error code of a failed AWS API call, or an empty
+// string if err does not originate from an AWS API.
+func errorCode(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+
+	return ""
+}
+
+// isNotFound reports whether err reports a resource that does not exist.
+func isNotFound(err error) bool {
+	return errorCode(err) == "ResourceNotFoundException"
+}
+
+// isResourceInUse reports whether err reports a resource that cannot be
+// deleted while other resources still depend on it.
+func isResourceInUse(err error) bool {
+	return errorCode(err) == "ResourceInUseException"
+}
+
+// apiError returns the error diagnostic of a failed AWS API call, e.g.
+// apiError("Error reading Connect Queue", "Could not read Connect Queue", err).
+// Known error codes get a remediation hint appended to the detail.
+func apiError(summary, detail string, err error) diag.Diagnostic {
+	remediation, ok := errorRemediations[errorCode(err)]
+	if !ok {
+		return diag.NewErrorDiagnostic(summary, withAPICallDetails(fmt.Sprintf("%s, unexpected error: %s", detail, err), err))
+	}
+
+	return diag.NewErrorDiagnostic(summary, withAPICallDetails(fmt.Sprintf("%s: %s\n\n%s", detail, err, remediation), err))
+}
+
+// withAPICallDetails appends to the detail of an error diagnostic the
+// service, operation, request ID and number of attempts of the failed AWS API
+// call of err, which are needed to open an AWS support case. The detail is
+// returned unchanged if err does not originate from an AWS API call.
+func withAPICallDetails(detail string, err error) string {
+	var details []string
+
+	var operationErr *smithy.OperationError
+	if errors.As(err, &operationErr) {
+		details = append(details, operationErr.Service()+" "+operationErr.Operation())
+	}
+
+	var requestErr interface{ ServiceRequestID() string }
+	if errors.As(err, &requestErr) && requestErr.ServiceRequestID() != "" {
+		details = append(details, "request ID: "+requestErr.ServiceRequestID())
+	}
+
+	var callErr *apiCallError
+	var maxAttemptsErr *retry.MaxAttemptsError
+	if errors.As(err, &callErr) {
+		details = append(details, fmt.Sprintf("attempts: %d", callErr.attempts))
+	} else if errors.As(err, &maxAttemptsErr) {
+		details = append(details, fmt.Sprintf("attempts: %d", maxAttemptsErr.Attempt))
+	}
+
+	if len(details) == 0 {
+		return detail
+	}
+
+	return detail + "\n\nAWS API call: " + strings.Join(details, ", ")
+}
+
+// apiCallError is the error of a failed AWS API call annotated with the number
+// of attempts made, which the SDK only reports in the response metadata.
+type apiCallError struct {
+	err      error
+	attempts int
+}
+
+func (e *apiCallError) Error() string {
+	return e.err.Error()
+}
+
+func (e *apiCallError) Unwrap() error {
+	return e.err
+}
+
+// recordAPICalls returns the middleware annotating the errors of AWS API calls
+// with their number of attempts, logging a summary of every call at debug
+// level if logCalls is true, and its response metadata if logMetadata is true.
+func recordAPICalls(logCalls, logMetadata bool) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("AwsExtAPICalls", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			if logCalls {
+				logAPICall(ctx, metadata, time.Since(start), err)
+			}
+
+			if logMetadata {
+				logResponseMetadata(ctx, metadata, err)
+			}
+
+			if attempts, ok := retry.GetAttemptResults(metadata); ok && err != nil {
+				err = &apiCallError{err: err, attempts: len(attempts.Results)}
+			}
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
+
+// logAPICall logs the service, operation, duration, request ID and number of
+// retries of an AWS API call, e.g. to find the calls throttled or slowed down
+// by retries.
+func logAPICall(ctx context.Context, metadata middleware.Metadata, duration time.Duration, err error) {
+	fields := map[string]interface{}{
+		"aws.service":     awsmiddleware.GetServiceID(ctx),
+		"aws.operation":   awsmiddleware.GetOperationName(ctx),
+		"aws.duration_ms": duration.Milliseconds(),
+		"aws.retries":     0,
+	}
+
+	if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		fields["aws.request_id"] = requestID
+	}
+
+	if attempts, ok := retry.GetAttemptResults(metadata); ok && len(attempts.Results) > 0 {
+		fields["aws.retries"] = len(attempts.Results) - 1
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	tflog.Debug(ctx, "AWS API call", fields)
+}
+
+// logResponseMetadata logs the response metadata of an AWS API call: the
+// request ID, the outcome of each attempt, and the status code and headers of
+// the last HTTP response.
+func logResponseMetadata(ctx context.Context, metadata middleware.Metadata, err error) {
+	fields := map[string]interface{}{
+		"aws.service":   awsmiddleware.GetServiceID(ctx),
+		"aws.operation": awsmiddleware.GetOperationName(ctx),
+	}
+
+	if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		fields["aws.request_id"] = requestID
+	}
+
+	if responseAt, ok := awsmiddleware.GetResponseAt(metadata); ok {
+		fields["aws.response_at"] = responseAt
+	}
+
+	if attempts, ok := retry.GetAttemptResults(metadata); ok {
+		results := make([]string, 0, len(attempts.Results))
+		for _, attempt := range attempts.Results {
+			result := "succeeded"
+			if attempt.Err != nil {
+				result = attempt.Err.Error()
+			}
+
+			if requestID, ok := awsmiddleware.GetRequestIDMetadata(attempt.ResponseMetadata); ok {
+				result = requestID + ": " + result
+			}
+
+			results = append(results, result)
+		}
+
+		fields["aws.attempts"] = results
+	}
+
+	if response, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && response != nil {
+		fields["http.status_code"] = response.StatusCode
+		fields["http.headers"] = response.Header
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	tflog.Debug(ctx, "AWS API call response metadata", fields)
+}
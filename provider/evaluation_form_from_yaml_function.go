@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	connecttypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &EvaluationFormFromYamlFunction{}
+
+var evaluationFormRefIDPattern = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,40}$`)
+
+// evaluationFormYaml is the YAML representation of an evaluation form.
+type evaluationFormYaml struct {
+	Title       string                      `yaml:"title"`
+	Description string                      `yaml:"description"`
+	Scoring     *evaluationFormScoringYaml  `yaml:"scoring"`
+	Sections    []evaluationFormSectionYaml `yaml:"sections"`
+}
+
+type evaluationFormScoringYaml struct {
+	Mode   string `yaml:"mode"`
+	Status string `yaml:"status"`
+}
+
+type evaluationFormSectionYaml struct {
+	RefID        string                       `yaml:"ref_id"`
+	Title        string                       `yaml:"title"`
+	Instructions string                       `yaml:"instructions"`
+	Weight       float64                      `yaml:"weight"`
+	Questions    []evaluationFormQuestionYaml `yaml:"questions"`
+	Sections     []evaluationFormSectionYaml  `yaml:"sections"`
+}
+
+type evaluationFormQuestionYaml struct {
+	RefID         string                     `yaml:"ref_id"`
+	Title         string                     `yaml:"title"`
+	Instructions  string                     `yaml:"instructions"`
+	Type          string                     `yaml:"type"`
+	Weight        float64                    `yaml:"weight"`
+	NotApplicable bool                       `yaml:"not_applicable"`
+	DisplayAs     string                     `yaml:"display_as"`
+	Options       []evaluationFormOptionYaml `yaml:"options"`
+	Min           *int32                     `yaml:"min"`
+	Max           *int32                     `yaml:"max"`
+	Ranges        []evaluationFormRangeYaml  `yaml:"ranges"`
+}
+
+type evaluationFormOptionYaml struct {
+	RefID         string `yaml:"ref_id"`
+	Text          string `yaml:"text"`
+	Score         int32  `yaml:"score"`
+	AutomaticFail bool   `yaml:"automatic_fail"`
+}
+
+type evaluationFormRangeYaml struct {
+	Min           int32 `yaml:"min"`
+	Max           int32 `yaml:"max"`
+	Score         int32 `yaml:"score"`
+	AutomaticFail bool  `yaml:"automatic_fail"`
+}
+
+// The evaluation form JSON mirrors the CreateEvaluationForm request.
+type evaluationFormJSON struct {
+	Title           string                     `json:"Title"`
+	Description     string                     `json:"Description,omitempty"`
+	Items           []evaluationFormItemJSON   `json:"Items"`
+	ScoringStrategy *evaluationFormScoringJSON `json:"ScoringStrategy,omitempty"`
+}
+
+type evaluationFormScoringJSON struct {
+	Mode   string `json:"Mode"`
+	Status string `json:"Status"`
+}
+
+type evaluationFormItemJSON struct {
+	Section  *evaluationFormSectionJSON  `json:"Section,omitempty"`
+	Question *evaluationFormQuestionJSON `json:"Question,omitempty"`
+}
+
+type evaluationFormSectionJSON struct {
+	RefId        string                   `json:"RefId"`
+	Title        string                   `json:"Title"`
+	Instructions string                   `json:"Instructions,omitempty"`
+	Weight       float64                  `json:"Weight,omitempty"`
+	Items        []evaluationFormItemJSON `json:"Items"`
+}
+
+type evaluationFormQuestionJSON struct {
+	RefId                  string                                    `json:"RefId"`
+	Title                  string                                    `json:"Title"`
+	Instructions           string                                    `json:"Instructions,omitempty"`
+	QuestionType           string                                    `json:"QuestionType"`
+	NotApplicableEnabled   bool                                      `json:"NotApplicableEnabled,omitempty"`
+	Weight                 float64                                   `json:"Weight,omitempty"`
+	QuestionTypeProperties *evaluationFormQuestionTypePropertiesJSON `json:"QuestionTypeProperties,omitempty"`
+}
+
+type evaluationFormQuestionTypePropertiesJSON struct {
+	SingleSelect *evaluationFormSingleSelectJSON `json:"SingleSelect,omitempty"`
+	Numeric      *evaluationFormNumericJSON      `json:"Numeric,omitempty"`
+}
+
+type evaluationFormSingleSelectJSON struct {
+	Options   []evaluationFormOptionJSON `json:"Options"`
+	DisplayAs string                     `json:"DisplayAs,omitempty"`
+}
+
+type evaluationFormOptionJSON struct {
+	RefId         string `json:"RefId"`
+	Text          string `json:"Text"`
+	Score         int32  `json:"Score"`
+	AutomaticFail bool   `json:"AutomaticFail,omitempty"`
+}
+
+type evaluationFormNumericJSON struct {
+	MinValue int32                     `json:"MinValue"`
+	MaxValue int32                     `json:"MaxValue"`
+	Options  []evaluationFormRangeJSON `json:"Options,omitempty"`
+}
+
+type evaluationFormRangeJSON struct {
+	MinValue      int32 `json:"MinValue"`
+	MaxValue      int32 `json:"MaxValue"`
+	Score         int32 `json:"Score"`
+	AutomaticFail bool  `json:"AutomaticFail,omitempty"`
+}
+
+func NewEvaluationFormFromYamlFunction() function.Function {
+	return &EvaluationFormFromYamlFunction{}
+}
+
+type EvaluationFormFromYamlFunction struct{}
+
+func (f *EvaluationFormFromYamlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "evaluation_form_from_yaml"
+}
+
+func (f *EvaluationFormFromYamlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a YAML evaluation form definition",
+		MarkdownDescription: "Converts a YAML evaluation form with `title`, `description`, `scoring` (`mode` and `status`) and nested " +
+			"`sections` of `questions` into the JSON object with the `Title`, `Description`, `Items` and `ScoringStrategy` expected " +
+			"by CreateEvaluationForm. Questions are of `type` `TEXT`, `SINGLESELECT` (with `options` of `text`, `score` and " +
+			"`automatic_fail`) or `NUMERIC` (with `min`, `max` and scored `ranges`). Missing `ref_id`s are derived from the position " +
+			"of the item. Unknown keys and invalid definitions are reported as errors at plan time.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "yaml",
+				MarkdownDescription: "YAML evaluation form definition.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EvaluationFormFromYamlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+
+	if resp.Error != nil {
+		return
+	}
+
+	form, err := evaluationFormFromYaml(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, err := marshalCanonicalJSON(form)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// evaluationFormFromYaml decodes and validates a YAML evaluation form.
+func evaluationFormFromYaml(input string) (evaluationFormJSON, error) {
+	var definition evaluationFormYaml
+
+	decoder := yaml.NewDecoder(bytes.NewBufferString(input))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&definition); err != nil && !errors.Is(err, io.EOF) {
+		return evaluationFormJSON{}, fmt.Errorf("invalid evaluation form YAML: %w", err)
+	}
+
+	if strings.TrimSpace(definition.Title) == "" {
+		return evaluationFormJSON{}, fmt.Errorf("title is required")
+	}
+
+	if len(definition.Sections) == 0 {
+		return evaluationFormJSON{}, fmt.Errorf("at least one section is required")
+	}
+
+	form := evaluationFormJSON{
+		Title:       definition.Title,
+		Description: definition.Description,
+	}
+
+	if definition.Scoring != nil {
+		mode := strings.ToUpper(definition.Scoring.Mode)
+		if !slices.Contains(connecttypes.EvaluationFormScoringMode("").Values(), connecttypes.EvaluationFormScoringMode(mode)) {
+			return form, fmt.Errorf("scoring.mode must be one of %v", connecttypes.EvaluationFormScoringMode("").Values())
+		}
+
+		status := strings.ToUpper(definition.Scoring.Status)
+		if status == "" {
+			status = string(connecttypes.EvaluationFormScoringStatusEnabled)
+		}
+		if !slices.Contains(connecttypes.EvaluationFormScoringStatus("").Values(), connecttypes.EvaluationFormScoringStatus(status)) {
+			return form, fmt.Errorf("scoring.status must be one of %v", connecttypes.EvaluationFormScoringStatus("").Values())
+		}
+
+		form.ScoringStrategy = &evaluationFormScoringJSON{Mode: mode, Status: status}
+	}
+
+	refIDs := map[string]bool{}
+
+	for i, section := range definition.Sections {
+		item, err := buildEvaluationFormSection(section, fmt.Sprintf("section-%d", i+1), refIDs)
+		if err != nil {
+			return form, fmt.Errorf("sections[%d]: %w", i, err)
+		}
+
+		form.Items = append(form.Items, item)
+	}
+
+	return form, nil
+}
+
+func buildEvaluationFormSection(section evaluationFormSectionYaml, defaultRefID string, refIDs map[string]bool) (evaluationFormItemJSON, error) {
+	if strings.TrimSpace(section.Title) == "" {
+		return evaluationFormItemJSON{}, fmt.Errorf("title is required")
+	}
+
+	refID, err := evaluationFormRefID(section.RefID, defaultRefID, refIDs)
+	if err != nil {
+		return evaluationFormItemJSON{}, err
+	}
+
+	if len(section.Questions) == 0 && len(section.Sections) == 0 {
+		return evaluationFormItemJSON{}, fmt.Errorf("section %s has no questions or sections", refID)
+	}
+
+	result := &evaluationFormSectionJSON{
+		RefId:        refID,
+		Title:        section.Title,
+		Instructions: section.Instructions,
+		Weight:       section.Weight,
+		Items:        []evaluationFormItemJSON{},
+	}
+
+	for i, question := range section.Questions {
+		item, err := buildEvaluationFormQuestion(question, fmt.Sprintf("%s-question-%d", refID, i+1), refIDs)
+		if err != nil {
+			return evaluationFormItemJSON{}, fmt.Errorf("questions[%d]: %w", i, err)
+		}
+
+		result.Items = append(result.Items, item)
+	}
+
+	for i, child := range section.Sections {
+		item, err := buildEvaluationFormSection(child, fmt.Sprintf("%s-%d", refID, i+1), refIDs)
+		if err != nil {
+			return evaluationFormItemJSON{}, fmt.Errorf("sections[%d]: %w", i, err)
+		}
+
+		result.Items = append(result.Items, item)
+	}
+
+	return evaluationFormItemJSON{Section: result}, nil
+}
+
+func buildEvaluationFormQuestion(question evaluationFormQuestionYaml, defaultRefID string, refIDs map[string]bool) (evaluationFormItemJSON, error) {
+	if strings.TrimSpace(question.Title) == "" {
+		return evaluationFormItemJSON{}, fmt.Errorf("title is required")
+	}
+
+	refID, err := evaluationFormRefID(question.RefID, defaultRefID, refIDs)
+	if err != nil {
+		return evaluationFormItemJSON{}, err
+	}
+
+	questionType := connecttypes.EvaluationFormQuestionType(strings.ToUpper(strings.ReplaceAll(question.Type, "_", "")))
+	if !slices.Contains(questionType.Values(), questionType) {
+		return evaluationFormItemJSON{}, fmt.Errorf("type must be one of %v", questionType.Values())
+	}
+
+	result := &evaluationFormQuestionJSON{
+		RefId:                refID,
+		Title:                question.Title,
+		Instructions:         question.Instructions,
+		QuestionType:         string(questionType),
+		NotApplicableEnabled: question.NotApplicable,
+		Weight:               question.Weight,
+	}
+
+	switch questionType {
+	case connecttypes.EvaluationFormQuestionTypeSingleselect:
+		if question.Min != nil || question.Max != nil || len(question.Ranges) > 0 {
+			return evaluationFormItemJSON{}, fmt.Errorf("min, max and ranges are only supported for NUMERIC questions")
+		}
+
+		properties, err := buildEvaluationFormSingleSelect(question, refID, refIDs)
+		if err != nil {
+			return evaluationFormItemJSON{}, err
+		}
+
+		result.QuestionTypeProperties = &evaluationFormQuestionTypePropertiesJSON{SingleSelect: properties}
+	case connecttypes.EvaluationFormQuestionTypeNumeric:
+		if len(question.Options) > 0 || question.DisplayAs != "" {
+			return evaluationFormItemJSON{}, fmt.Errorf("options and display_as are only supported for SINGLESELECT questions")
+		}
+
+		properties, err := buildEvaluationFormNumeric(question)
+		if err != nil {
+			return evaluationFormItemJSON{}, err
+		}
+
+		result.QuestionTypeProperties = &evaluationFormQuestionTypePropertiesJSON{Numeric: properties}
+	default:
+		if len(question.Options) > 0 || question.DisplayAs != "" || question.Min != nil || question.Max != nil || len(question.Ranges) > 0 {
+			return evaluationFormItemJSON{}, fmt.Errorf("TEXT questions do not support options, display_as, min, max or ranges")
+		}
+	}
+
+	return evaluationFormItemJSON{Question: result}, nil
+}
+
+func buildEvaluationFormSingleSelect(question evaluationFormQuestionYaml, refID string, refIDs map[string]bool) (*evaluationFormSingleSelectJSON, error) {
+	if len(question.Options) < 2 {
+		return nil, fmt.Errorf("SINGLESELECT questions require at least 2 options")
+	}
+
+	displayAs := connecttypes.EvaluationFormSingleSelectQuestionDisplayMode(strings.ToUpper(question.DisplayAs))
+	if displayAs != "" && !slices.Contains(displayAs.Values(), displayAs) {
+		return nil, fmt.Errorf("display_as must be one of %v", displayAs.Values())
+	}
+
+	result := &evaluationFormSingleSelectJSON{DisplayAs: string(displayAs)}
+
+	for i, option := range question.Options {
+		if strings.TrimSpace(option.Text) == "" {
+			return nil, fmt.Errorf("options[%d]: text is required", i)
+		}
+
+		optionRefID, err := evaluationFormRefID(option.RefID, fmt.Sprintf("%s-option-%d", refID, i+1), refIDs)
+		if err != nil {
+			return nil, fmt.Errorf("options[%d]: %w", i, err)
+		}
+
+		result.Options = append(result.Options, evaluationFormOptionJSON{
+			RefId:         optionRefID,
+			Text:          option.Text,
+			Score:         option.Score,
+			AutomaticFail: option.AutomaticFail,
+		})
+	}
+
+	return result, nil
+}
+
+func buildEvaluationFormNumeric(question evaluationFormQuestionYaml) (*evaluationFormNumericJSON, error) {
+	if question.Min == nil || question.Max == nil {
+		return nil, fmt.Errorf("NUMERIC questions require min and max")
+	}
+
+	if *question.Min >= *question.Max {
+		return nil, fmt.Errorf("min must be less than max")
+	}
+
+	result := &evaluationFormNumericJSON{
+		MinValue: *question.Min,
+		MaxValue: *question.Max,
+	}
+
+	for i, option := range question.Ranges {
+		if option.Min > option.Max || option.Min < *question.Min || option.Max > *question.Max {
+			return nil, fmt.Errorf("ranges[%d]: range %d-%d is not within %d-%d", i, option.Min, option.Max, *question.Min, *question.Max)
+		}
+
+		result.Options = append(result.Options, evaluationFormRangeJSON{
+			MinValue:      option.Min,
+			MaxValue:      option.Max,
+			Score:         option.Score,
+			AutomaticFail: option.AutomaticFail,
+		})
+	}
+
+	return result, nil
+}
+
+// evaluationFormRefID validates refID, or defaultRefID when refID is empty,
+// and checks that it is unique within the form.
+func evaluationFormRefID(refID string, defaultRefID string, refIDs map[string]bool) (string, error) {
+	if refID == "" {
+		refID = defaultRefID
+	}
+
+	if !evaluationFormRefIDPattern.MatchString(refID) {
+		return "", fmt.Errorf("ref_id %q must be 1 to 40 letters, digits, '.', '_' or '-'", refID)
+	}
+
+	if refIDs[refID] {
+		return "", fmt.Errorf("ref_id %q is used more than once", refID)
+	}
+	refIDs[refID] = true
+
+	return refID, nil
+}
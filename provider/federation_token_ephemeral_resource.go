@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &FederationTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &FederationTokenEphemeralResource{}
+
+func NewFederationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &FederationTokenEphemeralResource{}
+}
+
+type FederationTokenEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type FederationTokenEphemeralResourceModel struct {
+	InstanceID             types.String `tfsdk:"instance_id"`
+	RoleArn                types.String `tfsdk:"role_arn"`
+	Username               types.String `tfsdk:"username"`
+	AccessToken            types.String `tfsdk:"access_token"`
+	AccessTokenExpiration  types.String `tfsdk:"access_token_expiration"`
+	RefreshToken           types.String `tfsdk:"refresh_token"`
+	RefreshTokenExpiration types.String `tfsdk:"refresh_token_expiration"`
+	SignInURL              types.String `tfsdk:"sign_in_url"`
+	UserArn                types.String `tfsdk:"user_arn"`
+	UserID                 types.String `tfsdk:"user_id"`
+}
+
+func (r *FederationTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_federation_token"
+}
+
+func (r *FederationTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connect federation token for the calling user, which must be federated into the instance through SAML, or for the user named username by assuming the SAML role role_arn.",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validConnectInstanceID(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Optional:    true,
+				Description: "ARN of the role federating users into the instance through SAML, assumed with username as the session name to get the token of that user, e.g. to sign in test agents in CI.",
+				Validators: []validator.String{
+					validArn("iam"),
+					stringvalidator.AlsoRequires(path.MatchRoot("username")),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Username of the Connect user to get the token of, the session name of the SAML federated users.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.AlsoRequires(path.MatchRoot("role_arn")),
+				},
+			},
+			"access_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"access_token_expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"refresh_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"refresh_token_expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"sign_in_url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"user_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"user_id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *FederationTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *FederationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data FederationTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.providerData.connectClient(nil)
+
+	// Connect identifies SAML federated users by the session name of the role
+	if data.RoleArn.ValueString() != "" {
+		cfg := r.providerData.serviceConfig("connect", nil, 0)
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(r.providerData.stsClient(), data.RoleArn.ValueString(), func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = data.Username.ValueString()
+		}))
+		conn = connect.NewFromConfig(cfg)
+	}
+
+	input := &connect.GetFederationTokenInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+	}
+
+	response, err := conn.GetFederationToken(ctx, input)
+
+	if err != nil {
+		detail := "Could not get Connect Federation Token"
+		if data.Username.ValueString() != "" {
+			detail = fmt.Sprintf("Could not get the Connect Federation Token of user %s", data.Username.ValueString())
+		}
+
+		resp.Diagnostics.Append(apiError("Error getting Connect Federation Token", detail, err))
+		return
+	}
+
+	if response.Credentials != nil {
+		data.AccessToken = types.StringValue(aws.ToString(response.Credentials.AccessToken))
+		data.AccessTokenExpiration = types.StringValue(aws.ToTime(response.Credentials.AccessTokenExpiration).Format(time.RFC3339))
+		data.RefreshToken = types.StringValue(aws.ToString(response.Credentials.RefreshToken))
+		data.RefreshTokenExpiration = types.StringValue(aws.ToTime(response.Credentials.RefreshTokenExpiration).Format(time.RFC3339))
+	}
+
+	data.SignInURL = types.StringValue(aws.ToString(response.SignInUrl))
+	data.UserArn = types.StringValue(aws.ToString(response.UserArn))
+	data.UserID = types.StringValue(aws.ToString(response.UserId))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
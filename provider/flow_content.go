@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// flowLayoutKeys are keys of the flow Metadata that only describe the layout
+// of the flow designer canvas and carry no semantic meaning.
+var flowLayoutKeys = map[string]bool{
+	"entryPointPosition": true,
+	"position":           true,
+	"size":               true,
+	"isFolded":           true,
+	"snapToGrid":         true,
+}
+
+// normalizeFlowContent canonicalizes flow language JSON so that two flows
+// which only differ in key ordering, whitespace, canvas layout or action
+// ordering produce the same string.
+func normalizeFlowContent(content string) (string, error) {
+	var flow map[string]any
+
+	decoder := json.NewDecoder(bytes.NewBufferString(content))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&flow); err != nil {
+		return "", fmt.Errorf("invalid flow JSON: %w", err)
+	}
+
+	if metadata, ok := flow["Metadata"]; ok {
+		stripped := stripFlowLayout(metadata)
+		if isEmptyJSON(stripped) {
+			delete(flow, "Metadata")
+		} else {
+			flow["Metadata"] = stripped
+		}
+	}
+
+	if actions, ok := flow["Actions"].([]any); ok {
+		sort.SliceStable(actions, func(i, j int) bool {
+			return flowActionIdentifier(actions[i]) < flowActionIdentifier(actions[j])
+		})
+	}
+
+	return marshalCanonicalJSON(flow)
+}
+
+// stripFlowLayout removes canvas layout keys from a flow Metadata value and
+// drops objects that become empty as a result.
+func stripFlowLayout(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if flowLayoutKeys[key] {
+				delete(v, key)
+				continue
+			}
+
+			stripped := stripFlowLayout(child)
+			if _, isMap := child.(map[string]any); isMap && isEmptyJSON(stripped) {
+				delete(v, key)
+				continue
+			}
+
+			v[key] = stripped
+		}
+		return v
+	case []any:
+		for i, child := range v {
+			v[i] = stripFlowLayout(child)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func flowActionIdentifier(action any) string {
+	if v, ok := action.(map[string]any); ok {
+		if identifier, ok := v["Identifier"].(string); ok {
+			return identifier
+		}
+	}
+
+	return ""
+}
+
+func isEmptyJSON(value any) bool {
+	v, ok := value.(map[string]any)
+	return ok && len(v) == 0
+}
+
+// marshalCanonicalJSON encodes value as compact JSON with sorted object keys
+// and without HTML escaping, so SSML such as <speak> is preserved verbatim.
+func marshalCanonicalJSON(value any) (string, error) {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimRight(buffer.Bytes(), "\n")), nil
+}
+
+// flowReferences returns the sorted, de-duplicated ARNs referenced anywhere
+// in the flow language JSON (queues, prompts, Lambda functions, flow modules,
+// Lex bots, ...).
+func flowReferences(content string) ([]string, error) {
+	var flow any
+
+	if err := json.Unmarshal([]byte(content), &flow); err != nil {
+		return nil, fmt.Errorf("invalid flow JSON: %w", err)
+	}
+
+	found := map[string]bool{}
+	collectArns(flow, found)
+
+	references := make([]string, 0, len(found))
+	for reference := range found {
+		references = append(references, reference)
+	}
+	sort.Strings(references)
+
+	return references, nil
+}
+
+func collectArns(value any, found map[string]bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		for _, child := range v {
+			collectArns(child, found)
+		}
+	case []any:
+		for _, child := range v {
+			collectArns(child, found)
+		}
+	case string:
+		if arn.IsARN(v) {
+			found[v] = true
+		}
+	}
+}
+
+// flowActionTypes are the action types of the flow language known to the
+// provider. The language gains actions over time, so unknown types are only
+// reported as warnings.
+var flowActionTypes = map[string]bool{
+	"AuthenticateParticipant":                    true,
+	"CheckHoursOfOperation":                      true,
+	"CheckMetricData":                            true,
+	"CheckVoiceId":                               true,
+	"Compare":                                    true,
+	"ConnectParticipantWithLexBot":               true,
+	"CreateCallbackContact":                      true,
+	"CreatePersistentContactAssociation":         true,
+	"CreateTask":                                 true,
+	"DequeueContactAndTransferToQueue":           true,
+	"DisconnectParticipant":                      true,
+	"DistributeByPercentage":                     true,
+	"EndFlowExecution":                           true,
+	"EndFlowModuleExecution":                     true,
+	"GetMetricData":                              true,
+	"GetParticipantInput":                        true,
+	"InvokeExternalResource":                     true,
+	"InvokeFlowModule":                           true,
+	"InvokeLambdaFunction":                       true,
+	"Loop":                                       true,
+	"MessageParticipant":                         true,
+	"MessageParticipantIteratively":              true,
+	"ResumeContact":                              true,
+	"ShowView":                                   true,
+	"TagContact":                                 true,
+	"TransferContactToAgent":                     true,
+	"TransferContactToQueue":                     true,
+	"TransferParticipantToThirdParty":            true,
+	"TransferToFlow":                             true,
+	"UnTagContact":                               true,
+	"UpdateContactAttributes":                    true,
+	"UpdateContactCallbackNumber":                true,
+	"UpdateContactData":                          true,
+	"UpdateContactEventHooks":                    true,
+	"UpdateContactMediaProcessing":               true,
+	"UpdateContactMediaStreamingBehavior":        true,
+	"UpdateContactRecordingAndAnalyticsBehavior": true,
+	"UpdateContactRecordingBehavior":             true,
+	"UpdateContactRoutingBehavior":               true,
+	"UpdateContactTargetQueue":                   true,
+	"UpdateContactTextToSpeechVoice":             true,
+	"UpdateFlowAttributes":                       true,
+	"UpdateFlowLoggingBehavior":                  true,
+	"UpdatePreviousContactParticipantState":      true,
+	"Wait":                                       true,
+}
+
+// terminalFlowActionTypes end the flow or hand the contact over, so they
+// need no transition to a next action.
+var terminalFlowActionTypes = map[string]bool{
+	"DisconnectParticipant":  true,
+	"EndFlowExecution":       true,
+	"EndFlowModuleExecution": true,
+	"TransferContactToQueue": true,
+	"TransferToFlow":         true,
+}
+
+// flowContentProblem is a problem found in flow language JSON by
+// validateFlowContent.
+type flowContentProblem struct {
+	warning bool
+	message string
+}
+
+// flowTransitions is the Transitions object of a flow action.
+type flowTransitions struct {
+	NextAction string
+	Errors     []struct{ NextAction string }
+	Conditions []struct{ NextAction string }
+}
+
+// validateFlowContent checks the structure of flow language JSON without
+// calling Connect: the start action and every transition must reference an
+// action of the flow, action identifiers must be unique, and every action
+// that does not end the flow must transition somewhere. Unknown action types
+// are reported as warnings.
+func validateFlowContent(content string) []flowContentProblem {
+	var flow struct {
+		Version     *string
+		StartAction *string
+		Actions     []struct {
+			Identifier  string
+			Type        string
+			Transitions *flowTransitions
+		}
+	}
+
+	if err := json.Unmarshal([]byte(content), &flow); err != nil {
+		return []flowContentProblem{{message: fmt.Sprintf("invalid flow JSON: %s", err)}}
+	}
+
+	var problems []flowContentProblem
+	addError := func(format string, args ...any) {
+		problems = append(problems, flowContentProblem{message: fmt.Sprintf(format, args...)})
+	}
+
+	if flow.Version == nil {
+		addError("the flow has no Version")
+	}
+
+	identifiers := map[string]bool{}
+	for i, action := range flow.Actions {
+		switch {
+		case action.Identifier == "":
+			addError("Actions[%d] has no Identifier", i)
+		case identifiers[action.Identifier]:
+			addError("Actions[%d] reuses the Identifier %s", i, action.Identifier)
+		}
+
+		identifiers[action.Identifier] = true
+	}
+
+	if flow.StartAction == nil {
+		addError("the flow has no StartAction")
+	} else if !identifiers[*flow.StartAction] {
+		addError("StartAction %s is not an action of the flow", *flow.StartAction)
+	}
+
+	for i, action := range flow.Actions {
+		block := fmt.Sprintf("Actions[%d] (%s)", i, action.Identifier)
+
+		switch {
+		case action.Type == "":
+			addError("%s has no Type", block)
+		case !flowActionTypes[action.Type]:
+			problems = append(problems, flowContentProblem{
+				warning: true,
+				message: fmt.Sprintf("%s has the unknown Type %s", block, action.Type),
+			})
+		}
+
+		var next []string
+		if action.Transitions != nil {
+			if action.Transitions.NextAction != "" {
+				next = append(next, action.Transitions.NextAction)
+			}
+
+			for _, transition := range action.Transitions.Errors {
+				next = append(next, transition.NextAction)
+			}
+
+			for _, transition := range action.Transitions.Conditions {
+				next = append(next, transition.NextAction)
+			}
+		}
+
+		if len(next) == 0 && flowActionTypes[action.Type] && !terminalFlowActionTypes[action.Type] {
+			addError("%s of Type %s has no transitions", block, action.Type)
+		}
+
+		for _, identifier := range next {
+			if !identifiers[identifier] {
+				addError("%s transitions to %q, which is not an action of the flow", block, identifier)
+			}
+		}
+	}
+
+	return problems
+}
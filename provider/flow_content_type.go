@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = FlowContentType{}
+	_ basetypes.StringValuableWithSemanticEquals = FlowContent{}
+)
+
+// FlowContentType is the type of flow language JSON, which Connect returns
+// reformatted and with the canvas layout of the flow designer.
+type FlowContentType struct {
+	basetypes.StringType
+}
+
+func (t FlowContentType) String() string {
+	return "FlowContentType"
+}
+
+func (t FlowContentType) Equal(o attr.Type) bool {
+	other, ok := o.(FlowContentType)
+
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t FlowContentType) ValueType(ctx context.Context) attr.Value {
+	return FlowContent{}
+}
+
+func (t FlowContentType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return FlowContent{StringValue: in}, nil
+}
+
+func (t FlowContentType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return FlowContent{StringValue: stringValue}, nil
+}
+
+// FlowContent is flow language JSON compared by its normalized form, see
+// normalizeFlowContent.
+type FlowContent struct {
+	basetypes.StringValue
+}
+
+func (v FlowContent) Type(ctx context.Context) attr.Type {
+	return FlowContentType{}
+}
+
+func (v FlowContent) Equal(o attr.Value) bool {
+	other, ok := o.(FlowContent)
+
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both flows only differ in key
+// ordering, whitespace, canvas layout or action ordering. Invalid JSON is
+// compared verbatim.
+func (v FlowContent) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(FlowContent)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return v.StringValue.Equal(newValue.StringValue), diags
+	}
+
+	normalized, err := normalizeFlowContent(v.ValueString())
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	newNormalized, err := normalizeFlowContent(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return normalized == newNormalized, diags
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &FlowTemplateRenderFunction{}
+
+var flowTemplatePlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+func NewFlowTemplateRenderFunction() function.Function {
+	return &FlowTemplateRenderFunction{}
+}
+
+type FlowTemplateRenderFunction struct{}
+
+func (f *FlowTemplateRenderFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "flow_template_render"
+}
+
+func (f *FlowTemplateRenderFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render a Connect flow template",
+		MarkdownDescription: "Replaces the `{{ name }}` placeholders of a flow language JSON template with the values of `references`, " +
+			"typically the ARNs of queues, prompts and Lambda functions of the target environment. Values are JSON escaped. " +
+			"An error is returned when a placeholder has no value or when the rendered flow is not valid JSON.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "template",
+				MarkdownDescription: "Flow language JSON containing `{{ name }}` placeholders.",
+			},
+			function.MapParameter{
+				Name:                "references",
+				MarkdownDescription: "Map of placeholder names to their values.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FlowTemplateRenderFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var template string
+	var references map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &template, &references))
+
+	if resp.Error != nil {
+		return
+	}
+
+	rendered, err := renderFlowTemplate(template, references)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, rendered))
+}
+
+// renderFlowTemplate substitutes the placeholders of template with the JSON
+// escaped values of references.
+func renderFlowTemplate(template string, references map[string]string) (string, error) {
+	missing := map[string]bool{}
+
+	rendered := flowTemplatePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := flowTemplatePlaceholder.FindStringSubmatch(placeholder)[1]
+
+		value, ok := references[name]
+		if !ok {
+			missing[name] = true
+			return placeholder
+		}
+
+		// The value is placed inside a JSON string, so only the quotes
+		// delimiting the marshalled string are dropped
+		escaped, _ := json.Marshal(value)
+		return string(escaped[1 : len(escaped)-1])
+	})
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return "", fmt.Errorf("no reference for placeholders: %s", strings.Join(names, ", "))
+	}
+
+	if !json.Valid([]byte(rendered)) {
+		return "", fmt.Errorf("rendered flow is not valid JSON")
+	}
+
+	return rendered, nil
+}
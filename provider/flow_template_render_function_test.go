@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRenderFlowTemplate(t *testing.T) {
+	const template = `{"Actions": [{"Parameters": {"Text": "{{ prompt }}", "QueueId": "{{queue_arn}}"}}]}`
+
+	tests := map[string]struct {
+		references map[string]string
+		wantText   string
+		wantError  string
+	}{
+		"plain value": {
+			references: map[string]string{"prompt": "Hello", "queue_arn": "arn:aws:connect:us-east-1:123456789012:instance/abcd/queue/1234"},
+			wantText:   "Hello",
+		},
+		"value ending with a quote": {
+			references: map[string]string{"prompt": `Say "hi"`, "queue_arn": "arn"},
+			wantText:   `Say "hi"`,
+		},
+		"value starting with a quote": {
+			references: map[string]string{"prompt": `"Welcome", she said`, "queue_arn": "arn"},
+			wantText:   `"Welcome", she said`,
+		},
+		"backslashes and newlines": {
+			references: map[string]string{"prompt": "C:\\flows\\\nline two\t<break/>", "queue_arn": "arn"},
+			wantText:   "C:\\flows\\\nline two\t<break/>",
+		},
+		"empty value": {
+			references: map[string]string{"prompt": "", "queue_arn": "arn"},
+			wantText:   "",
+		},
+		"missing references": {
+			references: map[string]string{},
+			wantError:  "no reference for placeholders: prompt, queue_arn",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rendered, err := renderFlowTemplate(template, test.references)
+
+			if test.wantError != "" {
+				if err == nil || err.Error() != test.wantError {
+					t.Fatalf("got error %v, want %q", err, test.wantError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var flow struct {
+				Actions []struct {
+					Parameters struct {
+						Text    string
+						QueueId string
+					}
+				}
+			}
+			if err := json.Unmarshal([]byte(rendered), &flow); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := flow.Actions[0].Parameters.Text; got != test.wantText {
+				t.Errorf("Text: got %q, want %q", got, test.wantText)
+			}
+
+			if got := flow.Actions[0].Parameters.QueueId; got != test.references["queue_arn"] {
+				t.Errorf("QueueId: got %q, want %q", got, test.references["queue_arn"])
+			}
+		})
+	}
+}
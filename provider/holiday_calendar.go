@@ -0,0 +1,726 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &HolidayCalendarResource{}
+var _ resource.ResourceWithModifyPlan = &HolidayCalendarResource{}
+
+// holidayCalendarResourceType is the type of the holiday calendar resource
+// without the provider prefix, e.g. in operation_policies.
+const holidayCalendarResourceType = "connect_holiday_calendar"
+
+// holidayDatePattern matches the dates of holidays: a date, or a month and
+// day for holidays recurring every year of the calendar.
+var holidayDatePattern = regexp.MustCompile(`^(\d{4}-)?\d{2}-\d{2}$`)
+
+func NewHolidayCalendarResource() resource.Resource {
+	return &HolidayCalendarResource{}
+}
+
+// HolidayCalendarResource materializes holidays as hours of operation
+// overrides of several hours of operation. Overrides it did not create are
+// left alone unless they have the name of a holiday.
+type HolidayCalendarResource struct {
+	providerData *ProviderData
+}
+
+type HolidayCalendarResourceModel struct {
+	InstanceID          types.String            `tfsdk:"instance_id"`
+	InstanceAlias       types.String            `tfsdk:"instance_alias"`
+	HoursOfOperationIDs types.Set               `tfsdk:"hours_of_operation_ids"`
+	Years               types.Set               `tfsdk:"years"`
+	Holidays            map[string]HolidayModel `tfsdk:"holidays"`
+	Overrides           types.Map               `tfsdk:"overrides"`
+	Timeouts            timeouts.Value          `tfsdk:"timeouts"`
+	Override            *OverrideModel          `tfsdk:"override"`
+}
+
+type HolidayModel struct {
+	Date        types.String `tfsdk:"date"`
+	EndDate     types.String `tfsdk:"end_date"`
+	Description types.String `tfsdk:"description"`
+	Config      types.List   `tfsdk:"config"`
+}
+
+// holidayOverride is an hours of operation override materializing a
+// holiday.
+type holidayOverride struct {
+	name          string
+	description   string
+	effectiveFrom string
+	effectiveTill string
+	config        []conntypes.HoursOfOperationOverrideConfig
+}
+
+func (r *HolidayCalendarResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + holidayCalendarResourceType
+}
+
+func (r *HolidayCalendarResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Materializes a holiday calendar as hours of operation overrides of one or more hours of operation, keeping them in sync when the calendar changes",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"hours_of_operation_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the hours of operation the holidays are overrides of.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"years": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "Years recurring holidays, whose date is a month and day, are materialized for. Their overrides are named after the holiday and the year, e.g. `New Year 2027`.",
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.Between(2000, 2999)),
+				},
+			},
+			"holidays": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Holidays by name, e.g. from the `ics_to_hours_of_operation_overrides` function.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 122)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							Required:    true,
+							Description: "First day of the holiday, `YYYY-MM-DD`, or `MM-DD` for a holiday recurring every year of `years`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(holidayDatePattern, "must be a date YYYY-MM-DD or a month and day MM-DD"),
+							},
+						},
+						"end_date": schema.StringAttribute{
+							Optional:    true,
+							Description: "Last day of the holiday, in the format of `date`. Defaults to `date`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(holidayDatePattern, "must be a date YYYY-MM-DD or a month and day MM-DD"),
+							},
+						},
+						"description": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(250),
+							},
+						},
+						"config": hoursOfOperationOverrideConfigAttribute("Hours the hours of operation are open during the holiday, in the format of the `hours_of_operation_config` function. The hours of operation are closed during the holiday if not set."),
+					},
+				},
+			},
+			"overrides": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the overrides by `<hours_of_operation_id>/<override name>`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+// hoursOfOperationOverrideConfigAttribute returns the optional config of an
+// hours of operation override, in the format of the
+// `hours_of_operation_config` function.
+func hoursOfOperationOverrideConfigAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(100),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"day": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"),
+					},
+				},
+				"start_time": hoursOfOperationTimeAttribute(),
+				"end_time":   hoursOfOperationTimeAttribute(),
+			},
+		},
+	}
+}
+
+func hoursOfOperationTimeAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Required: true,
+		Attributes: map[string]schema.Attribute{
+			"hours": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 23),
+				},
+			},
+			"minutes": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 59),
+				},
+			},
+		},
+	}
+}
+
+func (r *HolidayCalendarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *HolidayCalendarResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+
+	// Nothing to compare on create or destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || !req.Plan.Raw.IsFullyKnown() {
+		return
+	}
+
+	var plan, state HolidayCalendarResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Overrides deleted or drifted outside Terraform are missing from the
+	// state, so they are recreated or updated by planning new overrides
+	expected, diags := plan.overrideKeys(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || state.Overrides.IsNull() {
+		return
+	}
+
+	if !slices.Equal(expected, slices.Sorted(maps.Keys(state.Overrides.Elements()))) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("overrides"), types.MapUnknown(types.StringType))...)
+	}
+}
+
+func (r *HolidayCalendarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(holidayCalendarResourceType, resp) {
+		return
+	}
+
+	var data HolidayCalendarResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(holidayCalendarResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(holidayCalendarResourceType, data.Override)
+	resp.Diagnostics.Append(r.reconcile(ctx, conn, &data, map[string]string{})...)
+
+	// Save the overrides reconciled, even on error, into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HolidayCalendarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HolidayCalendarResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(holidayCalendarResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	desired, diags := data.holidayOverrides(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	managed := map[string]string{}
+	resp.Diagnostics.Append(data.Overrides.ElementsAs(ctx, &managed, false)...)
+
+	var hoursOfOperationIDs []string
+	resp.Diagnostics.Append(data.HoursOfOperationIDs.ElementsAs(ctx, &hoursOfOperationIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.providerData.resourceConnectClient(holidayCalendarResourceType, data.Override)
+	overrides := map[string]string{}
+
+	// Only the overrides that still match their holiday are kept, so the
+	// next plan updates the others
+	for _, hoursOfOperationID := range hoursOfOperationIDs {
+		existing, err := hoursOfOperationOverrides(ctx, conn, data.InstanceID.ValueString(), hoursOfOperationID)
+
+		if isNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error listing Connect Hours of Operation Overrides", fmt.Sprintf("Could not list the overrides of hours of operation %s", hoursOfOperationID), err))
+			return
+		}
+
+		for _, override := range desired {
+			key := hoursOfOperationID + "/" + override.name
+			current, ok := existing[override.name]
+
+			if _, isManaged := managed[key]; isManaged && ok && override.matches(current) {
+				overrides[key] = aws.ToString(current.HoursOfOperationOverrideId)
+			}
+		}
+	}
+
+	data.Overrides, diags = types.MapValueFrom(ctx, types.StringType, overrides)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HolidayCalendarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, holidayCalendarResourceType, req, resp) {
+		return
+	}
+
+	var data, state HolidayCalendarResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	managed := map[string]string{}
+	resp.Diagnostics.Append(state.Overrides.ElementsAs(ctx, &managed, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(holidayCalendarResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	// Overrides of hours of operation removed from the calendar are deleted
+	// with the other overrides no longer planned
+	conn := r.providerData.resourceConnectClient(holidayCalendarResourceType, data.Override)
+	resp.Diagnostics.Append(r.reconcile(ctx, conn, &data, managed)...)
+
+	// Save the overrides reconciled, even on error, into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HolidayCalendarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(holidayCalendarResourceType, resp) {
+		return
+	}
+
+	var data HolidayCalendarResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	managed := map[string]string{}
+	resp.Diagnostics.Append(data.Overrides.ElementsAs(ctx, &managed, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(holidayCalendarResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(holidayCalendarResourceType, data.Override)
+
+	for _, key := range slices.Sorted(maps.Keys(managed)) {
+		hoursOfOperationID, _, _ := strings.Cut(key, "/")
+
+		_, err := conn.DeleteHoursOfOperationOverride(ctx, &connect.DeleteHoursOfOperationOverrideInput{
+			InstanceId:                 aws.String(data.InstanceID.ValueString()),
+			HoursOfOperationId:         aws.String(hoursOfOperationID),
+			HoursOfOperationOverrideId: aws.String(managed[key]),
+		})
+
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.Append(apiError("Error deleting Connect Hours of Operation Override", fmt.Sprintf("Could not delete override %s", key), err))
+			return
+		}
+	}
+}
+
+// reconcile creates the planned overrides missing from every hours of
+// operation, updates the drifted ones and deletes the managed overrides no
+// longer planned. The overrides of the model are replaced with the ones
+// reconciled, so they can be saved even on error.
+func (r *HolidayCalendarResource) reconcile(ctx context.Context, conn *connect.Client, data *HolidayCalendarResourceModel, managed map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	instanceID := data.InstanceID.ValueString()
+	overrides := maps.Clone(managed)
+
+	defer func() {
+		value, d := types.MapValueFrom(ctx, types.StringType, overrides)
+		diags.Append(d...)
+		data.Overrides = value
+	}()
+
+	desired, d := data.holidayOverrides(ctx)
+	diags.Append(d...)
+
+	var hoursOfOperationIDs []string
+	diags.Append(data.HoursOfOperationIDs.ElementsAs(ctx, &hoursOfOperationIDs, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	slices.Sort(hoursOfOperationIDs)
+	planned := map[string]bool{}
+
+	for _, hoursOfOperationID := range hoursOfOperationIDs {
+		existing, err := hoursOfOperationOverrides(ctx, conn, instanceID, hoursOfOperationID)
+		if err != nil {
+			diags.Append(apiError("Error listing Connect Hours of Operation Overrides", fmt.Sprintf("Could not list the overrides of hours of operation %s", hoursOfOperationID), err))
+			return diags
+		}
+
+		for _, override := range desired {
+			key := hoursOfOperationID + "/" + override.name
+			planned[key] = true
+
+			current, ok := existing[override.name]
+			if !ok {
+				input := &connect.CreateHoursOfOperationOverrideInput{
+					InstanceId:         aws.String(instanceID),
+					HoursOfOperationId: aws.String(hoursOfOperationID),
+					Name:               aws.String(override.name),
+					EffectiveFrom:      aws.String(override.effectiveFrom),
+					EffectiveTill:      aws.String(override.effectiveTill),
+					Config:             override.config,
+				}
+
+				if override.description != "" {
+					input.Description = aws.String(override.description)
+				}
+
+				response, err := conn.CreateHoursOfOperationOverride(ctx, input)
+				if err != nil {
+					diags.Append(apiError("Error creating Connect Hours of Operation Override", fmt.Sprintf("Could not create override %s", key), err))
+					return diags
+				}
+
+				overrides[key] = aws.ToString(response.HoursOfOperationOverrideId)
+
+				continue
+			}
+
+			overrides[key] = aws.ToString(current.HoursOfOperationOverrideId)
+
+			if override.matches(current) {
+				tflog.Debug(ctx, fmt.Sprintf("Skipping UpdateHoursOfOperationOverride of %s as it matches the holiday", key))
+				continue
+			}
+
+			_, err := conn.UpdateHoursOfOperationOverride(ctx, &connect.UpdateHoursOfOperationOverrideInput{
+				InstanceId:                 aws.String(instanceID),
+				HoursOfOperationId:         aws.String(hoursOfOperationID),
+				HoursOfOperationOverrideId: current.HoursOfOperationOverrideId,
+				Name:                       aws.String(override.name),
+				Description:                aws.String(override.description),
+				EffectiveFrom:              aws.String(override.effectiveFrom),
+				EffectiveTill:              aws.String(override.effectiveTill),
+				Config:                     override.config,
+			})
+			if err != nil {
+				diags.Append(apiError("Error updating Connect Hours of Operation Override", fmt.Sprintf("Could not update override %s", key), err))
+				return diags
+			}
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(managed)) {
+		if planned[key] {
+			continue
+		}
+
+		hoursOfOperationID, _, _ := strings.Cut(key, "/")
+
+		_, err := conn.DeleteHoursOfOperationOverride(ctx, &connect.DeleteHoursOfOperationOverrideInput{
+			InstanceId:                 aws.String(instanceID),
+			HoursOfOperationId:         aws.String(hoursOfOperationID),
+			HoursOfOperationOverrideId: aws.String(managed[key]),
+		})
+		if err != nil && !isNotFound(err) {
+			diags.Append(apiError("Error deleting Connect Hours of Operation Override", fmt.Sprintf("Could not delete override %s", key), err))
+			return diags
+		}
+
+		delete(overrides, key)
+	}
+
+	return diags
+}
+
+// overrideKeys returns the sorted keys of the overrides of the calendar.
+func (m HolidayCalendarResourceModel) overrideKeys(ctx context.Context) ([]string, diag.Diagnostics) {
+	desired, diags := m.holidayOverrides(ctx)
+
+	var hoursOfOperationIDs []string
+	diags.Append(m.HoursOfOperationIDs.ElementsAs(ctx, &hoursOfOperationIDs, false)...)
+
+	var keys []string
+	for _, hoursOfOperationID := range hoursOfOperationIDs {
+		for _, override := range desired {
+			keys = append(keys, hoursOfOperationID+"/"+override.name)
+		}
+	}
+
+	slices.Sort(keys)
+
+	return keys, diags
+}
+
+// holidayOverrides returns the overrides materializing the holidays, with
+// recurring holidays expanded for every year.
+func (m HolidayCalendarResourceModel) holidayOverrides(ctx context.Context) ([]holidayOverride, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var years []int64
+	diags.Append(m.Years.ElementsAs(ctx, &years, false)...)
+	slices.Sort(years)
+
+	var overrides []holidayOverride
+
+	for _, name := range slices.Sorted(maps.Keys(m.Holidays)) {
+		holiday := m.Holidays[name]
+		attribute := path.Root("holidays").AtMapKey(name)
+
+		var config []HoursOfOperationConfigModel
+		diags.Append(holiday.Config.ElementsAs(ctx, &config, false)...)
+
+		override := holidayOverride{
+			name:        name,
+			description: holiday.Description.ValueString(),
+			config:      hoursOfOperationOverrideConfig(config),
+		}
+
+		start := holiday.Date.ValueString()
+		end := holiday.EndDate.ValueString()
+		if end == "" {
+			end = start
+		}
+
+		recurring := len(start) == len("01-02")
+		if recurring != (len(end) == len("01-02")) {
+			diags.AddAttributeError(attribute.AtName("end_date"), "Invalid Holiday", fmt.Sprintf("The end date of %s must be a month and day if and only if its date is.", name))
+			continue
+		}
+
+		if !recurring {
+			override.effectiveFrom, override.effectiveTill = start, end
+			diags.Append(override.validate(attribute)...)
+			overrides = append(overrides, override)
+
+			continue
+		}
+
+		if len(years) == 0 {
+			diags.AddAttributeError(path.Root("years"), "Missing Years", fmt.Sprintf("%s recurs every year, so the years it is materialized for must be set.", name))
+			continue
+		}
+
+		for _, year := range years {
+			occurrence := override
+			occurrence.name = fmt.Sprintf("%s %d", name, year)
+			occurrence.effectiveFrom = fmt.Sprintf("%d-%s", year, start)
+			occurrence.effectiveTill = fmt.Sprintf("%d-%s", year, end)
+
+			// Holidays spanning the new year end the following year
+			if occurrence.effectiveTill < occurrence.effectiveFrom {
+				occurrence.effectiveTill = fmt.Sprintf("%d-%s", year+1, end)
+			}
+
+			diags.Append(occurrence.validate(attribute)...)
+			overrides = append(overrides, occurrence)
+		}
+	}
+
+	return overrides, diags
+}
+
+// validate checks the effective dates of the override.
+func (o holidayOverride) validate(attribute path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	from, fromErr := time.Parse(icsOverrideDateFormat, o.effectiveFrom)
+	till, tillErr := time.Parse(icsOverrideDateFormat, o.effectiveTill)
+
+	switch {
+	case fromErr != nil:
+		diags.AddAttributeError(attribute.AtName("date"), "Invalid Holiday", fmt.Sprintf("%s starts on the invalid date %s.", o.name, o.effectiveFrom))
+	case tillErr != nil:
+		diags.AddAttributeError(attribute.AtName("end_date"), "Invalid Holiday", fmt.Sprintf("%s ends on the invalid date %s.", o.name, o.effectiveTill))
+	case till.Before(from):
+		diags.AddAttributeError(attribute.AtName("end_date"), "Invalid Holiday", fmt.Sprintf("%s ends before it starts.", o.name))
+	}
+
+	return diags
+}
+
+// matches reports whether an existing override materializes the holiday.
+func (o holidayOverride) matches(override conntypes.HoursOfOperationOverride) bool {
+	if aws.ToString(override.Description) != o.description ||
+		aws.ToString(override.EffectiveFrom) != o.effectiveFrom ||
+		aws.ToString(override.EffectiveTill) != o.effectiveTill {
+		return false
+	}
+
+	return overrideConfigEqual(override.Config, o.config)
+}
+
+// overrideConfigEqual reports whether two override configs have the same
+// entries, in any order.
+func overrideConfigEqual(a, b []conntypes.HoursOfOperationOverrideConfig) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, config := range b {
+		if !slices.ContainsFunc(a, func(current conntypes.HoursOfOperationOverrideConfig) bool {
+			return current.Day == config.Day && overrideTimeEqual(current.StartTime, config.StartTime) && overrideTimeEqual(current.EndTime, config.EndTime)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func overrideTimeEqual(a, b *conntypes.OverrideTimeSlice) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return aws.ToInt32(a.Hours) == aws.ToInt32(b.Hours) && aws.ToInt32(a.Minutes) == aws.ToInt32(b.Minutes)
+}
+
+// hoursOfOperationOverrideConfig converts hours of operation config into
+// override config. An empty config closes the hours of operation.
+func hoursOfOperationOverrideConfig(config []HoursOfOperationConfigModel) []conntypes.HoursOfOperationOverrideConfig {
+	result := []conntypes.HoursOfOperationOverrideConfig{}
+
+	for _, entry := range config {
+		result = append(result, conntypes.HoursOfOperationOverrideConfig{
+			Day: conntypes.OverrideDays(entry.Day),
+			StartTime: &conntypes.OverrideTimeSlice{
+				Hours:   aws.Int32(int32(entry.StartTime.Hours)),
+				Minutes: aws.Int32(int32(entry.StartTime.Minutes)),
+			},
+			EndTime: &conntypes.OverrideTimeSlice{
+				Hours:   aws.Int32(int32(entry.EndTime.Hours)),
+				Minutes: aws.Int32(int32(entry.EndTime.Minutes)),
+			},
+		})
+	}
+
+	return result
+}
+
+// hoursOfOperationOverrides returns the overrides of hours of operation by
+// name.
+func hoursOfOperationOverrides(ctx context.Context, conn *connect.Client, instanceID, hoursOfOperationID string) (map[string]conntypes.HoursOfOperationOverride, error) {
+	overrides, err := collectPages(ctx, func(ctx context.Context, nextToken *string) ([]conntypes.HoursOfOperationOverride, *string, error) {
+		response, err := conn.ListHoursOfOperationOverrides(ctx, &connect.ListHoursOfOperationOverridesInput{
+			InstanceId:         aws.String(instanceID),
+			HoursOfOperationId: aws.String(hoursOfOperationID),
+			MaxResults:         aws.Int32(100),
+			NextToken:          nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.HoursOfOperationOverrideList, response.NextToken, nil
+	}, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]conntypes.HoursOfOperationOverride, len(overrides))
+	for _, override := range overrides {
+		byName[aws.ToString(override.Name)] = override
+	}
+
+	return byName, nil
+}
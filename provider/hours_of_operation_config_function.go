@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &HoursOfOperationConfigFunction{}
+
+// hoursOfOperationDays lists the Connect day names in week order, keyed by
+// their lower case three letter abbreviation.
+var hoursOfOperationDays = []struct {
+	short string
+	day   string
+}{
+	{"mon", "MONDAY"},
+	{"tue", "TUESDAY"},
+	{"wed", "WEDNESDAY"},
+	{"thu", "THURSDAY"},
+	{"fri", "FRIDAY"},
+	{"sat", "SATURDAY"},
+	{"sun", "SUNDAY"},
+}
+
+var hoursOfOperationTimeAttrTypes = map[string]attr.Type{
+	"hours":   types.Int64Type,
+	"minutes": types.Int64Type,
+}
+
+var hoursOfOperationConfigAttrTypes = map[string]attr.Type{
+	"day":        types.StringType,
+	"start_time": types.ObjectType{AttrTypes: hoursOfOperationTimeAttrTypes},
+	"end_time":   types.ObjectType{AttrTypes: hoursOfOperationTimeAttrTypes},
+}
+
+type HoursOfOperationTimeModel struct {
+	Hours   int64 `tfsdk:"hours"`
+	Minutes int64 `tfsdk:"minutes"`
+}
+
+type HoursOfOperationConfigModel struct {
+	Day       string                    `tfsdk:"day"`
+	StartTime HoursOfOperationTimeModel `tfsdk:"start_time"`
+	EndTime   HoursOfOperationTimeModel `tfsdk:"end_time"`
+}
+
+type HoursOfOperationScheduleModel struct {
+	TimeZone string                        `tfsdk:"time_zone"`
+	Config   []HoursOfOperationConfigModel `tfsdk:"config"`
+}
+
+func NewHoursOfOperationConfigFunction() function.Function {
+	return &HoursOfOperationConfigFunction{}
+}
+
+type HoursOfOperationConfigFunction struct{}
+
+func (f *HoursOfOperationConfigFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "hours_of_operation_config"
+}
+
+func (f *HoursOfOperationConfigFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Expand a compact schedule into hours of operation config",
+		MarkdownDescription: "Converts a compact schedule such as `Mon-Fri 08:00-18:00; Sat 09:00-13:00` into the `time_zone` and " +
+			"`config` list of day, `start_time` and `end_time` objects expected by hours of operation. Entries are separated by `;`, " +
+			"days are given as three letter abbreviations or full names, ranges (`Mon-Fri`, wrapping ranges such as `Fri-Mon` are allowed) or " +
+			"comma separated lists, and several time ranges may be given for the same days separated by `,`. " +
+			"`24:00` may be used as end time for midnight.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schedule",
+				MarkdownDescription: "Compact schedule, e.g. `Mon-Fri 08:00-12:00,13:00-18:00; Sat 09:00-13:00`.",
+			},
+			function.StringParameter{
+				Name:                "time_zone",
+				MarkdownDescription: "Time zone of the hours of operation, e.g. `America/New_York`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"time_zone": types.StringType,
+				"config":    types.ListType{ElemType: types.ObjectType{AttrTypes: hoursOfOperationConfigAttrTypes}},
+			},
+		},
+	}
+}
+
+func (f *HoursOfOperationConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule, timeZone string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &schedule, &timeZone))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if strings.TrimSpace(timeZone) == "" {
+		resp.Error = function.NewArgumentFuncError(1, "time_zone must not be empty")
+		return
+	}
+
+	config, err := parseHoursOfOperationSchedule(schedule)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result := HoursOfOperationScheduleModel{
+		TimeZone: strings.TrimSpace(timeZone),
+		Config:   config,
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// parseHoursOfOperationSchedule parses a compact schedule into hours of
+// operation config entries, in the order they are written.
+func parseHoursOfOperationSchedule(schedule string) ([]HoursOfOperationConfigModel, error) {
+	config := []HoursOfOperationConfigModel{}
+	seen := map[string]bool{}
+
+	for _, entry := range strings.Split(schedule, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		fields := strings.Fields(entry)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid schedule entry %q, expected \"<days> <start>-<end>\"", entry)
+		}
+
+		days, err := parseHoursOfOperationDays(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, timeRange := range strings.Split(fields[1], ",") {
+			start, end, err := parseHoursOfOperationRange(timeRange)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, day := range days {
+				key := fmt.Sprintf("%s %d:%d", day, start.Hours, start.Minutes)
+				if seen[key] {
+					return nil, fmt.Errorf("duplicate schedule for %s starting at %02d:%02d", day, start.Hours, start.Minutes)
+				}
+				seen[key] = true
+
+				config = append(config, HoursOfOperationConfigModel{
+					Day:       day,
+					StartTime: start,
+					EndTime:   end,
+				})
+			}
+		}
+	}
+
+	if len(config) == 0 {
+		return nil, fmt.Errorf("schedule must contain at least one entry")
+	}
+
+	return config, nil
+}
+
+func parseHoursOfOperationDays(input string) ([]string, error) {
+	days := []string{}
+
+	for _, part := range strings.Split(input, ",") {
+		first, last, isRange := strings.Cut(part, "-")
+
+		start, err := hoursOfOperationDayIndex(first)
+		if err != nil {
+			return nil, err
+		}
+
+		end := start
+		if isRange {
+			if end, err = hoursOfOperationDayIndex(last); err != nil {
+				return nil, err
+			}
+		}
+
+		for i := start; ; i = (i + 1) % len(hoursOfOperationDays) {
+			days = append(days, hoursOfOperationDays[i].day)
+			if i == end {
+				break
+			}
+		}
+	}
+
+	return days, nil
+}
+
+// hoursOfOperationDayIndex returns the index in hoursOfOperationDays of a day
+// given by its three letter abbreviation or its full name, in any case.
+func hoursOfOperationDayIndex(input string) (int, error) {
+	name := strings.TrimSpace(input)
+
+	for i, day := range hoursOfOperationDays {
+		if strings.EqualFold(name, day.short) || strings.EqualFold(name, day.day) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid day %q, expected one of Mon, Tue, Wed, Thu, Fri, Sat, Sun", input)
+}
+
+func parseHoursOfOperationRange(input string) (HoursOfOperationTimeModel, HoursOfOperationTimeModel, error) {
+	first, last, ok := strings.Cut(strings.TrimSpace(input), "-")
+	if !ok {
+		return HoursOfOperationTimeModel{}, HoursOfOperationTimeModel{}, fmt.Errorf("invalid time range %q, expected \"HH:MM-HH:MM\"", input)
+	}
+
+	start, err := parseHoursOfOperationTime(first, false)
+	if err != nil {
+		return HoursOfOperationTimeModel{}, HoursOfOperationTimeModel{}, err
+	}
+
+	end, err := parseHoursOfOperationTime(last, true)
+	if err != nil {
+		return HoursOfOperationTimeModel{}, HoursOfOperationTimeModel{}, err
+	}
+
+	// An end time of 00:00 means midnight at the end of the day.
+	if end != (HoursOfOperationTimeModel{}) && end.Hours*60+end.Minutes <= start.Hours*60+start.Minutes {
+		return HoursOfOperationTimeModel{}, HoursOfOperationTimeModel{}, fmt.Errorf("invalid time range %q, end must be after start", input)
+	}
+
+	return start, end, nil
+}
+
+func parseHoursOfOperationTime(input string, isEnd bool) (HoursOfOperationTimeModel, error) {
+	hoursInput, minutesInput, ok := strings.Cut(strings.TrimSpace(input), ":")
+
+	hours, hoursErr := strconv.ParseInt(hoursInput, 10, 64)
+	minutes, minutesErr := strconv.ParseInt(minutesInput, 10, 64)
+
+	if !ok || hoursErr != nil || minutesErr != nil || len(minutesInput) != 2 {
+		return HoursOfOperationTimeModel{}, fmt.Errorf("invalid time %q, expected \"HH:MM\"", input)
+	}
+
+	if isEnd && hours == 24 && minutes == 0 {
+		return HoursOfOperationTimeModel{}, nil
+	}
+
+	if hours < 0 || hours > 23 || minutes < 0 || minutes > 59 {
+		return HoursOfOperationTimeModel{}, fmt.Errorf("invalid time %q, hours must be between 00 and 23 and minutes between 00 and 59", input)
+	}
+
+	return HoursOfOperationTimeModel{Hours: hours, Minutes: minutes}, nil
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseHoursOfOperationSchedule(t *testing.T) {
+	morning := HoursOfOperationConfigModel{StartTime: HoursOfOperationTimeModel{Hours: 8}, EndTime: HoursOfOperationTimeModel{Hours: 12}}
+
+	// config returns morning entries for days
+	config := func(days ...string) []HoursOfOperationConfigModel {
+		entries := []HoursOfOperationConfigModel{}
+		for _, day := range days {
+			entry := morning
+			entry.Day = day
+			entries = append(entries, entry)
+		}
+
+		return entries
+	}
+
+	tests := map[string]struct {
+		schedule  string
+		want      []HoursOfOperationConfigModel
+		wantError string
+	}{
+		"abbreviation": {
+			schedule: "Mon 08:00-12:00",
+			want:     config("MONDAY"),
+		},
+		"full name in any case": {
+			schedule: "monday,SUNDAY 08:00-12:00",
+			want:     config("MONDAY", "SUNDAY"),
+		},
+		"range": {
+			schedule: "Mon-Wed 08:00-12:00",
+			want:     config("MONDAY", "TUESDAY", "WEDNESDAY"),
+		},
+		"wrapping range": {
+			schedule: "Fri-Mon 08:00-12:00",
+			want:     config("FRIDAY", "SATURDAY", "SUNDAY", "MONDAY"),
+		},
+		"several entries and time ranges": {
+			schedule: "Mon 08:00-12:00,13:00-24:00; Sat 09:30-13:00",
+			want: []HoursOfOperationConfigModel{
+				{Day: "MONDAY", StartTime: HoursOfOperationTimeModel{Hours: 8}, EndTime: HoursOfOperationTimeModel{Hours: 12}},
+				{Day: "MONDAY", StartTime: HoursOfOperationTimeModel{Hours: 13}, EndTime: HoursOfOperationTimeModel{}},
+				{Day: "SATURDAY", StartTime: HoursOfOperationTimeModel{Hours: 9, Minutes: 30}, EndTime: HoursOfOperationTimeModel{Hours: 13}},
+			},
+		},
+		"word starting with a day": {
+			schedule:  "Monkey 08:00-12:00",
+			wantError: `invalid day "Monkey", expected one of Mon, Tue, Wed, Thu, Fri, Sat, Sun`,
+		},
+		"partial day name": {
+			schedule:  "Tues 08:00-12:00",
+			wantError: `invalid day "Tues", expected one of Mon, Tue, Wed, Thu, Fri, Sat, Sun`,
+		},
+		"duplicate start": {
+			schedule:  "Mon-Fri 08:00-12:00; Fri 08:00-10:00",
+			wantError: "duplicate schedule for FRIDAY starting at 08:00",
+		},
+		"end before start": {
+			schedule:  "Mon 12:00-08:00",
+			wantError: `invalid time range "12:00-08:00", end must be after start`,
+		},
+		"invalid time": {
+			schedule:  "Mon 8-12:00",
+			wantError: `invalid time "8", expected "HH:MM"`,
+		},
+		"24:00 start": {
+			schedule:  "Mon 24:00-12:00",
+			wantError: `invalid time "24:00", hours must be between 00 and 23 and minutes between 00 and 59`,
+		},
+		"missing times": {
+			schedule:  "Mon",
+			wantError: `invalid schedule entry "Mon", expected "<days> <start>-<end>"`,
+		},
+		"empty": {
+			schedule:  " ; ",
+			wantError: "schedule must contain at least one entry",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseHoursOfOperationSchedule(test.schedule)
+
+			if test.wantError != "" {
+				if err == nil || err.Error() != test.wantError {
+					t.Fatalf("got error %v, want %q", err, test.wantError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,392 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &HoursOfOperationOverrideResource{}
+var _ resource.ResourceWithImportState = &HoursOfOperationOverrideResource{}
+var _ resource.ResourceWithModifyPlan = &HoursOfOperationOverrideResource{}
+
+// hoursOfOperationOverrideResourceType is the type of the hours of operation
+// override resource without the provider prefix, e.g. in operation_policies.
+const hoursOfOperationOverrideResourceType = "connect_hours_of_operation_override"
+
+// overrideDatePattern matches the effective dates of overrides.
+var overrideDatePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func NewHoursOfOperationOverrideResource() resource.Resource {
+	return &HoursOfOperationOverrideResource{}
+}
+
+type HoursOfOperationOverrideResource struct {
+	providerData *ProviderData
+}
+
+type HoursOfOperationOverrideResourceModel struct {
+	InstanceID                 types.String   `tfsdk:"instance_id"`
+	InstanceAlias              types.String   `tfsdk:"instance_alias"`
+	HoursOfOperationID         types.String   `tfsdk:"hours_of_operation_id"`
+	HoursOfOperationOverrideID types.String   `tfsdk:"hours_of_operation_override_id"`
+	Name                       types.String   `tfsdk:"name"`
+	Description                NullableString `tfsdk:"description"`
+	EffectiveFrom              types.String   `tfsdk:"effective_from"`
+	EffectiveTill              types.String   `tfsdk:"effective_till"`
+	Config                     types.List     `tfsdk:"config"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
+	Override                   *OverrideModel `tfsdk:"override"`
+}
+
+// config returns the override config of the model. An empty config closes
+// the hours of operation.
+func (m HoursOfOperationOverrideResourceModel) config(ctx context.Context) ([]conntypes.HoursOfOperationOverrideConfig, diag.Diagnostics) {
+	var config []HoursOfOperationConfigModel
+	diags := m.Config.ElementsAs(ctx, &config, false)
+
+	return hoursOfOperationOverrideConfig(config), diags
+}
+
+func (r *HoursOfOperationOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + hoursOfOperationOverrideResourceType
+}
+
+func (r *HoursOfOperationOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an override of Connect hours of operation, e.g. a holiday, effective between two dates",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"hours_of_operation_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hours_of_operation_override_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 127),
+				},
+			},
+			"description": schema.StringAttribute{
+				CustomType: NullableStringType{},
+				Optional:   true,
+				Validators: []validator.String{
+					// Empty is allowed and the same as no description
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+			"effective_from": schema.StringAttribute{
+				Required:    true,
+				Description: "First day the override is effective, `YYYY-MM-DD`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(overrideDatePattern, "must be a date YYYY-MM-DD"),
+				},
+			},
+			"effective_till": schema.StringAttribute{
+				Required:    true,
+				Description: "Last day the override is effective, `YYYY-MM-DD`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(overrideDatePattern, "must be a date YYYY-MM-DD"),
+				},
+			},
+			"config": hoursOfOperationOverrideConfigAttribute("Hours the hours of operation are open while the override is effective, in the format of the `hours_of_operation_config` function. The hours of operation are closed while the override is effective if not set."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *HoursOfOperationOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *HoursOfOperationOverrideResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *HoursOfOperationOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(hoursOfOperationOverrideResourceType, resp) {
+		return
+	}
+
+	var data HoursOfOperationOverrideResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	config, diags := data.config(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(hoursOfOperationOverrideResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(hoursOfOperationOverrideResourceType, data.Override)
+	input := &connect.CreateHoursOfOperationOverrideInput{
+		InstanceId:         aws.String(data.InstanceID.ValueString()),
+		HoursOfOperationId: aws.String(data.HoursOfOperationID.ValueString()),
+		Name:               aws.String(data.Name.ValueString()),
+		EffectiveFrom:      aws.String(data.EffectiveFrom.ValueString()),
+		EffectiveTill:      aws.String(data.EffectiveTill.ValueString()),
+		Config:             config,
+	}
+
+	if data.Description.ValueString() != "" {
+		input.Description = aws.String(data.Description.ValueString())
+	}
+
+	response, err := conn.CreateHoursOfOperationOverride(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect Hours of Operation Override", fmt.Sprintf("Could not create override %s of hours of operation %s", data.Name.ValueString(), data.HoursOfOperationID.ValueString()), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	data.HoursOfOperationOverrideID = types.StringValue(aws.ToString(response.HoursOfOperationOverrideId))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HoursOfOperationOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HoursOfOperationOverrideResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	config, diags := data.config(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(hoursOfOperationOverrideResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	// The override no longer exists when it is missing from the overrides of
+	// the hours of operation
+	conn := r.providerData.resourceConnectClient(hoursOfOperationOverrideResourceType, data.Override)
+	overrides, err := hoursOfOperationOverrides(ctx, conn, data.InstanceID.ValueString(), data.HoursOfOperationID.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Hours of Operation Override", fmt.Sprintf("Could not list the overrides of hours of operation %s", data.HoursOfOperationID.ValueString()), err))
+		return
+	}
+
+	var override *conntypes.HoursOfOperationOverride
+	for _, current := range overrides {
+		if aws.ToString(current.HoursOfOperationOverrideId) == data.HoursOfOperationOverrideID.ValueString() {
+			override = &current
+			break
+		}
+	}
+
+	if override == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(aws.ToString(override.Name))
+	data.Description = flattenNullableString(ctx, data.Description, override.Description)
+	data.EffectiveFrom = types.StringValue(aws.ToString(override.EffectiveFrom))
+	data.EffectiveTill = types.StringValue(aws.ToString(override.EffectiveTill))
+
+	// The config is kept as configured when it only differs by the order of
+	// its entries
+	if !overrideConfigEqual(override.Config, config) {
+		data.Config, diags = flattenHoursOfOperationOverrideConfig(ctx, override.Config)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HoursOfOperationOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, hoursOfOperationOverrideResourceType, req, resp) {
+		return
+	}
+
+	var data HoursOfOperationOverrideResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	config, diags := data.config(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(hoursOfOperationOverrideResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(hoursOfOperationOverrideResourceType, data.Override)
+	_, err := conn.UpdateHoursOfOperationOverride(ctx, &connect.UpdateHoursOfOperationOverrideInput{
+		InstanceId:                 aws.String(data.InstanceID.ValueString()),
+		HoursOfOperationId:         aws.String(data.HoursOfOperationID.ValueString()),
+		HoursOfOperationOverrideId: aws.String(data.HoursOfOperationOverrideID.ValueString()),
+		Name:                       aws.String(data.Name.ValueString()),
+		Description:                aws.String(data.Description.ValueString()),
+		EffectiveFrom:              aws.String(data.EffectiveFrom.ValueString()),
+		EffectiveTill:              aws.String(data.EffectiveTill.ValueString()),
+		Config:                     config,
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error updating Connect Hours of Operation Override", fmt.Sprintf("Could not update override %s of hours of operation %s", data.HoursOfOperationOverrideID.ValueString(), data.HoursOfOperationID.ValueString()), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HoursOfOperationOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(hoursOfOperationOverrideResourceType, resp) {
+		return
+	}
+
+	var data HoursOfOperationOverrideResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(hoursOfOperationOverrideResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(hoursOfOperationOverrideResourceType, data.Override)
+	_, err := conn.DeleteHoursOfOperationOverride(ctx, &connect.DeleteHoursOfOperationOverrideInput{
+		InstanceId:                 aws.String(data.InstanceID.ValueString()),
+		HoursOfOperationId:         aws.String(data.HoursOfOperationID.ValueString()),
+		HoursOfOperationOverrideId: aws.String(data.HoursOfOperationOverrideID.ValueString()),
+	})
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error deleting Connect Hours of Operation Override", fmt.Sprintf("Could not delete override %s of hours of operation %s", data.HoursOfOperationOverrideID.ValueString(), data.HoursOfOperationID.ValueString()), err))
+		return
+	}
+}
+
+func (r *HoursOfOperationOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with format <instance_id>:<hours_of_operation_id>:<hours_of_operation_override_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hours_of_operation_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hours_of_operation_override_id"), parts[2])...)
+}
+
+// flattenHoursOfOperationOverrideConfig returns override config in the format
+// of the `hours_of_operation_config` function, null if it closes the hours of
+// operation.
+func flattenHoursOfOperationOverrideConfig(ctx context.Context, config []conntypes.HoursOfOperationOverrideConfig) (types.List, diag.Diagnostics) {
+	elementType := types.ObjectType{AttrTypes: hoursOfOperationConfigAttrTypes}
+
+	if len(config) == 0 {
+		return types.ListNull(elementType), nil
+	}
+
+	models := make([]HoursOfOperationConfigModel, 0, len(config))
+	for _, entry := range config {
+		model := HoursOfOperationConfigModel{Day: string(entry.Day)}
+
+		if entry.StartTime != nil {
+			model.StartTime = HoursOfOperationTimeModel{Hours: int64(aws.ToInt32(entry.StartTime.Hours)), Minutes: int64(aws.ToInt32(entry.StartTime.Minutes))}
+		}
+
+		if entry.EndTime != nil {
+			model.EndTime = HoursOfOperationTimeModel{Hours: int64(aws.ToInt32(entry.EndTime.Hours)), Minutes: int64(aws.ToInt32(entry.EndTime.Minutes))}
+		}
+
+		models = append(models, model)
+	}
+
+	return types.ListValueFrom(ctx, elementType, models)
+}
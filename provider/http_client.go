@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// networkOptions returns the options loading the AWS config for the FIPS and
+// dual-stack endpoints, custom CA bundle and HTTP proxies set in the provider
+// configuration, e.g. for GovCloud or corporate networks.
+func networkOptions(data AwsExtProviderModel) ([]func(*config.LoadOptions) error, error) {
+	var options []func(*config.LoadOptions) error
+
+	if data.UseFIPSEndpoint.ValueBool() {
+		options = append(options, config.WithUseFIPSEndpoint(aws.FIPSEndpointStateEnabled))
+	}
+
+	if data.UseDualstackEndpoint.ValueBool() {
+		options = append(options, config.WithUseDualStackEndpoint(aws.DualStackEndpointStateEnabled))
+	}
+
+	if data.CustomCABundle.ValueString() != "" {
+		bundle, err := os.ReadFile(expandHomePaths([]string{data.CustomCABundle.ValueString()})[0])
+		if err != nil {
+			return nil, fmt.Errorf("could not read custom_ca_bundle: %w", err)
+		}
+
+		options = append(options, config.WithCustomCABundle(bytes.NewReader(bundle)))
+	}
+
+	proxy, err := proxyFunc(data.HTTPProxy, data.HTTPSProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	// The CA bundle is added to the transport of this client when the config
+	// is loaded, so it must stay a buildable client
+	options = append(options, config.WithHTTPClient(awshttp.NewBuildableClient().WithTransportOptions(func(tr *http.Transport) {
+		tr.Proxy = proxy
+	})))
+
+	return options, nil
+}
+
+// proxyFunc returns the proxy of the requests by URL scheme, falling back to
+// the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables for the
+// schemes without a proxy set.
+func proxyFunc(httpProxy, httpsProxy types.String) (func(*http.Request) (*url.URL, error), error) {
+	proxies := map[string]*url.URL{}
+	for scheme, value := range map[string]types.String{"http": httpProxy, "https": httpsProxy} {
+		if value.ValueString() == "" {
+			continue
+		}
+
+		proxy, err := url.Parse(value.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s_proxy: %w", scheme, err)
+		}
+
+		proxies[scheme] = proxy
+	}
+
+	return func(req *http.Request) (*url.URL, error) {
+		if proxy, ok := proxies[req.URL.Scheme]; ok {
+			return proxy, nil
+		}
+
+		return http.ProxyFromEnvironment(req)
+	}, nil
+}
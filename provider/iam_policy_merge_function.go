@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &IamPolicyMergeFunction{}
+
+// iamPolicyVersion is the current IAM policy language version, used when no
+// document specifies one.
+const iamPolicyVersion = "2012-10-17"
+
+// iamPolicy is a merged IAM policy document, with its elements in the order
+// of the IAM documentation.
+type iamPolicy struct {
+	Version   string `json:"Version"`
+	Id        string `json:"Id,omitempty"`
+	Statement []any  `json:"Statement"`
+}
+
+func NewIamPolicyMergeFunction() function.Function {
+	return &IamPolicyMergeFunction{}
+}
+
+type IamPolicyMergeFunction struct{}
+
+func (f *IamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f *IamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merge IAM policy documents",
+		MarkdownDescription: "Merges the statements of IAM policy JSON documents into a single document. A statement with a `Sid` " +
+			"replaces the statement with the same `Sid` of an earlier document, in its position; statements without `Sid` are kept " +
+			"unless they are identical to an earlier one. The merged document uses the latest `Version` of the documents and the " +
+			"`Id` of the last document having one. Null and empty documents are skipped, other documents must have a `Statement`.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				MarkdownDescription: "IAM policy JSON documents, e.g. from `jsonencode` or `aws_iam_policy_document`, in increasing order of precedence.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *IamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []*string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies))
+
+	if resp.Error != nil {
+		return
+	}
+
+	merged, err := mergeIamPolicies(policies)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, err := marshalCanonicalJSON(merged)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// mergeIamPolicies merges the statements of IAM policy documents, later
+// statements replacing earlier ones with the same Sid.
+func mergeIamPolicies(policies []*string) (iamPolicy, error) {
+	merged := iamPolicy{Statement: []any{}}
+
+	sids := map[string]int{}
+	anonymous := map[string]bool{}
+
+	for i, policy := range policies {
+		if policy == nil || len(bytes.TrimSpace([]byte(*policy))) == 0 {
+			continue
+		}
+
+		var document struct {
+			Version   string
+			Id        string
+			Statement json.RawMessage
+		}
+
+		if err := json.Unmarshal([]byte(*policy), &document); err != nil {
+			return iamPolicy{}, fmt.Errorf("policies[%d] is not an IAM policy JSON document: %w", i, err)
+		}
+
+		statements, err := iamPolicyStatements(document.Statement)
+		if err != nil {
+			return iamPolicy{}, fmt.Errorf("policies[%d]: %w", i, err)
+		}
+
+		// Versions are dates, so the latest one sorts last
+		if document.Version > merged.Version {
+			merged.Version = document.Version
+		}
+
+		if document.Id != "" {
+			merged.Id = document.Id
+		}
+
+		for _, statement := range statements {
+			sid, _ := statement["Sid"].(string)
+
+			if sid == "" {
+				key, err := marshalCanonicalJSON(statement)
+				if err != nil {
+					return iamPolicy{}, err
+				}
+
+				if anonymous[key] {
+					continue
+				}
+
+				anonymous[key] = true
+			} else if position, ok := sids[sid]; ok {
+				merged.Statement[position] = statement
+				continue
+			} else {
+				sids[sid] = len(merged.Statement)
+			}
+
+			merged.Statement = append(merged.Statement, statement)
+		}
+	}
+
+	if merged.Version == "" {
+		merged.Version = iamPolicyVersion
+	}
+
+	return merged, nil
+}
+
+// iamPolicyStatements decodes the Statement element of an IAM policy, which
+// is either a single statement or a list of statements. A missing Statement
+// is an error rather than no statements, as it is likely misspelled.
+func iamPolicyStatements(raw json.RawMessage) ([]map[string]any, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, fmt.Errorf("missing Statement")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	if raw[0] != '[' {
+		var statement map[string]any
+		if err := decoder.Decode(&statement); err != nil {
+			return nil, fmt.Errorf("invalid Statement: %w", err)
+		}
+
+		return []map[string]any{statement}, nil
+	}
+
+	var statements []map[string]any
+	if err := decoder.Decode(&statements); err != nil {
+		return nil, fmt.Errorf("invalid Statement: %w", err)
+	}
+
+	for i, statement := range statements {
+		if statement == nil {
+			return nil, fmt.Errorf("invalid Statement: element %d is null", i)
+		}
+	}
+
+	return statements, nil
+}
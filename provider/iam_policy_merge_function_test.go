@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIamPolicyMerge(t *testing.T) {
+	tests := map[string]struct {
+		policies  []attr.Value
+		want      string
+		wantError string
+	}{
+		"statements are appended": {
+			policies: []attr.Value{
+				types.StringValue(`{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`),
+				types.StringValue(`{"Statement": {"Sid": "Write", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}}`),
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"Read"},{"Action":"s3:PutObject","Effect":"Allow","Resource":"*","Sid":"Write"}]}`,
+		},
+		"later Sids replace earlier ones in place": {
+			policies: []attr.Value{
+				types.StringValue(`{"Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}, {"Sid": "Write", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}]}`),
+				types.StringValue(`{"Statement": [{"Sid": "Read", "Effect": "Deny", "Action": "s3:GetObject", "Resource": "*"}]}`),
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Deny","Resource":"*","Sid":"Read"},{"Action":"s3:PutObject","Effect":"Allow","Resource":"*","Sid":"Write"}]}`,
+		},
+		"identical statements without Sid are kept once": {
+			policies: []attr.Value{
+				types.StringValue(`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`),
+				types.StringValue(`{"Statement": [{"Resource": "*", "Action": "s3:GetObject", "Effect": "Allow"}, {"Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*"}]}`),
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"},{"Action":"s3:ListBucket","Effect":"Allow","Resource":"*"}]}`,
+		},
+		"latest version and last Id": {
+			policies: []attr.Value{
+				types.StringValue(`{"Version": "2012-10-17", "Id": "first", "Statement": []}`),
+				types.StringValue(`{"Version": "2008-10-17", "Id": "second", "Statement": []}`),
+				types.StringValue(`{"Statement": []}`),
+			},
+			want: `{"Version":"2012-10-17","Id":"second","Statement":[]}`,
+		},
+		"numbers are kept as written": {
+			policies: []attr.Value{
+				types.StringValue(`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "Condition": {"NumericLessThan": {"s3:max-keys": 10000000000000001}}}]}`),
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Condition":{"NumericLessThan":{"s3:max-keys":10000000000000001}},"Effect":"Allow","Resource":"*"}]}`,
+		},
+		"null and empty documents are skipped": {
+			policies: []attr.Value{
+				types.StringNull(),
+				types.StringValue(" "),
+				types.StringValue(`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`),
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		"no documents": {
+			policies: []attr.Value{},
+			want:     `{"Version":"2012-10-17","Statement":[]}`,
+		},
+		"invalid JSON": {
+			policies:  []attr.Value{types.StringValue(`{"Statement": [`)},
+			wantError: "policies[0] is not an IAM policy JSON document: unexpected end of JSON input",
+		},
+		"missing Statement": {
+			policies:  []attr.Value{types.StringValue(`{"Version": "2012-10-17", "Statements": []}`)},
+			wantError: "policies[0]: missing Statement",
+		},
+		"null statement": {
+			policies:  []attr.Value{types.StringValue(`{"Statement": [null]}`)},
+			wantError: "policies[0]: invalid Statement: element 0 is null",
+		},
+		"statement not an object": {
+			policies:  []attr.Value{types.StringValue(`{"Statement": "s3:GetObject"}`)},
+			wantError: "policies[0]: invalid Statement: json: cannot unmarshal string into Go value of type map[string]interface {}",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policies := types.ListValueMust(types.StringType, test.policies)
+			got, err := runFunction(NewIamPolicyMergeFunction(), types.StringUnknown(), policies)
+
+			if test.wantError != "" {
+				if err == nil || err.Text != test.wantError {
+					t.Fatalf("got error %v, want %q", err, test.wantError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !got.Equal(types.StringValue(test.want)) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,441 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	// Time zones are resolved even where the system has no zoneinfo, e.g. on
+	// Windows
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &IcsToHoursOfOperationOverridesFunction{}
+
+// icsOverrideDateFormat is the date format of the effective dates of hours of
+// operation overrides.
+const icsOverrideDateFormat = "2006-01-02"
+
+var hoursOfOperationOverrideAttrTypes = map[string]attr.Type{
+	"name":           types.StringType,
+	"description":    types.StringType,
+	"effective_from": types.StringType,
+	"effective_till": types.StringType,
+	"closed":         types.BoolType,
+	"config":         types.ListType{ElemType: types.ObjectType{AttrTypes: hoursOfOperationConfigAttrTypes}},
+}
+
+type HoursOfOperationOverrideModel struct {
+	Name          string                        `tfsdk:"name"`
+	Description   string                        `tfsdk:"description"`
+	EffectiveFrom string                        `tfsdk:"effective_from"`
+	EffectiveTill string                        `tfsdk:"effective_till"`
+	Closed        bool                          `tfsdk:"closed"`
+	Config        []HoursOfOperationConfigModel `tfsdk:"config"`
+}
+
+// icsEvent is the VEVENT properties relevant to overrides, unescaped.
+type icsEvent struct {
+	summary     string
+	description string
+	status      string
+	dtstart     icsProperty
+	dtend       *icsProperty
+	recurring   bool
+}
+
+// icsProperty is a content line of an iCalendar object.
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+func NewIcsToHoursOfOperationOverridesFunction() function.Function {
+	return &IcsToHoursOfOperationOverridesFunction{}
+}
+
+type IcsToHoursOfOperationOverridesFunction struct{}
+
+func (f *IcsToHoursOfOperationOverridesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ics_to_hours_of_operation_overrides"
+}
+
+func (f *IcsToHoursOfOperationOverridesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert an iCalendar holiday calendar into hours of operation overrides",
+		MarkdownDescription: "Parses the events of an iCalendar (`.ics`) calendar, e.g. the holiday calendar exported by an HR system, " +
+			"into hours of operation overrides ordered by date. All-day events close the hours of operation from their first to their " +
+			"last day: `closed` is true and `config` is empty. Timed events open the hours of operation from their start to their end " +
+			"time on every day they cover, with `config` in the format of `hours_of_operation_config`. Floating times, without a time zone, are taken as " +
+			"written, in the time zone of the hours of operation. Times in UTC or with a `TZID` are converted to the `time_zone` " +
+			"argument, and rejected without it. Cancelled events are skipped, recurring events are rejected as they cannot be " +
+			"expanded without a time window.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "calendar",
+				MarkdownDescription: "Content of the iCalendar file, e.g. `file(\"holidays.ics\")`.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "time_zone",
+			MarkdownDescription: "Optional IANA time zone of the hours of operation, e.g. `America/New_York`, to convert the times in UTC or with a `TZID` to.",
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: hoursOfOperationOverrideAttrTypes},
+		},
+	}
+}
+
+func (f *IcsToHoursOfOperationOverridesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var calendar string
+	var timeZones []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &calendar, &timeZones))
+
+	if resp.Error != nil {
+		return
+	}
+
+	var location *time.Location
+	if len(timeZones) > 1 {
+		resp.Error = function.NewArgumentFuncError(1, "at most one time_zone can be given")
+		return
+	} else if len(timeZones) == 1 {
+		var err error
+		location, err = time.LoadLocation(timeZones[0])
+		if err != nil || timeZones[0] == "" || timeZones[0] == "Local" {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid time_zone %q, expected an IANA time zone such as America/New_York", timeZones[0]))
+			return
+		}
+	}
+
+	overrides, err := parseIcsOverrides(calendar, location)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, overrides))
+}
+
+// parseIcsOverrides converts the events of an iCalendar calendar into hours
+// of operation overrides, ordered by effective date and name. Times in UTC or
+// with a TZID are converted to location, and rejected if it is nil.
+func parseIcsOverrides(calendar string, location *time.Location) ([]HoursOfOperationOverrideModel, error) {
+	events, err := parseIcsEvents(calendar)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := []HoursOfOperationOverrideModel{}
+	for _, event := range events {
+		if strings.EqualFold(event.status, "CANCELLED") {
+			continue
+		}
+
+		override, err := event.override(location)
+		if err != nil {
+			return nil, fmt.Errorf("event %q: %w", event.summary, err)
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	slices.SortStableFunc(overrides, func(a, b HoursOfOperationOverrideModel) int {
+		if c := strings.Compare(a.EffectiveFrom, b.EffectiveFrom); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return overrides, nil
+}
+
+// override returns the hours of operation override of the event, its times
+// in location.
+func (e icsEvent) override(location *time.Location) (HoursOfOperationOverrideModel, error) {
+	if e.recurring {
+		return HoursOfOperationOverrideModel{}, fmt.Errorf("recurring events are not supported, export the calendar with expanded occurrences")
+	}
+
+	if strings.TrimSpace(e.summary) == "" {
+		return HoursOfOperationOverrideModel{}, fmt.Errorf("event has no SUMMARY to name the override")
+	}
+
+	start, allDay, err := e.dtstart.time(location)
+	if err != nil {
+		return HoursOfOperationOverrideModel{}, err
+	}
+
+	override := HoursOfOperationOverrideModel{
+		Name:        strings.TrimSpace(e.summary),
+		Description: strings.TrimSpace(e.description),
+		Config:      []HoursOfOperationConfigModel{},
+	}
+
+	if allDay {
+		// The end date of all-day events is exclusive and defaults to the
+		// day after the start date
+		last := start
+		if e.dtend != nil {
+			end, endAllDay, err := e.dtend.time(location)
+			if err != nil {
+				return HoursOfOperationOverrideModel{}, err
+			}
+
+			if !endAllDay {
+				return HoursOfOperationOverrideModel{}, fmt.Errorf("DTEND must be a date as DTSTART is a date")
+			}
+
+			last = end.AddDate(0, 0, -1)
+		}
+
+		if last.Before(start) {
+			return HoursOfOperationOverrideModel{}, fmt.Errorf("DTEND must be after DTSTART")
+		}
+
+		override.EffectiveFrom = start.Format(icsOverrideDateFormat)
+		override.EffectiveTill = last.Format(icsOverrideDateFormat)
+		override.Closed = true
+
+		return override, nil
+	}
+
+	if e.dtend == nil {
+		return HoursOfOperationOverrideModel{}, fmt.Errorf("timed events must have a DTEND")
+	}
+
+	end, endAllDay, err := e.dtend.time(location)
+	if err != nil {
+		return HoursOfOperationOverrideModel{}, err
+	}
+
+	if endAllDay {
+		return HoursOfOperationOverrideModel{}, fmt.Errorf("DTEND must be a date-time as DTSTART is a date-time")
+	}
+
+	startTime := HoursOfOperationTimeModel{Hours: int64(start.Hour()), Minutes: int64(start.Minute())}
+	endTime := HoursOfOperationTimeModel{Hours: int64(end.Hour()), Minutes: int64(end.Minute())}
+
+	// Events ending at midnight end on the previous day, as an end time of
+	// 00:00 means midnight at the end of the day
+	lastDay := end
+	if endTime == (HoursOfOperationTimeModel{}) {
+		lastDay = end.AddDate(0, 0, -1)
+	} else if endTime.Hours*60+endTime.Minutes <= startTime.Hours*60+startTime.Minutes {
+		return HoursOfOperationOverrideModel{}, fmt.Errorf("the end time of the event must be after its start time")
+	}
+
+	firstDate := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	lastDate := time.Date(lastDay.Year(), lastDay.Month(), lastDay.Day(), 0, 0, 0, 0, time.UTC)
+
+	if lastDate.Before(firstDate) {
+		return HoursOfOperationOverrideModel{}, fmt.Errorf("DTEND must be after DTSTART")
+	}
+
+	override.EffectiveFrom = firstDate.Format(icsOverrideDateFormat)
+	override.EffectiveTill = lastDate.Format(icsOverrideDateFormat)
+
+	// Days are listed in week order, once even if the event covers more
+	// than a week
+	covered := map[time.Weekday]bool{}
+	for date := firstDate; !date.After(lastDate) && len(covered) < 7; date = date.AddDate(0, 0, 1) {
+		covered[date.Weekday()] = true
+	}
+
+	for i, day := range hoursOfOperationDays {
+		// hoursOfOperationDays starts on Monday, time.Weekday on Sunday
+		if covered[time.Weekday((i+1)%7)] {
+			override.Config = append(override.Config, HoursOfOperationConfigModel{
+				Day:       day.day,
+				StartTime: startTime,
+				EndTime:   endTime,
+			})
+		}
+	}
+
+	return override, nil
+}
+
+// time parses a DATE or DATE-TIME property value, reporting whether it is a
+// date. Floating times are returned as written, and times in UTC or with a
+// TZID are converted to location, failing if it is nil as they cannot be
+// taken as written.
+func (p icsProperty) time(location *time.Location) (time.Time, bool, error) {
+	value := p.value
+
+	if strings.EqualFold(p.params["VALUE"], "DATE") || len(value) == len("20060102") {
+		parsed, err := time.Parse("20060102", value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %s date %q, expected YYYYMMDD", p.name, value)
+		}
+
+		return parsed, true, nil
+	}
+
+	zone := time.UTC
+	utc := strings.HasSuffix(value, "Z")
+	tzid := p.params["TZID"]
+
+	switch {
+	case utc && tzid != "":
+		return time.Time{}, false, fmt.Errorf("invalid %s date-time %q, a time in UTC cannot have a TZID", p.name, value)
+	case (utc || tzid != "") && location == nil:
+		return time.Time{}, false, fmt.Errorf("%s %q is not a floating time, pass the time_zone of the hours of operation to convert it", p.name, value)
+	case tzid != "":
+		var err error
+		zone, err = time.LoadLocation(strings.TrimPrefix(tzid, "/"))
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown %s TZID %q, expected an IANA time zone such as America/New_York", p.name, tzid)
+		}
+	}
+
+	parsed, err := time.ParseInLocation("20060102T150405", strings.TrimSuffix(value, "Z"), zone)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s date-time %q, expected YYYYMMDDTHHMMSS", p.name, value)
+	}
+
+	if location != nil && (utc || tzid != "") {
+		parsed = parsed.In(location)
+	}
+
+	return parsed, false, nil
+}
+
+// parseIcsEvents returns the events of an iCalendar calendar.
+func parseIcsEvents(calendar string) ([]icsEvent, error) {
+	var events []icsEvent
+	var event *icsEvent
+
+	// Nested components of events, e.g. VALARM, are skipped
+	depth := 0
+
+	for _, line := range unfoldIcsLines(calendar) {
+		property, err := parseIcsProperty(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case property.name == "BEGIN" && strings.EqualFold(property.value, "VEVENT"):
+			if event != nil {
+				return nil, fmt.Errorf("unterminated VEVENT")
+			}
+
+			event = &icsEvent{}
+		case property.name == "END" && strings.EqualFold(property.value, "VEVENT"):
+			if event == nil {
+				return nil, fmt.Errorf("END:VEVENT without BEGIN:VEVENT")
+			}
+
+			if event.dtstart.name == "" {
+				return nil, fmt.Errorf("event %q has no DTSTART", event.summary)
+			}
+
+			events = append(events, *event)
+			event = nil
+		case event == nil:
+			continue
+		case property.name == "BEGIN":
+			depth++
+		case property.name == "END":
+			depth--
+		case depth > 0:
+			continue
+		case property.name == "SUMMARY":
+			event.summary = unescapeIcsText(property.value)
+		case property.name == "DESCRIPTION":
+			event.description = unescapeIcsText(property.value)
+		case property.name == "STATUS":
+			event.status = property.value
+		case property.name == "DTSTART":
+			event.dtstart = property
+		case property.name == "DTEND":
+			event.dtend = &property
+		case property.name == "RRULE" || property.name == "RDATE":
+			event.recurring = true
+		}
+	}
+
+	if event != nil {
+		return nil, fmt.Errorf("unterminated VEVENT")
+	}
+
+	if len(events) == 0 && !strings.Contains(strings.ToUpper(calendar), "BEGIN:VCALENDAR") {
+		return nil, fmt.Errorf("calendar is not in iCalendar format, expected BEGIN:VCALENDAR")
+	}
+
+	return events, nil
+}
+
+// unfoldIcsLines splits an iCalendar object into content lines, joining the
+// lines folded onto continuation lines starting with a space or tab.
+func unfoldIcsLines(calendar string) []string {
+	var lines []string
+
+	scanner := bufio.NewScanner(strings.NewReader(calendar))
+	scanner.Buffer(nil, len(calendar)+1)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		switch {
+		case line == "":
+			continue
+		case (line[0] == ' ' || line[0] == '\t') && len(lines) > 0:
+			lines[len(lines)-1] += line[1:]
+		default:
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// parseIcsProperty parses a content line such as
+// DTSTART;VALUE=DATE:20250101.
+func parseIcsProperty(line string) (icsProperty, error) {
+	// The value starts at the first colon outside of quoted parameter values
+	quoted := false
+	separator := -1
+
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == ':' && !quoted {
+			separator = i
+			break
+		}
+	}
+
+	if separator < 0 {
+		return icsProperty{}, fmt.Errorf("invalid iCalendar line %q, expected \"NAME:VALUE\"", line)
+	}
+
+	parts := strings.Split(line[:separator], ";")
+	property := icsProperty{
+		name:   strings.ToUpper(parts[0]),
+		params: map[string]string{},
+		value:  line[separator+1:],
+	}
+
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		property.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return property, nil
+}
+
+// unescapeIcsText unescapes an iCalendar TEXT value.
+func unescapeIcsText(value string) string {
+	return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
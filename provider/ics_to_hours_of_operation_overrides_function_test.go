@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// icsCalendar returns a calendar of the given VEVENT properties, one event per
+// argument with its properties separated by newlines.
+func icsCalendar(events ...string) string {
+	calendar := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"
+	for _, event := range events {
+		calendar += "BEGIN:VEVENT\r\n" + strings.ReplaceAll(event, "\n", "\r\n") + "\r\nEND:VEVENT\r\n"
+	}
+
+	return calendar + "END:VCALENDAR\r\n"
+}
+
+func TestParseIcsOverrides(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		calendar  string
+		location  *time.Location
+		want      []HoursOfOperationOverrideModel
+		wantError string
+	}{
+		"all-day events": {
+			calendar: icsCalendar(
+				"SUMMARY:Christmas\nDTSTART;VALUE=DATE:20251225\nDTEND;VALUE=DATE:20251227",
+				"SUMMARY:Independence Day\nDESCRIPTION:Closed\\, see intranet\nDTSTART;VALUE=DATE:20250704",
+			),
+			want: []HoursOfOperationOverrideModel{
+				{Name: "Independence Day", Description: "Closed, see intranet", EffectiveFrom: "2025-07-04", EffectiveTill: "2025-07-04", Closed: true, Config: []HoursOfOperationConfigModel{}},
+				{Name: "Christmas", EffectiveFrom: "2025-12-25", EffectiveTill: "2025-12-26", Closed: true, Config: []HoursOfOperationConfigModel{}},
+			},
+		},
+		"floating times are taken as written": {
+			calendar: icsCalendar("SUMMARY:Christmas Eve\nDTSTART:20251224T090000\nDTEND:20251224T120000"),
+			location: newYork,
+			want: []HoursOfOperationOverrideModel{{
+				Name:          "Christmas Eve",
+				EffectiveFrom: "2025-12-24",
+				EffectiveTill: "2025-12-24",
+				Config: []HoursOfOperationConfigModel{
+					{Day: "WEDNESDAY", StartTime: HoursOfOperationTimeModel{Hours: 9}, EndTime: HoursOfOperationTimeModel{Hours: 12}},
+				},
+			}},
+		},
+		"UTC times are converted": {
+			calendar: icsCalendar("SUMMARY:Independence Day\nDTSTART:20250704T140000Z\nDTEND:20250704T223000Z"),
+			location: newYork,
+			want: []HoursOfOperationOverrideModel{{
+				Name:          "Independence Day",
+				EffectiveFrom: "2025-07-04",
+				EffectiveTill: "2025-07-04",
+				Config: []HoursOfOperationConfigModel{
+					{Day: "FRIDAY", StartTime: HoursOfOperationTimeModel{Hours: 10}, EndTime: HoursOfOperationTimeModel{Hours: 18, Minutes: 30}},
+				},
+			}},
+		},
+		"UTC times are converted to the previous day": {
+			calendar: icsCalendar("SUMMARY:New Year's Eve\nDTSTART:20251231T030000Z\nDTEND:20251231T040000Z"),
+			location: newYork,
+			want: []HoursOfOperationOverrideModel{{
+				Name:          "New Year's Eve",
+				EffectiveFrom: "2025-12-30",
+				EffectiveTill: "2025-12-30",
+				Config: []HoursOfOperationConfigModel{
+					{Day: "TUESDAY", StartTime: HoursOfOperationTimeModel{Hours: 22}, EndTime: HoursOfOperationTimeModel{Hours: 23}},
+				},
+			}},
+		},
+		"TZID times are converted": {
+			calendar: icsCalendar("SUMMARY:Christmas Eve\nDTSTART;TZID=Europe/Paris:20251224T090000\nDTEND;TZID=\"Europe/Paris\":20251224T120000"),
+			location: newYork,
+			want: []HoursOfOperationOverrideModel{{
+				Name:          "Christmas Eve",
+				EffectiveFrom: "2025-12-24",
+				EffectiveTill: "2025-12-24",
+				Config: []HoursOfOperationConfigModel{
+					{Day: "WEDNESDAY", StartTime: HoursOfOperationTimeModel{Hours: 3}, EndTime: HoursOfOperationTimeModel{Hours: 6}},
+				},
+			}},
+		},
+		"timed events covering several days": {
+			calendar: icsCalendar("SUMMARY:Inventory\nDTSTART:20250704T080000\nDTEND:20250707T000000"),
+			want: []HoursOfOperationOverrideModel{{
+				Name:          "Inventory",
+				EffectiveFrom: "2025-07-04",
+				EffectiveTill: "2025-07-06",
+				Config: []HoursOfOperationConfigModel{
+					{Day: "FRIDAY", StartTime: HoursOfOperationTimeModel{Hours: 8}},
+					{Day: "SATURDAY", StartTime: HoursOfOperationTimeModel{Hours: 8}},
+					{Day: "SUNDAY", StartTime: HoursOfOperationTimeModel{Hours: 8}},
+				},
+			}},
+		},
+		"cancelled events are skipped": {
+			calendar: icsCalendar("SUMMARY:Picnic\nSTATUS:CANCELLED\nDTSTART;VALUE=DATE:20250704"),
+			want:     []HoursOfOperationOverrideModel{},
+		},
+		"UTC times without time zone": {
+			calendar:  icsCalendar("SUMMARY:Independence Day\nDTSTART:20250704T140000Z\nDTEND:20250704T220000Z"),
+			wantError: `event "Independence Day": DTSTART "20250704T140000Z" is not a floating time, pass the time_zone of the hours of operation to convert it`,
+		},
+		"TZID times without time zone": {
+			calendar:  icsCalendar("SUMMARY:Christmas Eve\nDTSTART;TZID=Europe/Paris:20251224T090000\nDTEND;TZID=Europe/Paris:20251224T120000"),
+			wantError: `event "Christmas Eve": DTSTART "20251224T090000" is not a floating time, pass the time_zone of the hours of operation to convert it`,
+		},
+		"unknown TZID": {
+			calendar:  icsCalendar("SUMMARY:Christmas Eve\nDTSTART;TZID=Eastern Standard Time:20251224T090000\nDTEND;TZID=Eastern Standard Time:20251224T120000"),
+			location:  newYork,
+			wantError: `event "Christmas Eve": unknown DTSTART TZID "Eastern Standard Time", expected an IANA time zone such as America/New_York`,
+		},
+		"recurring events": {
+			calendar:  icsCalendar("SUMMARY:Staff meeting\nDTSTART:20250704T080000\nDTEND:20250704T090000\nRRULE:FREQ=WEEKLY"),
+			wantError: `event "Staff meeting": recurring events are not supported, export the calendar with expanded occurrences`,
+		},
+		"end before start": {
+			calendar:  icsCalendar("SUMMARY:Inventory\nDTSTART:20250704T120000\nDTEND:20250704T080000"),
+			wantError: `event "Inventory": the end time of the event must be after its start time`,
+		},
+		"not a calendar": {
+			calendar:  "SUMMARY:Christmas",
+			wantError: "calendar is not in iCalendar format, expected BEGIN:VCALENDAR",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseIcsOverrides(test.calendar, test.location)
+
+			if test.wantError != "" {
+				if err == nil || err.Error() != test.wantError {
+					t.Fatalf("got error %v, want %q", err, test.wantError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// connectIdentitySchema returns the identity schema shared by instance scoped
+// Connect resources: the ARN of the resource, from which the instance ID and
+// the resource ID are derived on import, and the service specific ID.
+func connectIdentitySchema(idAttribute string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"arn": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "ARN of the resource",
+			},
+			idAttribute: identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "ID of the resource within the Connect instance",
+			},
+		},
+	}
+}
+
+// importConnectResource implements ImportState for instance scoped Connect
+// resources whose ARNs have the resource type arnResourceType, e.g.
+// agent-state. The resource is imported either by identity, by its ARN or by
+// an ID of the form <instance_id>:<resource_id> or <instance_id>/<resource_id>.
+// The instance ID, the resource ID and, when known, the ARN are set in the
+// state before it is read.
+func importConnectResource(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, arnResourceType string, idAttribute string) {
+	var resourceArn, instanceID, resourceID string
+
+	switch {
+	case req.ID == "":
+		var identityArn types.String
+
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("arn"), &identityArn)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resourceArn = identityArn.ValueString()
+	case arn.IsARN(req.ID):
+		resourceArn = req.ID
+	default:
+		parts := strings.Split(req.ID, ":")
+		if len(parts) == 1 {
+			parts = strings.Split(req.ID, "/")
+		}
+
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected an ARN or an import identifier with format <instance_id>:<%s> or <instance_id>/<%s>, got: %q", idAttribute, idAttribute, req.ID),
+			)
+			return
+		}
+
+		instanceID, resourceID = parts[0], parts[1]
+	}
+
+	if resourceArn != "" {
+		var err error
+
+		var resourceType string
+
+		instanceID, resourceType, resourceID, err = parseConnectArn(resourceArn)
+		if err == nil && resourceID == "" {
+			err = fmt.Errorf("%s is an instance ARN", resourceArn)
+		} else if err == nil && resourceType != arnResourceType {
+			err = fmt.Errorf("%s is the ARN of a %s, expected the ARN of a %s", resourceArn, resourceType, arnResourceType)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Could not import from ARN: %s", err))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("arn"), resourceArn)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttribute), resourceID)...)
+}
+
+// connectNameLookup returns the ID and ARN of the resource of an instance with
+// the given name, found being false if there is none.
+type connectNameLookup func(ctx context.Context, instanceID string, name string) (id string, resourceArn string, found bool, err error)
+
+// importConnectResourceByName implements ImportState like
+// importConnectResource, also accepting an ID of the form
+// <instance_id>:name=<name> resolved with lookup. Both the state and the
+// identity are set from the found resource.
+func importConnectResourceByName(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, arnResourceType string, idAttribute string, lookup connectNameLookup) {
+	instanceID, name, ok := strings.Cut(req.ID, ":name=")
+	if !ok || arn.IsARN(req.ID) {
+		importConnectResource(ctx, req, resp, arnResourceType, idAttribute)
+		return
+	}
+
+	if instanceID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with format <instance_id>:name=<name>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resourceID, resourceArn, found, err := lookup(ctx, instanceID, name)
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error importing resource", fmt.Sprintf("Could not look up %q in instance %s", name, instanceID), err))
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddError("Cannot Import Non-Existent Resource", fmt.Sprintf("Instance %s has no resource named %q.", instanceID, name))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("arn"), resourceArn)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttribute), resourceID)...)
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("arn"), resourceArn)...)
+		resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root(idAttribute), resourceID)...)
+	}
+}
+
+// connectUnscopedIdentitySchema returns the identity schema of the Connect
+// resources not scoped to an instance, e.g. phone numbers, which may move
+// between instances, and the instances themselves.
+func connectUnscopedIdentitySchema(idAttribute string) identityschema.Schema {
+	identitySchema := connectIdentitySchema(idAttribute)
+	identitySchema.Attributes[idAttribute] = identityschema.StringAttribute{
+		OptionalForImport: true,
+		Description:       "ID of the resource",
+	}
+
+	return identitySchema
+}
+
+// importUnscopedConnectResource implements ImportState for the Connect
+// resources not scoped to an instance whose ARN resource is
+// <arnResourceType>/<id>, e.g. phone-number. The resource is imported either by
+// identity, by its ARN or by its ID, set in the state with the ARN when known.
+func importUnscopedConnectResource(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, arnResourceType string, idAttribute string) {
+	resourceArn := req.ID
+
+	if req.ID == "" {
+		var identityArn types.String
+
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("arn"), &identityArn)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resourceArn = identityArn.ValueString()
+	}
+
+	if !arn.IsARN(resourceArn) {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttribute), req.ID)...)
+		return
+	}
+
+	parsed, err := arn.Parse(resourceArn)
+	resourceType, resourceID, ok := strings.Cut(parsed.Resource, "/")
+
+	if err != nil || !ok || resourceType != arnResourceType || resourceID == "" || strings.Contains(resourceID, "/") {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Could not import from ARN: %s is not the ARN of a %s", resourceArn, arnResourceType))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("arn"), resourceArn)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttribute), resourceID)...)
+
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("arn"), resourceArn)...)
+		resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root(idAttribute), resourceID)...)
+	}
+}
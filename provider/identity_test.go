@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// importState imports the resource r by id, or by the identity with the given
+// ARN if id is empty, and returns the response.
+func importState(t *testing.T, r resource.ResourceWithImportState, id string, identityArn string) *resource.ImportStateResponse {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	var identityResponse resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResponse)
+	identityType := identityResponse.IdentitySchema.Type().TerraformType(ctx)
+
+	req := resource.ImportStateRequest{ID: id}
+	if id == "" {
+		identityValues := map[string]tftypes.Value{}
+		for name := range identityResponse.IdentitySchema.Attributes {
+			identityValues[name] = tftypes.NewValue(tftypes.String, nil)
+		}
+		identityValues["arn"] = tftypes.NewValue(tftypes.String, identityArn)
+
+		req.Identity = &tfsdk.ResourceIdentity{
+			Schema: identityResponse.IdentitySchema,
+			Raw:    tftypes.NewValue(identityType, identityValues),
+		}
+	}
+
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResponse.Schema,
+			Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+		},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identityResponse.IdentitySchema,
+			Raw:    tftypes.NewValue(identityType, nil),
+		},
+	}
+
+	r.ImportState(ctx, req, resp)
+
+	return resp
+}
+
+func TestImportUnscopedConnectResource(t *testing.T) {
+	const (
+		phoneNumberID  = "33333333-4444-5555-6666-777777777777"
+		phoneNumberArn = "arn:aws:connect:us-east-1:123456789012:phone-number/" + phoneNumberID
+		instanceID     = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+		instanceArn    = "arn:aws:connect:us-east-1:123456789012:instance/" + instanceID
+	)
+
+	tests := map[string]struct {
+		resource    resource.ResourceWithImportState
+		idAttribute string
+		id          string
+		identityArn string
+		wantID      string
+		wantArn     string
+		wantError   bool
+	}{
+		"phone number by ID": {
+			resource:    &PhoneNumberResource{},
+			idAttribute: "phone_number_id",
+			id:          phoneNumberID,
+			wantID:      phoneNumberID,
+		},
+		"phone number by ARN": {
+			resource:    &PhoneNumberResource{},
+			idAttribute: "phone_number_id",
+			id:          phoneNumberArn,
+			wantID:      phoneNumberID,
+			wantArn:     phoneNumberArn,
+		},
+		"phone number by identity": {
+			resource:    &PhoneNumberResource{},
+			idAttribute: "phone_number_id",
+			identityArn: phoneNumberArn,
+			wantID:      phoneNumberID,
+			wantArn:     phoneNumberArn,
+		},
+		"phone number by the ARN of a queue": {
+			resource:    &PhoneNumberResource{},
+			idAttribute: "phone_number_id",
+			identityArn: instanceArn + "/queue/1234",
+			wantError:   true,
+		},
+		"instance by ID": {
+			resource:    &InstanceResource{},
+			idAttribute: "instance_id",
+			id:          instanceID,
+			wantID:      instanceID,
+		},
+		"instance by ARN": {
+			resource:    &InstanceResource{},
+			idAttribute: "instance_id",
+			id:          instanceArn,
+			wantID:      instanceID,
+			wantArn:     instanceArn,
+		},
+		"instance by identity": {
+			resource:    &InstanceResource{},
+			idAttribute: "instance_id",
+			identityArn: instanceArn,
+			wantID:      instanceID,
+			wantArn:     instanceArn,
+		},
+		"instance by the ARN of a queue": {
+			resource:    &InstanceResource{},
+			idAttribute: "instance_id",
+			identityArn: instanceArn + "/queue/1234",
+			wantError:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			resp := importState(t, test.resource, test.id, test.identityArn)
+
+			if test.wantError {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("got no error")
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var id, resourceArn types.String
+			resp.State.GetAttribute(ctx, path.Root(test.idAttribute), &id)
+			resp.State.GetAttribute(ctx, path.Root("arn"), &resourceArn)
+
+			if id.ValueString() != test.wantID || resourceArn.ValueString() != test.wantArn {
+				t.Errorf("got %s %s and arn %s, want %s and %s", test.idAttribute, id, resourceArn, test.wantID, test.wantArn)
+			}
+
+			if test.wantArn != "" {
+				var identityID types.String
+				resp.Identity.GetAttribute(ctx, path.Root(test.idAttribute), &identityID)
+				if identityID.ValueString() != test.wantID {
+					t.Errorf("identity %s: got %s, want %s", test.idAttribute, identityID, test.wantID)
+				}
+			}
+		})
+	}
+}
+
+func TestImportConnectResource(t *testing.T) {
+	const (
+		instanceID                = "aaaaaaaa-bbbb-cccc-dddd-111111111111"
+		integrationAssociationID  = "eeeeeeee-ffff-0000-1111-222222222222"
+		integrationAssociationArn = "arn:aws:connect:us-east-1:123456789012:instance/" + instanceID + "/integration-association/" + integrationAssociationID
+	)
+
+	tests := map[string]struct {
+		id          string
+		identityArn string
+		wantArn     string
+		wantError   bool
+	}{
+		"by instance and integration association ID": {
+			id: instanceID + ":" + integrationAssociationID,
+		},
+		"by ARN": {
+			id:      integrationAssociationArn,
+			wantArn: integrationAssociationArn,
+		},
+		"by identity": {
+			identityArn: integrationAssociationArn,
+			wantArn:     integrationAssociationArn,
+		},
+		"by the ARN of a queue": {
+			identityArn: "arn:aws:connect:us-east-1:123456789012:instance/" + instanceID + "/queue/" + integrationAssociationID,
+			wantError:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			resp := importState(t, &IntegrationAssociationResource{}, test.id, test.identityArn)
+
+			if test.wantError {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("got no error")
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var gotInstanceID, id, resourceArn types.String
+			resp.State.GetAttribute(ctx, path.Root("instance_id"), &gotInstanceID)
+			resp.State.GetAttribute(ctx, path.Root("integration_association_id"), &id)
+			resp.State.GetAttribute(ctx, path.Root("arn"), &resourceArn)
+
+			if gotInstanceID.ValueString() != instanceID || id.ValueString() != integrationAssociationID || resourceArn.ValueString() != test.wantArn {
+				t.Errorf("got instance_id %s, integration_association_id %s and arn %s", gotInstanceID, id, resourceArn)
+			}
+		})
+	}
+}
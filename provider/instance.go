@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+)
+
+// memoized is the result of a lookup made once per operation.
+type memoized[T any] struct {
+	once   sync.Once
+	value  T
+	err    error
+	loaded atomic.Bool
+}
+
+// memo memoizes lookups by key, so a lookup shared by many resources, e.g.
+// of their Connect instance, is made once however many resources need it.
+type memo[T any] struct {
+	mu      sync.Mutex
+	entries map[string]*memoized[T]
+}
+
+// get returns the memoized result of key, calling load the first time.
+// Concurrent callers of the same key wait for the first one and share its
+// result. Errors are not memoized: the callers waiting get the error, and the
+// next caller loads again.
+//
+// load runs with the context of the first caller, which the other callers
+// share, so it must not depend on the cancellation of that caller: a timed
+// out or cancelled operation only fails the callers already waiting.
+func (m *memo[T]) get(key string, load func() (T, error)) (T, error) {
+	m.mu.Lock()
+	if m.entries == nil {
+		m.entries = map[string]*memoized[T]{}
+	}
+	entry, ok := m.entries[key]
+	if !ok {
+		entry = &memoized[T]{}
+		m.entries[key] = entry
+	}
+	m.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.value, entry.err = load()
+		entry.loaded.Store(true)
+	})
+
+	if entry.err != nil {
+		m.mu.Lock()
+		if m.entries[key] == entry {
+			delete(m.entries, key)
+		}
+		m.mu.Unlock()
+	}
+
+	return entry.value, entry.err
+}
+
+// peek returns the memoized result of key if it was loaded successfully,
+// without loading it.
+func (m *memo[T]) peek(key string) (T, bool) {
+	m.mu.Lock()
+	entry, ok := m.entries[key]
+	m.mu.Unlock()
+
+	if !ok || !entry.loaded.Load() || entry.err != nil {
+		var zero T
+		return zero, false
+	}
+
+	return entry.value, true
+}
+
+// forget drops the memoized result of key, e.g. after a change making it
+// stale.
+func (m *memo[T]) forget(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, key)
+}
+
+// describeInstance returns the Connect instance with the given ID in the
+// region of the override block, which may be nil.
+func (p *ProviderData) describeInstance(ctx context.Context, override *OverrideModel, instanceID string) (*conntypes.Instance, error) {
+	key := p.awsConfig(override).Region + "/" + instanceID
+
+	return p.instances.get(key, func() (*conntypes.Instance, error) {
+		response, err := p.connectClient(override).DescribeInstance(ctx, &connect.DescribeInstanceInput{
+			InstanceId: aws.String(instanceID),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return response.Instance, nil
+	})
+}
+
+// instanceAttributes returns the attributes of the Connect instance with the
+// given ID in the provider's region, by attribute type.
+func (p *ProviderData) instanceAttributes(ctx context.Context, instanceID string) (map[string]string, error) {
+	return p.instanceAttributeValues.get(p.Config.Region+"/"+instanceID, func() (map[string]string, error) {
+		conn := p.connectClient(nil)
+		attributes := map[string]string{}
+
+		var nextToken *string
+		for {
+			response, err := conn.ListInstanceAttributes(ctx, &connect.ListInstanceAttributesInput{
+				InstanceId: aws.String(instanceID),
+				NextToken:  nextToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, attribute := range response.Attributes {
+				attributes[string(attribute.AttributeType)] = aws.ToString(attribute.Value)
+			}
+
+			nextToken = response.NextToken
+
+			if nextToken == nil {
+				return attributes, nil
+			}
+		}
+	})
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	instanceIDDescription    = "ID of the Connect instance. Exactly one of instance_id and instance_alias is required."
+	instanceAliasDescription = "Alias of the Connect instance, resolved to its ID in the region and account of the resource, so configurations are portable across accounts where instance IDs differ."
+)
+
+// instanceIDValidators returns the validators of the instance_id attribute of
+// resources and data sources also accepting an instance_alias.
+func instanceIDValidators() []validator.String {
+	return []validator.String{
+		validConnectInstanceID(),
+		stringvalidator.ExactlyOneOf(path.MatchRoot("instance_alias")),
+	}
+}
+
+// instanceAliasAttribute returns the schema of the instance_alias attribute
+// of resources.
+func instanceAliasAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: instanceAliasDescription,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 45),
+		},
+	}
+}
+
+// dataSourceInstanceAliasAttribute returns the schema of the instance_alias
+// attribute of data sources.
+func dataSourceInstanceAliasAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Description: instanceAliasDescription,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 45),
+		},
+	}
+}
+
+// instanceAliasKey returns the key of the instance aliases of the region and
+// account of the override block, which may be nil.
+func (p *ProviderData) instanceAliasKey(override *OverrideModel) string {
+	key := p.awsConfig(override).Region
+	if override != nil {
+		key += "/" + override.RoleArn.ValueString()
+	}
+
+	return key
+}
+
+// instanceIDByAlias returns the ID of the Connect instance with the given
+// alias in the region and account of the override block, which may be nil.
+// The instances are listed once per region and account.
+func (p *ProviderData) instanceIDByAlias(ctx context.Context, override *OverrideModel, alias string) (string, error) {
+	ids, err := p.instanceAliases.get(p.instanceAliasKey(override), func() (map[string]string, error) {
+		instances, err := collectPages(ctx, listInstances(p.connectClient(override)), 0, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make(map[string]string, len(instances))
+		for _, instance := range instances {
+			ids[aws.ToString(instance.InstanceAlias)] = aws.ToString(instance.Id)
+		}
+
+		return ids, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("listing Connect instances: %w", err)
+	}
+
+	id, ok := ids[alias]
+	if !ok {
+		return "", fmt.Errorf("no Connect instance has alias %q in region %s", alias, p.awsConfig(override).Region)
+	}
+
+	return id, nil
+}
+
+// resolveInstanceID sets the instance ID of a data source configured with an
+// instance alias.
+func (p *ProviderData) resolveInstanceID(ctx context.Context, instanceID *types.String, instanceAlias types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if instanceAlias.IsNull() {
+		return diags
+	}
+
+	id, err := p.instanceIDByAlias(ctx, nil, instanceAlias.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("instance_alias"), "Error resolving Connect Instance Alias", withAPICallDetails(err.Error(), err))
+		return diags
+	}
+
+	*instanceID = types.StringValue(id)
+
+	return diags
+}
+
+// modifyPlanInstanceAlias plans the instance_id of a resource configured with
+// an instance_alias. The resource is replaced when the alias resolves to
+// another instance than the one in state.
+func (p *ProviderData) modifyPlanInstanceAlias(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() || p == nil {
+		return
+	}
+
+	var instanceAlias types.String
+	var override *OverrideModel
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("instance_alias"), &instanceAlias)...)
+
+	// Not all resources have an override block, e.g. config backups
+	if _, ok := req.Plan.Schema.GetBlocks()["override"]; ok {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("override"), &override)...)
+	}
+
+	if resp.Diagnostics.HasError() || instanceAlias.IsNull() {
+		return
+	}
+
+	if instanceAlias.IsUnknown() || (override != nil && (override.Region.IsUnknown() || override.RoleArn.IsUnknown())) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("instance_id"), types.StringUnknown())...)
+		return
+	}
+
+	id, err := p.instanceIDByAlias(ctx, override, instanceAlias.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("instance_alias"), "Error resolving Connect Instance Alias", withAPICallDetails(err.Error(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("instance_id"), id)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var prior types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("instance_id"), &prior)...)
+
+	if !prior.IsNull() && prior.ValueString() != id {
+		resp.RequiresReplace.Append(path.Root("instance_id"))
+	}
+}
+
+// listInstances returns the pages of the Connect instances of the account.
+func listInstances(conn *connect.Client) pageLister[conntypes.InstanceSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.InstanceSummary, *string, error) {
+		response, err := conn.ListInstances(ctx, &connect.ListInstancesInput{
+			MaxResults: aws.Int32(10),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.InstanceSummaryList, response.NextToken, nil
+	}
+}
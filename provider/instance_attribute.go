@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &InstanceAttributeResource{}
+var _ resource.ResourceWithImportState = &InstanceAttributeResource{}
+var _ resource.ResourceWithModifyPlan = &InstanceAttributeResource{}
+
+// instanceAttributeResourceType is the type of the instance attribute
+// resource without the provider prefix, e.g. in operation_policies.
+const instanceAttributeResourceType = "connect_instance_attribute"
+
+func NewInstanceAttributeResource() resource.Resource {
+	return &InstanceAttributeResource{}
+}
+
+type InstanceAttributeResource struct {
+	providerData *ProviderData
+}
+
+type InstanceAttributeResourceModel struct {
+	InstanceID    types.String   `tfsdk:"instance_id"`
+	InstanceAlias types.String   `tfsdk:"instance_alias"`
+	AttributeType types.String   `tfsdk:"attribute_type"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	Override      *OverrideModel `tfsdk:"override"`
+}
+
+func (r *InstanceAttributeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + instanceAttributeResourceType
+}
+
+func (r *InstanceAttributeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributeTypes := []string{}
+	for _, attributeType := range conntypes.InstanceAttributeType("").Values() {
+		attributeTypes = append(attributeTypes, string(attributeType))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enables or disables a single attribute of a Connect instance, e.g. CONTACT_LENS, independently of its other attributes. Destroying the resource leaves the attribute as it is",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"attribute_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of the attribute, e.g. CONTACT_LENS, EARLY_MEDIA or MULTI_PARTY_CONFERENCE.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(attributeTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *InstanceAttributeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *InstanceAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *InstanceAttributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(instanceAttributeResourceType, resp) {
+		return
+	}
+
+	var data InstanceAttributeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(instanceAttributeResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.update(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceAttributeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InstanceAttributeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(instanceAttributeResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(instanceAttributeResourceType, data.Override)
+	response, err := conn.DescribeInstanceAttribute(ctx, &connect.DescribeInstanceAttributeInput{
+		InstanceId:    aws.String(data.InstanceID.ValueString()),
+		AttributeType: conntypes.InstanceAttributeType(data.AttributeType.ValueString()),
+	})
+
+	// The attribute exists as long as the instance
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Instance Attribute", fmt.Sprintf("Could not read the %s attribute of Connect Instance", data.AttributeType.ValueString()), err))
+		return
+	}
+
+	enabled, err := strconv.ParseBool(aws.ToString(response.Attribute.Value))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Connect Instance Attribute Value", fmt.Sprintf("The %s attribute of Connect Instance %s is not a boolean: %s", data.AttributeType.ValueString(), data.InstanceID.ValueString(), err))
+		return
+	}
+
+	data.Enabled = types.BoolValue(enabled)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceAttributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, instanceAttributeResourceType, req, resp) {
+		return
+	}
+
+	var data InstanceAttributeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(instanceAttributeResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.update(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// update sets the attribute of the instance to the value of the model.
+func (r *InstanceAttributeResource) update(ctx context.Context, data InstanceAttributeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := r.providerData.resourceConnectClient(instanceAttributeResourceType, data.Override)
+	err := updateInstanceAttribute(ctx, conn, data.InstanceID.ValueString(), conntypes.InstanceAttributeType(data.AttributeType.ValueString()), strconv.FormatBool(data.Enabled.ValueBool()))
+
+	if err != nil {
+		diags.Append(apiError("Error updating Connect Instance Attribute", fmt.Sprintf("Could not update the %s attribute of Connect Instance", data.AttributeType.ValueString()), err))
+		return diags
+	}
+
+	r.providerData.instanceAttributeValues.forget(r.providerData.Config.Region + "/" + data.InstanceID.ValueString())
+
+	return diags
+}
+
+func (r *InstanceAttributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InstanceAttributeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attributes cannot be removed, and their default depends on the
+	// attribute and on when the instance was created
+	tflog.Info(ctx, fmt.Sprintf("Leaving the %s attribute of Connect Instance %s as it is", data.AttributeType.ValueString(), data.InstanceID.ValueString()))
+}
+
+func (r *InstanceAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instanceID, attributeType, found := strings.Cut(req.ID, ":")
+
+	if !found || instanceID == "" || attributeType == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with format <instance_id>:<attribute_type>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_type"), attributeType)...)
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InstanceAttributesDataSource{}
+
+func NewInstanceAttributesDataSource() datasource.DataSource {
+	return &InstanceAttributesDataSource{}
+}
+
+type InstanceAttributesDataSource struct {
+	providerData *ProviderData
+}
+
+type InstanceAttributesDataSourceModel struct {
+	InstanceID    types.String `tfsdk:"instance_id"`
+	InstanceAlias types.String `tfsdk:"instance_alias"`
+	Attributes    types.Map    `tfsdk:"attributes"`
+}
+
+func (d *InstanceAttributesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_instance_attributes"
+}
+
+func (d *InstanceAttributesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connect instance attributes data source",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"attributes": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Map of instance attribute type (e.g. CONTACT_LENS) to its value.",
+			},
+		},
+	}
+}
+
+func (d *InstanceAttributesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *InstanceAttributesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceAttributesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, err := d.providerData.instanceAttributes(ctx, data.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Instance Attributes", "Could not list Connect Instance Attributes", err))
+		return
+	}
+
+	value, diags := types.MapValueFrom(ctx, types.StringType, attributes)
+	resp.Diagnostics.Append(diags...)
+	data.Attributes = value
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InstanceDataSource{}
+
+func NewInstanceDataSource() datasource.DataSource {
+	return &InstanceDataSource{}
+}
+
+type InstanceDataSource struct {
+	providerData *ProviderData
+}
+
+type InstanceDataSourceModel struct {
+	InstanceID             types.String `tfsdk:"instance_id"`
+	InstanceAlias          types.String `tfsdk:"instance_alias"`
+	Arn                    types.String `tfsdk:"arn"`
+	IdentityManagementType types.String `tfsdk:"identity_management_type"`
+	InboundCallsEnabled    types.Bool   `tfsdk:"inbound_calls_enabled"`
+	OutboundCallsEnabled   types.Bool   `tfsdk:"outbound_calls_enabled"`
+	Status                 types.String `tfsdk:"status"`
+	ServiceRole            types.String `tfsdk:"service_role"`
+	AccessURL              types.String `tfsdk:"access_url"`
+	CreatedTime            types.String `tfsdk:"created_time"`
+	Attributes             types.Map    `tfsdk:"attributes"`
+	Tags                   types.Map    `tfsdk:"tags"`
+}
+
+func (d *InstanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_instance"
+}
+
+func (d *InstanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Connect instance by alias or ID, so other resources do not need its ID hardcoded",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Alias of the Connect instance, looked up among the instances of the provider's region and account.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 45),
+				},
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"identity_management_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "How users are managed, CONNECT_MANAGED, SAML or EXISTING_DIRECTORY.",
+			},
+			"inbound_calls_enabled": schema.BoolAttribute{
+				Computed: true,
+			},
+			"outbound_calls_enabled": schema.BoolAttribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"service_role": schema.StringAttribute{
+				Computed:    true,
+				Description: "ARN of the service linked role of the instance.",
+			},
+			"access_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp of the creation of the instance.",
+			},
+			"attributes": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Map of instance attribute type (e.g. CONTACT_LENS) to its value.",
+			},
+			"tags": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *InstanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *InstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The alias is resolved by listing the instances of the account
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := d.providerData.describeInstance(ctx, nil, data.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Instance", fmt.Sprintf("Could not read Connect Instance %s", data.InstanceID.ValueString()), err))
+		return
+	}
+
+	attributes, err := d.providerData.instanceAttributes(ctx, data.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Instance Attributes", "Could not list Connect Instance Attributes", err))
+		return
+	}
+
+	data.InstanceID = types.StringValue(aws.ToString(instance.Id))
+	data.InstanceAlias = types.StringPointerValue(instance.InstanceAlias)
+	data.Arn = types.StringValue(aws.ToString(instance.Arn))
+	data.IdentityManagementType = types.StringValue(string(instance.IdentityManagementType))
+	data.InboundCallsEnabled = types.BoolValue(aws.ToBool(instance.InboundCallsEnabled))
+	data.OutboundCallsEnabled = types.BoolValue(aws.ToBool(instance.OutboundCallsEnabled))
+	data.Status = types.StringValue(string(instance.InstanceStatus))
+	data.ServiceRole = types.StringPointerValue(instance.ServiceRole)
+	data.AccessURL = types.StringPointerValue(instance.InstanceAccessUrl)
+	data.CreatedTime = types.StringNull()
+
+	if instance.CreatedTime != nil {
+		data.CreatedTime = types.StringValue(instance.CreatedTime.Format(time.RFC3339))
+	}
+
+	var diags diag.Diagnostics
+	data.Attributes, diags = types.MapValueFrom(ctx, types.StringType, attributes)
+	resp.Diagnostics.Append(diags...)
+
+	data.Tags, diags = types.MapValueFrom(ctx, types.StringType, ignoreTags(instance.Tags, d.providerData.IgnoreTags))
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
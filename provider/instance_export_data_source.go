@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InstanceExportDataSource{}
+
+// exportableResourceTypes are the resource types the instance export
+// enumerates, with the types exported when resource_types is not set first.
+// The set and association types overlap with them, so they are opt-in.
+var exportableResourceTypes = []string{
+	"awsext_" + agentStatusResourceType,
+	"awsext_" + usersBulkResourceType,
+	"awsext_" + agentStatusSetResourceType,
+	"awsext_" + routingProfileUserAssociationResourceType,
+}
+
+// defaultExportedResourceTypes is the number of exportableResourceTypes
+// exported by default.
+const defaultExportedResourceTypes = 2
+
+// terraformLabelInvalidChars matches the characters not allowed in resource
+// names of import blocks.
+var terraformLabelInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+func NewInstanceExportDataSource() datasource.DataSource {
+	return &InstanceExportDataSource{}
+}
+
+type InstanceExportDataSource struct {
+	providerData *ProviderData
+}
+
+type InstanceExportDataSourceModel struct {
+	InstanceID    types.String        `tfsdk:"instance_id"`
+	InstanceAlias types.String        `tfsdk:"instance_alias"`
+	ResourceTypes types.Set           `tfsdk:"resource_types"`
+	Imports       []ExportImportModel `tfsdk:"imports"`
+	ImportBlocks  types.String        `tfsdk:"import_blocks"`
+}
+
+type ExportImportModel struct {
+	To types.String `tfsdk:"to"`
+	ID types.String `tfsdk:"id"`
+}
+
+func (d *InstanceExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_instance_export"
+}
+
+func (d *InstanceExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enumerates the resources of a Connect instance supported by the provider as import blocks, to adopt an instance built in the console",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"resource_types": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("Resource types to export. Defaults to %s.", strings.Join(exportableResourceTypes[:defaultExportedResourceTypes], " and ")),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(exportableResourceTypes...)),
+				},
+			},
+			"imports": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"to": schema.StringAttribute{
+							Computed:    true,
+							Description: "Address of the resource, named after the Connect resource.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Import ID of the resource.",
+						},
+					},
+				},
+			},
+			"import_blocks": schema.StringAttribute{
+				Computed:    true,
+				Description: "Import blocks of all imports, to write to a file and run terraform plan -generate-config-out.",
+			},
+		},
+	}
+}
+
+func (d *InstanceExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *InstanceExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceExportDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	resourceTypes := slices.Clone(exportableResourceTypes[:defaultExportedResourceTypes])
+	if !data.ResourceTypes.IsNull() {
+		resourceTypes = nil
+		resp.Diagnostics.Append(data.ResourceTypes.ElementsAs(ctx, &resourceTypes, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.providerData.connectClient(nil)
+	instanceID := data.InstanceID.ValueString()
+	export := &instanceExport{imports: []ExportImportModel{}, labels: map[string]bool{}}
+
+	for _, resourceType := range exportableResourceTypes {
+		if !slices.Contains(resourceTypes, resourceType) {
+			continue
+		}
+
+		var err error
+
+		switch resourceType {
+		case "awsext_" + agentStatusResourceType:
+			err = export.agentStatuses(ctx, conn, instanceID, resourceType)
+		case "awsext_" + agentStatusSetResourceType:
+			err = export.instanceScoped(instanceID, resourceType, func() (bool, error) {
+				statuses, err := customAgentStatuses(ctx, conn, instanceID)
+				return len(statuses) > 0, err
+			})
+		case "awsext_" + usersBulkResourceType:
+			err = export.instanceScoped(instanceID, resourceType, func() (bool, error) {
+				users, err := collectPages(ctx, searchUsers(conn, instanceID, nil), 1, nil)
+				return len(users) > 0, err
+			})
+		case "awsext_" + routingProfileUserAssociationResourceType:
+			err = export.routingProfileUserAssociations(ctx, conn, instanceID, resourceType)
+		}
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error exporting Connect Instance", fmt.Sprintf("Could not enumerate the %s resources of instance %s", resourceType, instanceID), err))
+			return
+		}
+	}
+
+	var blocks strings.Builder
+	for i, imp := range export.imports {
+		if i > 0 {
+			blocks.WriteString("\n")
+		}
+
+		fmt.Fprintf(&blocks, "import {\n  to = %s\n  id = %q\n}\n", imp.To.ValueString(), imp.ID.ValueString())
+	}
+
+	data.Imports = export.imports
+	data.ImportBlocks = types.StringValue(blocks.String())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// instanceExport collects the imports of an instance, naming the resources
+// uniquely.
+type instanceExport struct {
+	imports []ExportImportModel
+	labels  map[string]bool
+}
+
+// add adds the import of a resource, named after the Connect resource.
+func (e *instanceExport) add(resourceType string, name string, id string) {
+	label := strings.Trim(terraformLabelInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	switch {
+	case label == "":
+		label = "resource"
+	case label[0] >= '0' && label[0] <= '9':
+		label = "r_" + label
+	}
+
+	unique := label
+	for i := 2; e.labels[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+
+	e.labels[resourceType+"."+unique] = true
+	e.imports = append(e.imports, ExportImportModel{
+		To: types.StringValue(resourceType + "." + unique),
+		ID: types.StringValue(id),
+	})
+}
+
+// agentStatuses adds the custom agent statuses of the instance. System
+// statuses cannot be updated, so they are not exported.
+func (e *instanceExport) agentStatuses(ctx context.Context, conn *connect.Client, instanceID string, resourceType string) error {
+	statuses, err := customAgentStatuses(ctx, conn, instanceID)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(statuses)) {
+		e.add(resourceType, name, instanceID+":"+aws.ToString(statuses[name].AgentStatusId))
+	}
+
+	return nil
+}
+
+// instanceScoped adds a resource imported by instance ID if exists reports
+// that the instance has resources for it to manage.
+func (e *instanceExport) instanceScoped(instanceID string, resourceType string, exists func() (bool, error)) error {
+	ok, err := exists()
+	if err != nil || !ok {
+		return err
+	}
+
+	e.add(resourceType, "this", instanceID)
+
+	return nil
+}
+
+// routingProfileUserAssociations adds the routing profiles having users, as
+// associations of all their users.
+func (e *instanceExport) routingProfileUserAssociations(ctx context.Context, conn *connect.Client, instanceID string, resourceType string) error {
+	users, err := collectPages(ctx, searchUsers(conn, instanceID, nil), 0, nil)
+	if err != nil {
+		return err
+	}
+
+	assigned := map[string]bool{}
+	for _, user := range users {
+		assigned[aws.ToString(user.RoutingProfileId)] = true
+	}
+
+	profiles, err := collectPages(ctx, listRoutingProfiles(conn, instanceID), 0, func(profile conntypes.RoutingProfileSummary) bool {
+		return assigned[aws.ToString(profile.Id)]
+	})
+	if err != nil {
+		return err
+	}
+
+	slices.SortFunc(profiles, func(a, b conntypes.RoutingProfileSummary) int {
+		return strings.Compare(aws.ToString(a.Name), aws.ToString(b.Name))
+	})
+
+	for _, profile := range profiles {
+		e.add(resourceType, aws.ToString(profile.Name), instanceID+":"+aws.ToString(profile.Id))
+	}
+
+	return nil
+}
+
+func listRoutingProfiles(conn *connect.Client, instanceID string) pageLister[conntypes.RoutingProfileSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.RoutingProfileSummary, *string, error) {
+		response, err := conn.ListRoutingProfiles(ctx, &connect.ListRoutingProfilesInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int32(1000),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.RoutingProfileSummaryList, response.NextToken, nil
+	}
+}
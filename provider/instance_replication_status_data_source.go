@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InstanceReplicationStatusDataSource{}
+
+func NewInstanceReplicationStatusDataSource() datasource.DataSource {
+	return &InstanceReplicationStatusDataSource{}
+}
+
+type InstanceReplicationStatusDataSource struct {
+	providerData *ProviderData
+}
+
+type InstanceReplicationStatusDataSourceModel struct {
+	InstanceID           types.String             `tfsdk:"instance_id"`
+	InstanceAlias        types.String             `tfsdk:"instance_alias"`
+	Replicated           types.Bool               `tfsdk:"replicated"`
+	Ready                types.Bool               `tfsdk:"ready"`
+	SourceRegion         types.String             `tfsdk:"source_region"`
+	GlobalSignInEndpoint types.String             `tfsdk:"global_sign_in_endpoint"`
+	Regions              []ReplicationRegionModel `tfsdk:"regions"`
+}
+
+type ReplicationRegionModel struct {
+	Region       types.String `tfsdk:"region"`
+	Status       types.String `tfsdk:"status"`
+	StatusReason types.String `tfsdk:"status_reason"`
+}
+
+func (d *InstanceReplicationStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_instance_replication_status"
+}
+
+func (d *InstanceReplicationStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Global Resiliency replication status of a Connect instance, per region",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"replicated": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the instance is replicated to another region.",
+			},
+			"ready": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the instance is replicated and the replication is complete in every region, e.g. to gate a failover.",
+			},
+			"source_region": schema.StringAttribute{
+				Computed:    true,
+				Description: "Region where the instance was replicated from.",
+			},
+			"global_sign_in_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Sign-in URL of the instance across its traffic distribution group.",
+			},
+			"regions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Replication status in the region, e.g. INSTANCE_REPLICATION_COMPLETE.",
+						},
+						"status_reason": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InstanceReplicationStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *InstanceReplicationStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceReplicationStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Described directly instead of through the memoized instance, as the
+	// replication status changes while the provider runs
+	response, err := d.providerData.connectClient(nil).DescribeInstance(ctx, &connect.DescribeInstanceInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Instance", fmt.Sprintf("Could not read Connect instance %s", data.InstanceID.ValueString()), err))
+		return
+	}
+
+	config := response.ReplicationConfiguration
+	if config == nil {
+		config = &conntypes.ReplicationConfiguration{}
+	}
+
+	data.SourceRegion = types.StringPointerValue(config.SourceRegion)
+	data.GlobalSignInEndpoint = types.StringPointerValue(config.GlobalSignInEndpoint)
+	data.Replicated = types.BoolValue(len(config.ReplicationStatusSummaryList) > 0)
+
+	ready := len(config.ReplicationStatusSummaryList) > 0
+	data.Regions = make([]ReplicationRegionModel, 0, len(config.ReplicationStatusSummaryList))
+	for _, summary := range config.ReplicationStatusSummaryList {
+		data.Regions = append(data.Regions, ReplicationRegionModel{
+			Region:       types.StringPointerValue(summary.Region),
+			Status:       types.StringValue(string(summary.ReplicationStatus)),
+			StatusReason: types.StringPointerValue(summary.ReplicationStatusReason),
+		})
+
+		ready = ready && summary.ReplicationStatus == conntypes.InstanceReplicationStatusInstanceReplicationComplete
+	}
+
+	data.Ready = types.BoolValue(ready)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
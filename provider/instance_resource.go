@@ -0,0 +1,484 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &InstanceResource{}
+var _ resource.ResourceWithIdentity = &InstanceResource{}
+var _ resource.ResourceWithImportState = &InstanceResource{}
+var _ resource.ResourceWithModifyPlan = &InstanceResource{}
+var _ resource.ResourceWithMoveState = &InstanceResource{}
+
+// instanceResourceType is the type of the instance resource without the
+// provider prefix, e.g. in operation_policies.
+const instanceResourceType = "connect_instance"
+
+func NewInstanceResource() resource.Resource {
+	return &InstanceResource{}
+}
+
+type InstanceResource struct {
+	providerData *ProviderData
+}
+
+type InstanceResourceModel struct {
+	Arn                    types.String   `tfsdk:"arn"`
+	InstanceID             types.String   `tfsdk:"instance_id"`
+	InstanceAlias          types.String   `tfsdk:"instance_alias"`
+	IdentityManagementType types.String   `tfsdk:"identity_management_type"`
+	DirectoryID            types.String   `tfsdk:"directory_id"`
+	InboundCallsEnabled    types.Bool     `tfsdk:"inbound_calls_enabled"`
+	OutboundCallsEnabled   types.Bool     `tfsdk:"outbound_calls_enabled"`
+	Status                 types.String   `tfsdk:"status"`
+	ServiceRole            types.String   `tfsdk:"service_role"`
+	AccessURL              types.String   `tfsdk:"access_url"`
+	CreatedTime            types.String   `tfsdk:"created_time"`
+	DeletionProtection     types.Bool     `tfsdk:"deletion_protection"`
+	Tags                   types.Map      `tfsdk:"tags"`
+	TagsAll                types.Map      `tfsdk:"tags_all"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+	Override               *OverrideModel `tfsdk:"override"`
+}
+
+type InstanceResourceIdentityModel struct {
+	Arn        types.String `tfsdk:"arn"`
+	InstanceID types.String `tfsdk:"instance_id"`
+}
+
+func (m InstanceResourceModel) identity() InstanceResourceIdentityModel {
+	return InstanceResourceIdentityModel{
+		Arn:        m.Arn,
+		InstanceID: m.InstanceID,
+	}
+}
+
+func (r *InstanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + instanceResourceType
+}
+
+func (r *InstanceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = connectUnscopedIdentitySchema("instance_id")
+}
+
+func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connect instance resource. Other instance attributes, e.g. CONTACT_LENS, are managed individually with awsext_connect_instance_attribute",
+
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_alias": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Alias of the instance, part of its access URL. Required unless identity_management_type is EXISTING_DIRECTORY. Changing it replaces the instance.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 45),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_management_type": schema.StringAttribute{
+				Required:    true,
+				Description: "How users are managed, CONNECT_MANAGED, SAML or EXISTING_DIRECTORY. Changing it replaces the instance.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(conntypes.DirectoryTypeConnectManaged), string(conntypes.DirectoryTypeSaml), string(conntypes.DirectoryTypeExistingDirectory)),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the Directory Service directory of the users, when identity_management_type is EXISTING_DIRECTORY. Changing it replaces the instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"inbound_calls_enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"outbound_calls_enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_role": schema.StringAttribute{
+				Computed:    true,
+				Description: "ARN of the service linked role of the instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"access_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp of the creation of the instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			deletionProtectionAttributeName: deletionProtectionAttribute(),
+			"tags":                          tagsAttribute(),
+			"tags_all":                      tagsAllAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *InstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *InstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.providerData.modifyPlanTags(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *InstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(instanceResourceType, resp) {
+		return
+	}
+
+	var data InstanceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	tagsAll, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(instanceResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(instanceResourceType, data.Override)
+	input := &connect.CreateInstanceInput{
+		IdentityManagementType: conntypes.DirectoryType(data.IdentityManagementType.ValueString()),
+		InstanceAlias:          data.InstanceAlias.ValueStringPointer(),
+		DirectoryId:            data.DirectoryID.ValueStringPointer(),
+		InboundCallsEnabled:    aws.Bool(data.InboundCallsEnabled.ValueBool()),
+		OutboundCallsEnabled:   aws.Bool(data.OutboundCallsEnabled.ValueBool()),
+	}
+
+	if len(tagsAll) > 0 {
+		input.Tags = tagsAll
+	}
+
+	response, err := conn.CreateInstance(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect Instance", "Could not create Connect Instance", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// The alias lookups listed before the instance existed are stale
+	r.providerData.instanceAliases.forget(r.providerData.instanceAliasKey(data.Override))
+
+	var instance *conntypes.Instance
+
+	err = waitFor(ctx, defaultPollInterval, func(ctx context.Context) (bool, error) {
+		described, err := conn.DescribeInstance(ctx, &connect.DescribeInstanceInput{
+			InstanceId: response.Id,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		instance = described.Instance
+
+		switch instance.InstanceStatus {
+		case conntypes.InstanceStatusActive:
+			return true, nil
+		case conntypes.InstanceStatusCreationFailed:
+			reason := "unknown reason"
+			if instance.StatusReason != nil {
+				reason = aws.ToString(instance.StatusReason.Message)
+			}
+			return false, fmt.Errorf("instance creation failed: %s", reason)
+		default:
+			return false, nil
+		}
+	})
+
+	data.InstanceID = types.StringValue(aws.ToString(response.Id))
+	data.Arn = types.StringValue(aws.ToString(response.Arn))
+	data.Status = types.StringNull()
+	data.ServiceRole = types.StringNull()
+	data.AccessURL = types.StringNull()
+	data.CreatedTime = types.StringNull()
+
+	if data.InstanceAlias.IsUnknown() {
+		data.InstanceAlias = types.StringNull()
+	}
+
+	if instance != nil {
+		data.flatten(instance)
+	}
+
+	// Instances which failed to be created are saved too, so that they are
+	// deleted on the next apply
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for Connect Instance", fmt.Sprintf("Connect Instance %s did not become active: %s", data.InstanceID.ValueString(), err))
+	}
+}
+
+func (r *InstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InstanceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(instanceResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(instanceResourceType, data.Override)
+	response, err := conn.DescribeInstance(ctx, &connect.DescribeInstanceInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+	})
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Instance", "Could not read Connect Instance", err))
+		return
+	}
+
+	instance := response.Instance
+	data.flatten(instance)
+
+	// Imported instances have no deletion protection in state
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
+	data.Tags, data.TagsAll, diags = r.providerData.readTags(ctx, instance.Tags, data.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *InstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, instanceResourceType, req, resp) {
+		return
+	}
+
+	var data, state InstanceResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(instanceResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(instanceResourceType, data.Override)
+
+	calls := map[conntypes.InstanceAttributeType][2]types.Bool{
+		conntypes.InstanceAttributeTypeInboundCalls:  {state.InboundCallsEnabled, data.InboundCallsEnabled},
+		conntypes.InstanceAttributeTypeOutboundCalls: {state.OutboundCallsEnabled, data.OutboundCallsEnabled},
+	}
+
+	for attributeType, values := range calls {
+		if values[0].Equal(values[1]) {
+			continue
+		}
+
+		err := updateInstanceAttribute(ctx, conn, data.InstanceID.ValueString(), attributeType, fmt.Sprint(values[1].ValueBool()))
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Instance", fmt.Sprintf("Could not update the %s attribute of Connect Instance", attributeType), err))
+			return
+		}
+	}
+
+	r.providerData.instanceAttributeValues.forget(r.providerData.Config.Region + "/" + data.InstanceID.ValueString())
+
+	if !data.TagsAll.Equal(state.TagsAll) {
+		oldTags, diags := mapFromTags(ctx, state.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		newTags, diags := mapFromTags(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), oldTags, newTags); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Instance tags", "Could not update Connect Instance tags", err))
+			return
+		}
+	}
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *InstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(instanceResourceType, resp) {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDeletionProtection(ctx, req.State, "awsext_connect_instance")...)
+
+	var data InstanceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(instanceResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(instanceResourceType, data.Override)
+	_, err := conn.DeleteInstance(ctx, &connect.DeleteInstanceInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+	})
+
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error deleting Connect Instance", "Could not delete Connect Instance", err))
+		return
+	}
+
+	err = waitFor(ctx, defaultPollInterval, func(ctx context.Context) (bool, error) {
+		_, err := conn.DescribeInstance(ctx, &connect.DescribeInstanceInput{
+			InstanceId: aws.String(data.InstanceID.ValueString()),
+		})
+		if isNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for Connect Instance", fmt.Sprintf("Connect Instance %s was not deleted: %s", data.InstanceID.ValueString(), err))
+		return
+	}
+
+	r.providerData.instanceAliases.forget(r.providerData.instanceAliasKey(data.Override))
+}
+
+func (r *InstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importUnscopedConnectResource(ctx, req, resp, "instance", "instance_id")
+}
+
+// MoveState migrates the state of aws_connect_instance in moved blocks.
+func (r *InstanceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{connectUnscopedStateMover("aws_connect_instance", "instance_id")}
+}
+
+// flatten sets the attributes of the model read from an instance.
+func (m *InstanceResourceModel) flatten(instance *conntypes.Instance) {
+	m.InstanceID = types.StringValue(aws.ToString(instance.Id))
+	m.Arn = types.StringValue(aws.ToString(instance.Arn))
+	m.InstanceAlias = types.StringPointerValue(instance.InstanceAlias)
+	m.IdentityManagementType = types.StringValue(string(instance.IdentityManagementType))
+	m.InboundCallsEnabled = types.BoolValue(aws.ToBool(instance.InboundCallsEnabled))
+	m.OutboundCallsEnabled = types.BoolValue(aws.ToBool(instance.OutboundCallsEnabled))
+	m.Status = types.StringValue(string(instance.InstanceStatus))
+	m.ServiceRole = types.StringPointerValue(instance.ServiceRole)
+	m.AccessURL = types.StringPointerValue(instance.InstanceAccessUrl)
+	m.CreatedTime = types.StringNull()
+
+	if instance.CreatedTime != nil {
+		m.CreatedTime = types.StringValue(instance.CreatedTime.Format(time.RFC3339))
+	}
+}
+
+// updateInstanceAttribute sets the value of an attribute of an instance.
+func updateInstanceAttribute(ctx context.Context, conn *connect.Client, instanceID string, attributeType conntypes.InstanceAttributeType, value string) error {
+	_, err := conn.UpdateInstanceAttribute(ctx, &connect.UpdateInstanceAttributeInput{
+		InstanceId:    aws.String(instanceID),
+		AttributeType: attributeType,
+		Value:         aws.String(value),
+	})
+
+	return err
+}
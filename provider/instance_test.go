@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMemo(t *testing.T) {
+	throttled := errors.New("throttled")
+
+	tests := map[string]struct {
+		results   []error
+		wantErrs  []error
+		wantLoads int
+	}{
+		"memoizes a value": {
+			results:   []error{nil, nil},
+			wantErrs:  []error{nil, nil, nil},
+			wantLoads: 1,
+		},
+		"loads again after an error": {
+			results:   []error{throttled, nil},
+			wantErrs:  []error{throttled, nil, nil},
+			wantLoads: 2,
+		},
+		"does not memoize repeated errors": {
+			results:   []error{throttled, throttled, nil},
+			wantErrs:  []error{throttled, throttled, nil},
+			wantLoads: 3,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var m memo[int]
+			loads := 0
+
+			for i, wantErr := range test.wantErrs {
+				value, err := m.get("key", func() (int, error) {
+					err := test.results[loads]
+					loads++
+					return loads, err
+				})
+
+				if !errors.Is(err, wantErr) {
+					t.Errorf("get %d: got error %v, want %v", i, err, wantErr)
+				}
+
+				if _, ok := m.peek("key"); ok != (err == nil) {
+					t.Errorf("get %d: got peek %t, want %t", i, ok, err == nil)
+				}
+
+				if err == nil && value != loads {
+					t.Errorf("get %d: got %d, want %d", i, value, loads)
+				}
+			}
+
+			if loads != test.wantLoads {
+				t.Errorf("got %d loads, want %d", loads, test.wantLoads)
+			}
+		})
+	}
+}
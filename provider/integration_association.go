@@ -0,0 +1,411 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &IntegrationAssociationResource{}
+var _ resource.ResourceWithIdentity = &IntegrationAssociationResource{}
+var _ resource.ResourceWithImportState = &IntegrationAssociationResource{}
+var _ resource.ResourceWithModifyPlan = &IntegrationAssociationResource{}
+
+// integrationAssociationResourceType is the type of the integration
+// association resource without the provider prefix, e.g. in
+// operation_policies.
+const integrationAssociationResourceType = "connect_integration_association"
+
+func NewIntegrationAssociationResource() resource.Resource {
+	return &IntegrationAssociationResource{}
+}
+
+type IntegrationAssociationResource struct {
+	providerData *ProviderData
+}
+
+type IntegrationAssociationResourceModel struct {
+	InstanceID               types.String   `tfsdk:"instance_id"`
+	InstanceAlias            types.String   `tfsdk:"instance_alias"`
+	IntegrationAssociationID types.String   `tfsdk:"integration_association_id"`
+	Arn                      types.String   `tfsdk:"arn"`
+	IntegrationType          types.String   `tfsdk:"integration_type"`
+	IntegrationArn           types.String   `tfsdk:"integration_arn"`
+	SourceApplicationName    types.String   `tfsdk:"source_application_name"`
+	SourceApplicationURL     types.String   `tfsdk:"source_application_url"`
+	SourceType               types.String   `tfsdk:"source_type"`
+	Tags                     types.Map      `tfsdk:"tags"`
+	TagsAll                  types.Map      `tfsdk:"tags_all"`
+	SkipDestroy              types.Bool     `tfsdk:"skip_destroy"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	Override                 *OverrideModel `tfsdk:"override"`
+}
+
+type IntegrationAssociationResourceIdentityModel struct {
+	Arn                      types.String `tfsdk:"arn"`
+	IntegrationAssociationID types.String `tfsdk:"integration_association_id"`
+}
+
+func (m IntegrationAssociationResourceModel) identity() IntegrationAssociationResourceIdentityModel {
+	return IntegrationAssociationResourceIdentityModel{
+		Arn:                      m.Arn,
+		IntegrationAssociationID: m.IntegrationAssociationID,
+	}
+}
+
+func (r *IntegrationAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + integrationAssociationResourceType
+}
+
+func (r *IntegrationAssociationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = connectIdentitySchema("integration_association_id")
+}
+
+func (r *IntegrationAssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	integrationTypes := []string{}
+	for _, integrationType := range conntypes.IntegrationType("").Values() {
+		integrationTypes = append(integrationTypes, string(integrationType))
+	}
+
+	sourceTypes := []string{}
+	for _, sourceType := range conntypes.SourceType("").Values() {
+		sourceTypes = append(sourceTypes, string(sourceType))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Associates an integration with a Connect instance, e.g. a Voice ID domain, a Wisdom assistant or knowledge base, an AppIntegrations application or event integration, or a Cases domain",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"integration_association_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of the integration, e.g. VOICE_ID, WISDOM_ASSISTANT, WISDOM_KNOWLEDGE_BASE, APPLICATION, EVENT or CASES_DOMAIN.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(integrationTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration_arn": schema.StringAttribute{
+				Required:    true,
+				Description: "ARN of the integration, e.g. arn:aws:voiceid:us-east-1:123456789012:domain/abcdefghijklmnopqrstuv.",
+				Validators: []validator.String{
+					validArn(""),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_application_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the external application, only for EVENT integrations.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("source_application_url"), path.MatchRoot("source_type")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_application_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the external application, only for EVENT integrations.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("source_application_name"), path.MatchRoot("source_type")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Type of the external application, e.g. SALESFORCE, only for EVENT integrations.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(sourceTypes...),
+					stringvalidator.AlsoRequires(path.MatchRoot("source_application_name"), path.MatchRoot("source_application_url")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags":                   tagsAttribute(),
+			"tags_all":               tagsAllAttribute(),
+			skipDestroyAttributeName: skipDestroyAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *IntegrationAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *IntegrationAssociationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanTags(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *IntegrationAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(integrationAssociationResourceType, resp) {
+		return
+	}
+
+	var data IntegrationAssociationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(integrationAssociationResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	tagsAll, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &connect.CreateIntegrationAssociationInput{
+		InstanceId:            aws.String(data.InstanceID.ValueString()),
+		IntegrationType:       conntypes.IntegrationType(data.IntegrationType.ValueString()),
+		IntegrationArn:        aws.String(data.IntegrationArn.ValueString()),
+		SourceApplicationName: data.SourceApplicationName.ValueStringPointer(),
+		SourceApplicationUrl:  data.SourceApplicationURL.ValueStringPointer(),
+		SourceType:            conntypes.SourceType(data.SourceType.ValueString()),
+	}
+
+	if len(tagsAll) > 0 {
+		input.Tags = tagsAll
+	}
+
+	conn := r.providerData.resourceConnectClient(integrationAssociationResourceType, data.Override)
+	response, err := conn.CreateIntegrationAssociation(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect Integration Association", fmt.Sprintf("Could not associate %s integration %s", data.IntegrationType.ValueString(), data.IntegrationArn.ValueString()), err))
+		return
+	}
+
+	data.IntegrationAssociationID = types.StringPointerValue(response.IntegrationAssociationId)
+	data.Arn = types.StringPointerValue(response.IntegrationAssociationArn)
+
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *IntegrationAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IntegrationAssociationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(integrationAssociationResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	// There is no API describing a single association. The integration type
+	// is not known on import
+	conn := r.providerData.resourceConnectClient(integrationAssociationResourceType, data.Override)
+	associations, err := collectPages(ctx, listIntegrationAssociations(conn, data.InstanceID.ValueString(), conntypes.IntegrationType(data.IntegrationType.ValueString())), 1, func(association conntypes.IntegrationAssociationSummary) bool {
+		return aws.ToString(association.IntegrationAssociationId) == data.IntegrationAssociationID.ValueString()
+	})
+
+	if isNotFound(err) || (err == nil && len(associations) == 0) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Integration Associations", "Could not list integration associations", err))
+		return
+	}
+
+	association := associations[0]
+	data.Arn = types.StringPointerValue(association.IntegrationAssociationArn)
+	data.IntegrationType = types.StringValue(string(association.IntegrationType))
+	data.IntegrationArn = types.StringPointerValue(association.IntegrationArn)
+	data.SourceApplicationName = optionalString(association.SourceApplicationName)
+	data.SourceApplicationURL = optionalString(association.SourceApplicationUrl)
+	data.SourceType = optionalString(aws.String(string(association.SourceType)))
+
+	tags, err := connectResourceTags(ctx, conn, data.Arn.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Integration Association tags", "Could not read integration association tags", err))
+		return
+	}
+
+	data.Tags, data.TagsAll, diags = r.providerData.readTags(ctx, tags, data.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	// skip_destroy is not set on import
+	if data.SkipDestroy.IsNull() {
+		data.SkipDestroy = types.BoolValue(false)
+	}
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *IntegrationAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, integrationAssociationResourceType, req, resp) {
+		return
+	}
+
+	var data, state IntegrationAssociationResourceModel
+
+	// Only the tags, skip_destroy and timeouts can change without replacement
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.TagsAll.Equal(state.TagsAll) {
+		ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(integrationAssociationResourceType, defaultUpdateTimeout))
+		resp.Diagnostics.Append(diags...)
+		defer cancel()
+
+		oldTags, diags := mapFromTags(ctx, state.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		newTags, diags := mapFromTags(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		conn := r.providerData.resourceConnectClient(integrationAssociationResourceType, data.Override)
+		if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), oldTags, newTags); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Integration Association tags", "Could not update integration association tags", err))
+			return
+		}
+	}
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *IntegrationAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(integrationAssociationResourceType, resp) {
+		return
+	}
+
+	var data IntegrationAssociationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	skip, diags := skipDestroy(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || skip {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(integrationAssociationResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(integrationAssociationResourceType, data.Override)
+	_, err := conn.DeleteIntegrationAssociation(ctx, &connect.DeleteIntegrationAssociationInput{
+		InstanceId:               aws.String(data.InstanceID.ValueString()),
+		IntegrationAssociationId: aws.String(data.IntegrationAssociationID.ValueString()),
+	})
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error deleting Connect Integration Association", fmt.Sprintf("Could not delete integration association %s", data.IntegrationAssociationID.ValueString()), err))
+	}
+}
+
+func (r *IntegrationAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importConnectResource(ctx, req, resp, "integration-association", "integration_association_id")
+}
+
+// listIntegrationAssociations returns a lister of the integration
+// associations of an instance, of all types if integrationType is empty.
+func listIntegrationAssociations(conn *connect.Client, instanceID string, integrationType conntypes.IntegrationType) pageLister[conntypes.IntegrationAssociationSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.IntegrationAssociationSummary, *string, error) {
+		response, err := conn.ListIntegrationAssociations(ctx, &connect.ListIntegrationAssociationsInput{
+			InstanceId:      aws.String(instanceID),
+			IntegrationType: integrationType,
+			MaxResults:      aws.Int32(100),
+			NextToken:       nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.IntegrationAssociationSummaryList, response.NextToken, nil
+	}
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &KmsDataKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &KmsDataKeyEphemeralResource{}
+
+func NewKmsDataKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &KmsDataKeyEphemeralResource{}
+}
+
+type KmsDataKeyEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type KmsDataKeyEphemeralResourceModel struct {
+	KeyID             types.String `tfsdk:"key_id"`
+	KeySpec           types.String `tfsdk:"key_spec"`
+	NumberOfBytes     types.Int32  `tfsdk:"number_of_bytes"`
+	EncryptionContext types.Map    `tfsdk:"encryption_context"`
+	KeyArn            types.String `tfsdk:"key_arn"`
+	Plaintext         types.String `tfsdk:"plaintext"`
+	CiphertextBlob    types.String `tfsdk:"ciphertext_blob"`
+}
+
+func (r *KmsDataKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_data_key"
+}
+
+func (r *KmsDataKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "KMS data key generated with GenerateDataKey",
+
+		Attributes: map[string]schema.Attribute{
+			"key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Key ID, key ARN, alias name or alias ARN of the KMS key.",
+			},
+			"key_spec": schema.StringAttribute{
+				Optional:    true,
+				Description: "Length of the data key, `AES_256` or `AES_128`. Defaults to `AES_256` unless `number_of_bytes` is set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(kmstypes.DataKeySpecAes256), string(kmstypes.DataKeySpecAes128)),
+					stringvalidator.ConflictsWith(path.MatchRoot("number_of_bytes")),
+				},
+			},
+			"number_of_bytes": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Length of the data key in bytes.",
+				Validators: []validator.Int32{
+					int32validator.Between(1, 1024),
+				},
+			},
+			"encryption_context": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"key_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded plaintext data key.",
+			},
+			"ciphertext_blob": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base64 encoded data key encrypted under the KMS key.",
+			},
+		},
+	}
+}
+
+func (r *KmsDataKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *KmsDataKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data KmsDataKeyEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.providerData.kmsClient()
+	input := &kms.GenerateDataKeyInput{
+		KeyId:         aws.String(data.KeyID.ValueString()),
+		NumberOfBytes: data.NumberOfBytes.ValueInt32Pointer(),
+	}
+
+	if data.KeySpec.ValueString() != "" {
+		input.KeySpec = kmstypes.DataKeySpec(data.KeySpec.ValueString())
+	} else if data.NumberOfBytes.IsNull() {
+		input.KeySpec = kmstypes.DataKeySpecAes256
+	}
+
+	if !data.EncryptionContext.IsNull() {
+		resp.Diagnostics.Append(data.EncryptionContext.ElementsAs(ctx, &input.EncryptionContext, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response, err := conn.GenerateDataKey(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error generating KMS Data Key", "Could not generate KMS Data Key", err))
+		return
+	}
+
+	data.KeyArn = types.StringValue(aws.ToString(response.KeyId))
+	data.Plaintext = types.StringValue(base64.StdEncoding.EncodeToString(response.Plaintext))
+	data.CiphertextBlob = types.StringValue(base64.StdEncoding.EncodeToString(response.CiphertextBlob))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
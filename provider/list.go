@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// listConcurrency bounds the results of a page built concurrently, e.g. when
+// each result describes its resource. Requests are further limited by the
+// provider's max_concurrent_requests.
+const listConcurrency = 8
+
+// listPage is a page fetched ahead by streamListResults.
+type listPage[S any] struct {
+	items []S
+	err   error
+}
+
+// streamListResults sets the results of a list request to one result per
+// item of all pages of lister, stopping at the requested limit. The result
+// function populates the display name, identity and, when requested, the
+// resource of each result. The next page is fetched while the results of the
+// current one are built, concurrently and pushed in order.
+func streamListResults[S any](ctx context.Context, req list.ListRequest, stream *list.ListResultsStream, lister pageLister[S], result func(context.Context, S, *list.ListResult)) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		pages := make(chan listPage[S], 1)
+		go fetchPages(ctx, lister, pages)
+
+		var count int64
+		for page := range pages {
+			if page.err != nil {
+				var diags diag.Diagnostics
+				diags.AddError("Error listing resources", withAPICallDetails(page.err.Error(), page.err))
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			items := page.items
+			if req.Limit > 0 && int64(len(items)) > req.Limit-count {
+				items = items[:req.Limit-count]
+			}
+			count += int64(len(items))
+
+			results := make([]list.ListResult, len(items))
+			semaphore := make(chan struct{}, listConcurrency)
+			var wg sync.WaitGroup
+
+			for i, item := range items {
+				results[i] = req.NewListResult(ctx)
+
+				wg.Add(1)
+				semaphore <- struct{}{}
+				go func() {
+					defer wg.Done()
+					defer func() { <-semaphore }()
+
+					result(ctx, item, &results[i])
+				}()
+			}
+
+			wg.Wait()
+
+			for _, listResult := range results {
+				if !push(listResult) {
+					return
+				}
+			}
+
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+		}
+	}
+}
+
+// fetchPages sends the pages of lister to pages until the last page, an
+// error, or the cancellation of ctx, and closes pages.
+func fetchPages[S any](ctx context.Context, lister pageLister[S], pages chan<- listPage[S]) {
+	defer close(pages)
+
+	var nextToken *string
+	for {
+		items, next, err := lister(ctx, nextToken)
+
+		select {
+		case pages <- listPage[S]{items: items, err: err}:
+		case <-ctx.Done():
+			return
+		}
+
+		if err != nil || next == nil {
+			return
+		}
+
+		nextToken = next
+	}
+}
@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &MetricDataV2DataSource{}
+
+func NewMetricDataV2DataSource() datasource.DataSource {
+	return &MetricDataV2DataSource{}
+}
+
+type MetricDataV2DataSource struct {
+	providerData *ProviderData
+}
+
+type MetricDataV2DataSourceModel struct {
+	InstanceID     types.String        `tfsdk:"instance_id"`
+	InstanceAlias  types.String        `tfsdk:"instance_alias"`
+	StartTime      types.String        `tfsdk:"start_time"`
+	EndTime        types.String        `tfsdk:"end_time"`
+	IntervalPeriod types.String        `tfsdk:"interval_period"`
+	TimeZone       types.String        `tfsdk:"time_zone"`
+	Filters        []MetricFilterModel `tfsdk:"filters"`
+	Groupings      types.List          `tfsdk:"groupings"`
+	Metrics        []MetricModel       `tfsdk:"metrics"`
+	MaxResults     types.Int64         `tfsdk:"max_results"`
+	Results        []MetricResultModel `tfsdk:"results"`
+}
+
+type MetricFilterModel struct {
+	Key    types.String `tfsdk:"key"`
+	Values types.Set    `tfsdk:"values"`
+}
+
+type MetricModel struct {
+	Name      types.String          `tfsdk:"name"`
+	Threshold *MetricThresholdModel `tfsdk:"threshold"`
+}
+
+type MetricThresholdModel struct {
+	Comparison types.String  `tfsdk:"comparison"`
+	Value      types.Float64 `tfsdk:"value"`
+}
+
+type MetricResultModel struct {
+	Dimensions    types.Map    `tfsdk:"dimensions"`
+	IntervalStart types.String `tfsdk:"interval_start"`
+	IntervalEnd   types.String `tfsdk:"interval_end"`
+	Metrics       types.Map    `tfsdk:"metrics"`
+}
+
+func (d *MetricDataV2DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_metric_data_v2"
+}
+
+func (d *MetricDataV2DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Historical metrics of a Connect instance from GetMetricDataV2, e.g. the average queue answer time of a queue over the last week",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"start_time": schema.StringAttribute{
+				Required:    true,
+				Description: "RFC3339 timestamp of the start of the time window, at most 90 days ago.",
+			},
+			"end_time": schema.StringAttribute{
+				Required:    true,
+				Description: "RFC3339 timestamp of the end of the time window, at most 35 days after start_time.",
+			},
+			"interval_period": schema.StringAttribute{
+				Optional:    true,
+				Description: "Aggregates the metrics by interval instead of over the whole time window.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("FIFTEEN_MIN", "THIRTY_MIN", "HOUR", "DAY", "WEEK", "TOTAL"),
+				},
+			},
+			"time_zone": schema.StringAttribute{
+				Optional:    true,
+				Description: "Time zone of the intervals, e.g. America/New_York. Defaults to UTC.",
+			},
+			"filters": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Filters of the metrics, e.g. by QUEUE or ROUTING_PROFILE. At least one filter is required.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 5),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required: true,
+						},
+						"values": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"groupings": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Dimensions the metrics are grouped by, e.g. QUEUE.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(4),
+				},
+			},
+			"metrics": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Metrics to return, e.g. AVG_QUEUE_ANSWER_TIME.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"threshold": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Threshold of metrics requiring one, e.g. SERVICE_LEVEL.",
+							Attributes: map[string]schema.Attribute{
+								"comparison": schema.StringAttribute{
+									Required:    true,
+									Description: "Comparison of the threshold, e.g. LT.",
+								},
+								"value": schema.Float64Attribute{
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"max_results": maxResultsAttribute(),
+			"results": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dimensions": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Values of the groupings of the result, e.g. the queue ID by QUEUE.",
+						},
+						"interval_start": schema.StringAttribute{
+							Computed: true,
+						},
+						"interval_end": schema.StringAttribute{
+							Computed: true,
+						},
+						"metrics": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.Float64Type,
+							Description: "Metric values by name. Values are null when there is no data.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MetricDataV2DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *MetricDataV2DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetricDataV2DataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.input(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Metrics are requested by instance ARN
+	instance, err := d.providerData.describeInstance(ctx, nil, data.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Instance", fmt.Sprintf("Could not read Connect instance %s", data.InstanceID.ValueString()), err))
+		return
+	}
+
+	input.ResourceArn = instance.Arn
+
+	conn := d.providerData.connectClient(nil)
+	results, err := collectPages(ctx, getMetricDataV2(conn, input, data.MaxResults.ValueInt64()), data.MaxResults.ValueInt64(), nil)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error getting Connect Metric Data", "Could not get Connect metric data", err))
+		return
+	}
+
+	data.Results = make([]MetricResultModel, 0, len(results))
+	for _, result := range results {
+		model, diags := flattenMetricResult(ctx, result)
+		resp.Diagnostics.Append(diags...)
+		data.Results = append(data.Results, model)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// input returns the GetMetricDataV2 input of the configuration, without the
+// instance ARN.
+func (m MetricDataV2DataSourceModel) input(ctx context.Context) (*connect.GetMetricDataV2Input, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	input := &connect.GetMetricDataV2Input{}
+
+	for _, attribute := range []struct {
+		name   string
+		value  types.String
+		target **time.Time
+	}{
+		{"start_time", m.StartTime, &input.StartTime},
+		{"end_time", m.EndTime, &input.EndTime},
+	} {
+		parsed, err := time.Parse(time.RFC3339, attribute.value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root(attribute.name), "Invalid Timestamp", fmt.Sprintf("%q is not an RFC3339 timestamp: %s", attribute.value.ValueString(), err))
+			continue
+		}
+
+		*attribute.target = aws.Time(parsed)
+	}
+
+	if !m.IntervalPeriod.IsNull() || !m.TimeZone.IsNull() {
+		input.Interval = &conntypes.IntervalDetails{
+			IntervalPeriod: conntypes.IntervalPeriod(m.IntervalPeriod.ValueString()),
+			TimeZone:       m.TimeZone.ValueStringPointer(),
+		}
+	}
+
+	for _, filter := range m.Filters {
+		var values []string
+		diags.Append(filter.Values.ElementsAs(ctx, &values, false)...)
+
+		input.Filters = append(input.Filters, conntypes.FilterV2{
+			FilterKey:    aws.String(filter.Key.ValueString()),
+			FilterValues: values,
+		})
+	}
+
+	diags.Append(m.Groupings.ElementsAs(ctx, &input.Groupings, false)...)
+
+	for _, metric := range m.Metrics {
+		metricInput := conntypes.MetricV2{
+			Name: aws.String(metric.Name.ValueString()),
+		}
+
+		if metric.Threshold != nil {
+			metricInput.Threshold = []conntypes.ThresholdV2{{
+				Comparison:     aws.String(metric.Threshold.Comparison.ValueString()),
+				ThresholdValue: metric.Threshold.Value.ValueFloat64Pointer(),
+			}}
+		}
+
+		input.Metrics = append(input.Metrics, metricInput)
+	}
+
+	return input, diags
+}
+
+// flattenMetricResult converts a metric result of GetMetricDataV2, keying its
+// values by metric name.
+func flattenMetricResult(ctx context.Context, result conntypes.MetricResultV2) (MetricResultModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := MetricResultModel{
+		IntervalStart: types.StringNull(),
+		IntervalEnd:   types.StringNull(),
+	}
+
+	if result.MetricInterval != nil {
+		if result.MetricInterval.StartTime != nil {
+			model.IntervalStart = types.StringValue(result.MetricInterval.StartTime.Format(time.RFC3339))
+		}
+
+		if result.MetricInterval.EndTime != nil {
+			model.IntervalEnd = types.StringValue(result.MetricInterval.EndTime.Format(time.RFC3339))
+		}
+	}
+
+	dimensions, d := types.MapValueFrom(ctx, types.StringType, result.Dimensions)
+	diags.Append(d...)
+	model.Dimensions = dimensions
+
+	values := make(map[string]attr.Value, len(result.Collections))
+	for _, collection := range result.Collections {
+		if collection.Metric == nil {
+			continue
+		}
+
+		values[aws.ToString(collection.Metric.Name)] = types.Float64PointerValue(collection.Value)
+	}
+
+	metrics, d := types.MapValue(types.Float64Type, values)
+	diags.Append(d...)
+	model.Metrics = metrics
+
+	return model, diags
+}
+
+func getMetricDataV2(conn *connect.Client, input *connect.GetMetricDataV2Input, maxResults int64) pageLister[conntypes.MetricResultV2] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.MetricResultV2, *string, error) {
+		pageInput := *input
+		pageInput.MaxResults = pageSize(maxResults, 100)
+		pageInput.NextToken = nextToken
+
+		response, err := conn.GetMetricDataV2(ctx, &pageInput)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.MetricResults, response.NextToken, nil
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// awsProviderSource is the registry source, without hostname, of the primary
+// AWS provider whose resources can be moved to their awsext equivalents.
+const awsProviderSource = "hashicorp/aws"
+
+// connectStateMover returns a StateMover for moved blocks migrating the
+// hashicorp/aws resource sourceTypeName, e.g. aws_connect_queue, to an instance
+// scoped Connect resource with the ID attribute idAttribute.
+//
+// The source state is read from its raw JSON so the mover does not depend on
+// the schema version of the AWS provider. The instance and resource IDs are
+// taken from the <instance_id>:<resource_id> id of the source, and attributes
+// with the same name and a compatible type are carried over. Everything else
+// is populated by the refresh following the move.
+func connectStateMover(sourceTypeName, idAttribute string) resource.StateMover {
+	return moveConnectState(sourceTypeName, idAttribute, func(id string) (map[string]string, bool) {
+		instanceID, resourceID, ok := strings.Cut(id, ":")
+		if !ok || instanceID == "" || resourceID == "" {
+			return nil, false
+		}
+
+		return map[string]string{"instance_id": instanceID, idAttribute: resourceID}, true
+	}, "<instance_id>:<"+idAttribute+">")
+}
+
+// connectUnscopedStateMover returns a StateMover like connectStateMover for
+// the hashicorp/aws resources whose id is the ID of the resource alone, e.g.
+// aws_connect_phone_number or aws_connect_instance.
+func connectUnscopedStateMover(sourceTypeName, idAttribute string) resource.StateMover {
+	return moveConnectState(sourceTypeName, idAttribute, func(id string) (map[string]string, bool) {
+		return map[string]string{idAttribute: id}, id != ""
+	}, "<"+idAttribute+">")
+}
+
+// moveConnectState returns the StateMover of sourceTypeName, setting the
+// attributes returned by parseID from the id of the source, described by
+// idFormat in errors.
+func moveConnectState(sourceTypeName, idAttribute string, parseID func(id string) (map[string]string, bool), idFormat string) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != sourceTypeName || !strings.HasSuffix(req.SourceProviderAddress, awsProviderSource) {
+				return
+			}
+
+			if req.SourceRawState == nil {
+				resp.Diagnostics.AddError("Unable to Move Resource State", "The source state of "+sourceTypeName+" is missing.")
+				return
+			}
+
+			var source map[string]any
+			if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+				resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("Could not decode the source state of %s: %s", sourceTypeName, err))
+				return
+			}
+
+			id, _ := source["id"].(string)
+			ids, ok := parseID(id)
+			if !ok {
+				resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("Expected the id of %s to have the format %s, got: %q", sourceTypeName, idFormat, id))
+				return
+			}
+
+			for name, value := range ids {
+				source[name] = value
+			}
+
+			for name, attribute := range resp.TargetState.Schema.GetAttributes() {
+				value, ok := convertMovedAttribute(attribute.GetType(), source[name])
+				if !ok {
+					continue
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root(name), value)...)
+			}
+
+			if resp.TargetIdentity == nil {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.TargetIdentity.SetAttribute(ctx, path.Root(idAttribute), ids[idAttribute])...)
+			if resourceArn, ok := source["arn"].(string); ok {
+				resp.Diagnostics.Append(resp.TargetIdentity.SetAttribute(ctx, path.Root("arn"), resourceArn)...)
+			}
+		},
+	}
+}
+
+// convertMovedAttribute converts a value decoded from a raw JSON state to the
+// type of the target attribute, reporting whether it could be converted.
+func convertMovedAttribute(target attr.Type, value any) (any, bool) {
+	// Also converts the custom string types, e.g. NullableStringType
+	if _, ok := target.(basetypes.StringTypable); ok {
+		v, ok := value.(string)
+		return v, ok
+	}
+
+	switch target {
+	case types.BoolType:
+		v, ok := value.(bool)
+		return v, ok
+	case types.Int32Type:
+		v, ok := value.(float64)
+		return int32(v), ok
+	case types.Int64Type:
+		v, ok := value.(float64)
+		return int64(v), ok
+	}
+
+	if mapType, ok := target.(types.MapType); ok && mapType.ElemType == types.StringType {
+		values, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		converted := make(map[string]string, len(values))
+		for k, v := range values {
+			if converted[k], ok = v.(string); !ok {
+				return nil, false
+			}
+		}
+
+		return converted, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// moveState runs the first state mover of r accepting a raw source state of
+// sourceTypeName from the hashicorp/aws provider, like Terraform for a moved
+// block.
+func moveState(t *testing.T, r resource.ResourceWithMoveState, sourceTypeName, rawState string) *resource.MoveStateResponse {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: schemaResponse.Schema,
+			Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	if withIdentity, ok := r.(resource.ResourceWithIdentity); ok {
+		var identityResponse resource.IdentitySchemaResponse
+		withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResponse)
+
+		resp.TargetIdentity = &tfsdk.ResourceIdentity{
+			Schema: identityResponse.IdentitySchema,
+			Raw:    tftypes.NewValue(identityResponse.IdentitySchema.Type().TerraformType(ctx), nil),
+		}
+	}
+
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+		SourceTypeName:        sourceTypeName,
+		SourceSchemaVersion:   0,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+	}
+
+	for _, mover := range r.MoveState(ctx) {
+		mover.StateMover(ctx, req, resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			break
+		}
+	}
+
+	return resp
+}
+
+func TestQueueMoveState(t *testing.T) {
+	ctx := context.Background()
+
+	resp := moveState(t, &QueueResource{}, "aws_connect_queue", `{
+		"id": "aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012",
+		"arn": "arn:aws:connect:us-east-1:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/queue/12345678-1234-1234-1234-123456789012",
+		"instance_id": "aaaaaaaa-bbbb-cccc-dddd-111111111111",
+		"queue_id": "12345678-1234-1234-1234-123456789012",
+		"name": "Sales",
+		"description": "Sales queue",
+		"hours_of_operation_id": "hours",
+		"max_contacts": 10,
+		"outbound_caller_config": [{"outbound_caller_id_name": "Sales", "outbound_caller_id_number_id": "", "outbound_flow_id": ""}],
+		"quick_connect_ids": [],
+		"status": "ENABLED",
+		"tags": {"team": "sales"},
+		"tags_all": {"team": "sales"}
+	}`)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var data QueueResourceModel
+	if diags := resp.TargetState.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	for name, test := range map[string]struct{ got, want string }{
+		"instance_id": {data.InstanceID.ValueString(), "aaaaaaaa-bbbb-cccc-dddd-111111111111"},
+		"queue_id":    {data.QueueID.ValueString(), "12345678-1234-1234-1234-123456789012"},
+		"name":        {data.Name.ValueString(), "Sales"},
+		"description": {data.Description.ValueString(), "Sales queue"},
+		"status":      {data.Status.ValueString(), "ENABLED"},
+	} {
+		if test.got != test.want {
+			t.Errorf("%s: got %q, want %q", name, test.got, test.want)
+		}
+	}
+
+	if data.MaxContacts.ValueInt32() != 10 {
+		t.Errorf("max_contacts: got %s, want 10", data.MaxContacts)
+	}
+
+	// Blocks of the AWS provider are left to the refresh following the move
+	if data.OutboundCallerConfig != nil {
+		t.Errorf("outbound_caller_config: got %v, want null", data.OutboundCallerConfig)
+	}
+
+	var queueID types.String
+	resp.Diagnostics.Append(resp.TargetIdentity.GetAttribute(ctx, path.Root("queue_id"), &queueID)...)
+	if queueID.ValueString() != data.QueueID.ValueString() {
+		t.Errorf("identity queue_id: got %s, want %s", queueID, data.QueueID)
+	}
+}
+
+func TestConnectStateMovers(t *testing.T) {
+	tests := map[string]struct {
+		resource       resource.ResourceWithMoveState
+		sourceTypeName string
+		rawState       string
+		wantError      bool
+		wantMoved      bool
+	}{
+		"moves an unscoped id": {
+			resource:       &PhoneNumberResource{},
+			sourceTypeName: "aws_connect_phone_number",
+			rawState:       `{"id": "12345678-1234-1234-1234-123456789012", "phone_number": "+12065550100", "status": [{"message": "", "status": "CLAIMED"}]}`,
+			wantMoved:      true,
+		},
+		"fails on an id without instance": {
+			resource:       &QueueResource{},
+			sourceTypeName: "aws_connect_queue",
+			rawState:       `{"id": "12345678-1234-1234-1234-123456789012"}`,
+			wantError:      true,
+		},
+		"fails on an invalid state": {
+			resource:       &QueueResource{},
+			sourceTypeName: "aws_connect_queue",
+			rawState:       `[`,
+			wantError:      true,
+		},
+		"ignores other resource types": {
+			resource:       &QueueResource{},
+			sourceTypeName: "aws_connect_routing_profile",
+			rawState:       `{"id": "instance:routing-profile"}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := moveState(t, test.resource, test.sourceTypeName, test.rawState)
+
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Errorf("got diagnostics %v, want error %t", resp.Diagnostics, test.wantError)
+			}
+
+			if !resp.TargetState.Raw.IsNull() != test.wantMoved {
+				t.Errorf("got state %s, want moved %t", resp.TargetState.Raw, test.wantMoved)
+			}
+		})
+	}
+}
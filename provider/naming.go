@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	// namePrefixAttributeName is the name of the attribute generating unique
+	// names of named resources, mutually exclusive with name.
+	namePrefixAttributeName = "name_prefix"
+
+	// defaultNamePrefix prefixes generated names when neither name nor
+	// name_prefix is set.
+	defaultNamePrefix = "terraform-"
+
+	// uniqueSuffixLength is the length of the suffix of generated names: a
+	// timestamp with 1/10000 second precision and a counter.
+	uniqueSuffixLength = 26
+)
+
+// uniqueNameCounter disambiguates names generated in the same instant.
+var uniqueNameCounter atomic.Uint32
+
+// nameAttribute returns the schema of the name attribute of resources
+// supporting name_prefix, with names of at most maxLength characters. Names
+// are generated when not set, and kept until name_prefix changes.
+func nameAttribute(maxLength int) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Name of the resource. A unique name is generated if neither name nor name_prefix is set.",
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, maxLength),
+			stringvalidator.ConflictsWith(path.MatchRoot(namePrefixAttributeName)),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// namePrefixAttribute returns the schema of the name_prefix attribute of
+// resources whose names are at most maxLength characters.
+func namePrefixAttribute(maxLength int) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Creates a unique name beginning with the prefix, e.g. for blue/green rollouts. Conflicts with name.",
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, maxLength-uniqueSuffixLength),
+			stringvalidator.ConflictsWith(path.MatchRoot("name")),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// uniqueName returns a name beginning with prefix that is not taken, so two
+// configurations creating resources with the same prefix never collide.
+func uniqueName(prefix string, taken func(name string) bool) string {
+	for {
+		timestamp := strings.ReplaceAll(time.Now().UTC().Format("20060102150405.0000"), ".", "")
+		name := fmt.Sprintf("%s%s%08x", prefix, timestamp, uniqueNameCounter.Add(1))
+
+		if !taken(name) {
+			return name
+		}
+	}
+}
+
+// truncateRunes returns the first maxLength characters of s.
+func truncateRunes(s string, maxLength int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s
+	}
+
+	return string(runes[:maxLength])
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = NullableStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = NullableString{}
+)
+
+// NullableStringType is the type of optional strings, e.g. descriptions, that
+// the API returns as an empty string or omits when they are not set.
+type NullableStringType struct {
+	basetypes.StringType
+}
+
+func (t NullableStringType) String() string {
+	return "NullableStringType"
+}
+
+func (t NullableStringType) Equal(o attr.Type) bool {
+	other, ok := o.(NullableStringType)
+
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t NullableStringType) ValueType(ctx context.Context) attr.Value {
+	return NullableString{}
+}
+
+func (t NullableStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return NullableString{StringValue: in}, nil
+}
+
+func (t NullableStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return NullableString{StringValue: stringValue}, nil
+}
+
+// NullableString is a string in which null and empty are the same value.
+type NullableString struct {
+	basetypes.StringValue
+}
+
+func (v NullableString) Type(ctx context.Context) attr.Type {
+	return NullableStringType{}
+}
+
+func (v NullableString) Equal(o attr.Value) bool {
+	other, ok := o.(NullableString)
+
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both strings are empty or null, or
+// have the same value.
+func (v NullableString) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(NullableString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	if v.IsUnknown() || newValue.IsUnknown() {
+		return false, diags
+	}
+
+	return v.ValueString() == newValue.ValueString(), diags
+}
+
+// flattenNullableString returns the value of a string returned by the API,
+// keeping the prior value when they are semantically equal so a null or empty
+// configuration stays as written.
+func flattenNullableString(ctx context.Context, prior NullableString, value *string) NullableString {
+	flattened := NullableString{StringValue: basetypes.NewStringValue(aws.ToString(value))}
+	if value == nil || *value == "" {
+		flattened = NullableString{StringValue: basetypes.NewStringNull()}
+	}
+
+	if equal, _ := prior.StringSemanticEquals(ctx, flattened); equal {
+		return prior
+	}
+
+	return flattened
+}
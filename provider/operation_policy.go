@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OperationPolicyModel describes an entry of the operation_policies map of
+// the provider, keyed by resource type without the provider prefix, e.g.
+// connect_agent_status.
+type OperationPolicyModel struct {
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	Timeout     types.String `tfsdk:"timeout"`
+}
+
+// operationPolicy overrides the retry budget and the default operation
+// timeout of the resources of one type.
+type operationPolicy struct {
+	maxAttempts int
+	timeout     time.Duration
+}
+
+// newOperationPolicies parses the operation_policies of the provider.
+func newOperationPolicies(models map[string]OperationPolicyModel) (map[string]operationPolicy, error) {
+	policies := make(map[string]operationPolicy, len(models))
+
+	for resourceType, model := range models {
+		policy := operationPolicy{
+			maxAttempts: int(model.MaxAttempts.ValueInt64()),
+		}
+
+		if model.Timeout.ValueString() != "" {
+			timeout, err := time.ParseDuration(model.Timeout.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("invalid timeout of %s: %w", resourceType, err)
+			}
+
+			policy.timeout = timeout
+		}
+
+		policies[resourceType] = policy
+	}
+
+	return policies, nil
+}
+
+// defaultTimeout returns the timeout of an operation on a resource type when
+// its timeouts block does not set one: the timeout of the operation policy of
+// the type, or fallback.
+func (p *ProviderData) defaultTimeout(resourceType string, fallback time.Duration) time.Duration {
+	if policy, ok := p.operationPolicies[resourceType]; ok && policy.timeout > 0 {
+		return policy.timeout
+	}
+
+	return fallback
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OverrideModel describes the override block of resources, which manages a
+// resource with other credentials or in another region than the provider's,
+// e.g. when the Connect instance lives in another account than the rest of
+// the stack.
+type OverrideModel struct {
+	RoleArn types.String `tfsdk:"role_arn"`
+	Region  types.String `tfsdk:"region"`
+}
+
+// overrideBlock returns the override block shared by all resources.
+func overrideBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		Description: "Manage the resource with another role or in another region than the provider's",
+		Attributes: map[string]schema.Attribute{
+			"role_arn": schema.StringAttribute{
+				Optional:    true,
+				Description: "ARN of the role to assume with the provider's credentials",
+				Validators: []validator.String{
+					validArn("iam"),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Region of the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// awsConfig returns the AWS config of a resource with the given override
+// block, which may be nil. Assumed role credentials are cached per role so
+// resources sharing an override do not each assume the role.
+func (p *ProviderData) awsConfig(override *OverrideModel) aws.Config {
+	if override == nil {
+		return p.Config
+	}
+
+	cfg := p.Config.Copy()
+
+	if override.Region.ValueString() != "" {
+		cfg.Region = override.Region.ValueString()
+	}
+
+	if roleArn := override.RoleArn.ValueString(); roleArn != "" {
+		p.assumedRolesMu.Lock()
+		defer p.assumedRolesMu.Unlock()
+
+		if p.assumedRoles == nil {
+			p.assumedRoles = map[string]aws.CredentialsProvider{}
+		}
+
+		if _, ok := p.assumedRoles[roleArn]; !ok {
+			p.assumedRoles[roleArn] = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(p.stsClient(), roleArn))
+		}
+
+		cfg.Credentials = p.assumedRoles[roleArn]
+	}
+
+	return cfg
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// maxResultsAttribute returns the schema of the max_results attribute shared
+// by plural data sources.
+func maxResultsAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Description: "Maximum number of results to return. All results are returned if unset.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// collectPages walks the pages of list and returns the items for which keep
+// returns true, or all items if keep is nil, stopping after maxResults items
+// if it is positive.
+//
+// Without maxResults, all pages are needed, so the next page is fetched while
+// the items of the current one are collected, as in streamListResults. With
+// maxResults, pages are fetched one after another so no page past the last
+// result is requested.
+func collectPages[S any](ctx context.Context, list pageLister[S], maxResults int64, keep func(S) bool) ([]S, error) {
+	results := []S{}
+
+	// collect adds the items kept and reports whether maxResults is reached
+	collect := func(items []S) bool {
+		for _, item := range items {
+			if keep != nil && !keep(item) {
+				continue
+			}
+
+			results = append(results, item)
+
+			if maxResults > 0 && int64(len(results)) >= maxResults {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if maxResults <= 0 {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		pages := make(chan listPage[S], 1)
+		go fetchPages(ctx, list, pages)
+
+		for page := range pages {
+			if page.err != nil {
+				return nil, page.err
+			}
+
+			collect(page.items)
+		}
+
+		return results, nil
+	}
+
+	var nextToken *string
+	for {
+		items, next, err := list(ctx, nextToken)
+		if err != nil {
+			return nil, err
+		}
+
+		if collect(items) || next == nil {
+			return results, nil
+		}
+
+		nextToken = next
+	}
+}
+
+// pageSize returns the page size to request from an API accepting at most
+// limit results per page, so no more than maxResults items are fetched when
+// it is positive.
+func pageSize(maxResults int64, limit int32) *int32 {
+	if maxResults <= 0 || maxResults >= int64(limit) {
+		return aws.Int32(limit)
+	}
+
+	return aws.Int32(int32(maxResults))
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// fakePages returns a pageLister of pages, failing with err on the page at
+// failAt if err is set.
+func fakePages(pages [][]int, failAt int, err error) (pageLister[int], *int) {
+	calls := 0
+
+	return func(ctx context.Context, nextToken *string) ([]int, *string, error) {
+		calls++
+
+		page := 0
+		if nextToken != nil {
+			page, _ = strconv.Atoi(*nextToken)
+		}
+
+		if err != nil && page == failAt {
+			return nil, nil, err
+		}
+
+		var next *string
+		if page+1 < len(pages) {
+			next = aws.String(strconv.Itoa(page + 1))
+		}
+
+		return pages[page], next, nil
+	}, &calls
+}
+
+func TestCollectPages(t *testing.T) {
+	pages := [][]int{{1, 2, 3}, {4, 5}, {6}}
+	odd := func(i int) bool { return i%2 == 1 }
+	listError := errors.New("throttled")
+
+	tests := map[string]struct {
+		maxResults int64
+		keep       func(int) bool
+		err        error
+		want       []int
+		wantCalls  int
+		wantErr    error
+	}{
+		"collects all pages": {
+			want:      []int{1, 2, 3, 4, 5, 6},
+			wantCalls: 3,
+		},
+		"stops listing at max_results": {
+			maxResults: 4,
+			want:       []int{1, 2, 3, 4},
+			wantCalls:  2,
+		},
+		"counts only kept items towards max_results": {
+			maxResults: 3,
+			keep:       odd,
+			want:       []int{1, 3, 5},
+			wantCalls:  2,
+		},
+		"returns an empty list without matches": {
+			keep:      func(int) bool { return false },
+			want:      []int{},
+			wantCalls: 3,
+		},
+		"fails on an error on a later page": {
+			err:       listError,
+			wantCalls: 2,
+			wantErr:   listError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			list, calls := fakePages(pages, 1, test.err)
+
+			got, err := collectPages(context.Background(), list, test.maxResults, test.keep)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got error %v, want %v", err, test.wantErr)
+			}
+
+			if !slices.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+
+			if *calls != test.wantCalls {
+				t.Errorf("got %d calls, want %d", *calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCollectPagesPrefetch(t *testing.T) {
+	pages := [][]int{{1}, {2}}
+	requested := make(chan struct{})
+
+	list := func(ctx context.Context, nextToken *string) ([]int, *string, error) {
+		if nextToken == nil {
+			return pages[0], aws.String("1"), nil
+		}
+
+		close(requested)
+		return pages[1], nil, nil
+	}
+
+	// The items of the first page are only kept once the second page was
+	// requested, which deadlocks unless it is fetched ahead
+	keep := func(i int) bool {
+		if i == 1 {
+			select {
+			case <-requested:
+			case <-time.After(5 * time.Second):
+				t.Error("the next page was not fetched while collecting the current one")
+			}
+		}
+
+		return true
+	}
+
+	got, err := collectPages(context.Background(), list, 0, keep)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("got %v, want [1 2]", got)
+	}
+}
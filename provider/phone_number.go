@@ -0,0 +1,564 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &PhoneNumberResource{}
+var _ resource.ResourceWithImportState = &PhoneNumberResource{}
+var _ resource.ResourceWithModifyPlan = &PhoneNumberResource{}
+var _ resource.ResourceWithMoveState = &PhoneNumberResource{}
+var _ resource.ResourceWithIdentity = &PhoneNumberResource{}
+
+// phoneNumberResourceType is the type of the phone number resource without
+// the provider prefix, e.g. in operation_policies.
+const phoneNumberResourceType = "connect_phone_number"
+
+func NewPhoneNumberResource() resource.Resource {
+	return &PhoneNumberResource{}
+}
+
+type PhoneNumberResource struct {
+	providerData *ProviderData
+}
+
+type PhoneNumberResourceModel struct {
+	Arn                types.String   `tfsdk:"arn"`
+	PhoneNumberID      types.String   `tfsdk:"phone_number_id"`
+	InstanceID         types.String   `tfsdk:"instance_id"`
+	InstanceAlias      types.String   `tfsdk:"instance_alias"`
+	PhoneNumber        types.String   `tfsdk:"phone_number"`
+	Description        NullableString `tfsdk:"description"`
+	ContactFlowID      types.String   `tfsdk:"contact_flow_id"`
+	CountryCode        types.String   `tfsdk:"country_code"`
+	Type               types.String   `tfsdk:"type"`
+	Status             types.String   `tfsdk:"status"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Tags               types.Map      `tfsdk:"tags"`
+	TagsAll            types.Map      `tfsdk:"tags_all"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Override           *OverrideModel `tfsdk:"override"`
+}
+
+type PhoneNumberResourceIdentityModel struct {
+	Arn           types.String `tfsdk:"arn"`
+	PhoneNumberID types.String `tfsdk:"phone_number_id"`
+}
+
+func (m PhoneNumberResourceModel) identity() PhoneNumberResourceIdentityModel {
+	return PhoneNumberResourceIdentityModel{
+		Arn:           m.Arn,
+		PhoneNumberID: m.PhoneNumberID,
+	}
+}
+
+func (r *PhoneNumberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + phoneNumberResourceType
+}
+
+func (r *PhoneNumberResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = connectUnscopedIdentitySchema("phone_number_id")
+}
+
+func (r *PhoneNumberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Claims a phone number to a Connect instance, waiting for the claim to complete, and releases it on destroy",
+
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"phone_number_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"phone_number": schema.StringAttribute{
+				Required:    true,
+				Description: "Phone number to claim in E.164 format, e.g. +12065550100, as returned by SearchAvailablePhoneNumbers. Changing it releases the phone number and claims the new one.",
+				Validators: []validator.String{
+					validE164(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				CustomType: NullableStringType{},
+				Optional:   true,
+				Validators: []validator.String{
+					// Empty is allowed and the same as no description
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"contact_flow_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the flow run for inbound calls to the phone number.",
+			},
+			"country_code": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the phone number, e.g. DID or TOLL_FREE.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the claim of the phone number, CLAIMED once it completed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			deletionProtectionAttributeName: deletionProtectionAttribute(),
+			"tags":                          tagsAttribute(),
+			"tags_all":                      tagsAllAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *PhoneNumberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *PhoneNumberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanTags(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *PhoneNumberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(phoneNumberResourceType, resp) {
+		return
+	}
+
+	var data PhoneNumberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	tagsAll, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(phoneNumberResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(phoneNumberResourceType, data.Override)
+	input := &connect.ClaimPhoneNumberInput{
+		InstanceId:  aws.String(data.InstanceID.ValueString()),
+		PhoneNumber: aws.String(data.PhoneNumber.ValueString()),
+	}
+
+	if data.Description.ValueString() != "" {
+		input.PhoneNumberDescription = aws.String(data.Description.ValueString())
+	}
+
+	if len(tagsAll) > 0 {
+		input.Tags = tagsAll
+	}
+
+	response, err := conn.ClaimPhoneNumber(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error claiming Connect Phone Number", fmt.Sprintf("Could not claim phone number %s", data.PhoneNumber.ValueString()), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	data.PhoneNumberID = types.StringValue(aws.ToString(response.PhoneNumberId))
+	data.Arn = types.StringValue(aws.ToString(response.PhoneNumberArn))
+	data.CountryCode = types.StringNull()
+	data.Type = types.StringNull()
+	data.Status = types.StringNull()
+
+	// The phone number is saved while being claimed, so that it is released
+	// on destroy if the claim does not complete
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+
+	number, err := waitForPhoneNumberClaim(ctx, conn, data.PhoneNumberID.ValueString())
+
+	if number != nil {
+		data.flatten(ctx, number)
+		resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for Connect Phone Number", fmt.Sprintf("Phone number %s was not claimed: %s", data.PhoneNumber.ValueString(), err))
+		return
+	}
+
+	if !data.ContactFlowID.IsNull() {
+		_, err := conn.AssociatePhoneNumberContactFlow(ctx, &connect.AssociatePhoneNumberContactFlowInput{
+			InstanceId:    aws.String(data.InstanceID.ValueString()),
+			PhoneNumberId: aws.String(data.PhoneNumberID.ValueString()),
+			ContactFlowId: aws.String(data.ContactFlowID.ValueString()),
+		})
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error associating Connect Phone Number", fmt.Sprintf("Could not associate phone number %s with flow %s", data.PhoneNumber.ValueString(), data.ContactFlowID.ValueString()), err))
+
+			// The phone number is claimed but not associated
+			data.ContactFlowID = types.StringNull()
+			resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+			return
+		}
+	}
+
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *PhoneNumberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PhoneNumberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(phoneNumberResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(phoneNumberResourceType, data.Override)
+	response, err := conn.DescribePhoneNumber(ctx, &connect.DescribePhoneNumberInput{
+		PhoneNumberId: aws.String(data.PhoneNumberID.ValueString()),
+	})
+
+	// Released phone numbers may still be described for a while, but are no
+	// longer claimed to the instance
+	if isNotFound(err) || (err == nil && response.ClaimedPhoneNumberSummary == nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Phone Number", "Could not read Connect Phone Number", err))
+		return
+	}
+
+	number := response.ClaimedPhoneNumberSummary
+	data.flatten(ctx, number)
+
+	// Imported phone numbers have no deletion protection in state
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
+	// The association is only read when managed, listing the flow
+	// associations of the whole instance
+	if !data.ContactFlowID.IsNull() {
+		flowID, err := findPhoneNumberContactFlow(ctx, conn, data.InstanceID.ValueString(), number)
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error reading Connect Phone Number", "Could not list the flow associations of the instance", err))
+			return
+		}
+
+		data.ContactFlowID = flowID
+	}
+
+	data.Tags, data.TagsAll, diags = r.providerData.readTags(ctx, number.Tags, data.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *PhoneNumberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, phoneNumberResourceType, req, resp) {
+		return
+	}
+
+	var data, state PhoneNumberResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(phoneNumberResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(phoneNumberResourceType, data.Override)
+
+	if !data.Description.Equal(state.Description) {
+		_, err := conn.UpdatePhoneNumberMetadata(ctx, &connect.UpdatePhoneNumberMetadataInput{
+			PhoneNumberId:          aws.String(data.PhoneNumberID.ValueString()),
+			PhoneNumberDescription: aws.String(data.Description.ValueString()),
+		})
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Phone Number", "Could not update the description of Connect Phone Number", err))
+			return
+		}
+
+		// Metadata updates go through the same workflow as claims
+		if _, err := waitForPhoneNumberClaim(ctx, conn, data.PhoneNumberID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error waiting for Connect Phone Number", fmt.Sprintf("Phone number %s was not updated: %s", data.PhoneNumber.ValueString(), err))
+			return
+		}
+	}
+
+	if !data.ContactFlowID.Equal(state.ContactFlowID) {
+		var err error
+
+		if data.ContactFlowID.IsNull() {
+			_, err = conn.DisassociatePhoneNumberContactFlow(ctx, &connect.DisassociatePhoneNumberContactFlowInput{
+				InstanceId:    aws.String(data.InstanceID.ValueString()),
+				PhoneNumberId: aws.String(data.PhoneNumberID.ValueString()),
+			})
+		} else {
+			_, err = conn.AssociatePhoneNumberContactFlow(ctx, &connect.AssociatePhoneNumberContactFlowInput{
+				InstanceId:    aws.String(data.InstanceID.ValueString()),
+				PhoneNumberId: aws.String(data.PhoneNumberID.ValueString()),
+				ContactFlowId: aws.String(data.ContactFlowID.ValueString()),
+			})
+		}
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Phone Number", "Could not update the flow of Connect Phone Number", err))
+			return
+		}
+	}
+
+	if !data.TagsAll.Equal(state.TagsAll) {
+		oldTags, diags := mapFromTags(ctx, state.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		newTags, diags := mapFromTags(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), oldTags, newTags); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Phone Number tags", "Could not update Connect Phone Number tags", err))
+			return
+		}
+	}
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *PhoneNumberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(phoneNumberResourceType, resp) {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDeletionProtection(ctx, req.State, "awsext_connect_phone_number")...)
+
+	var data PhoneNumberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(phoneNumberResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(phoneNumberResourceType, data.Override)
+
+	// A claim still in progress, e.g. after a create timeout, cannot be
+	// released
+	if _, err := waitForPhoneNumberClaim(ctx, conn, data.PhoneNumberID.ValueString()); err != nil && !isNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Releasing phone number %s not claimed: %s", data.PhoneNumber.ValueString(), err))
+	}
+
+	_, err := conn.ReleasePhoneNumber(ctx, &connect.ReleasePhoneNumberInput{
+		PhoneNumberId: aws.String(data.PhoneNumberID.ValueString()),
+	})
+
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error releasing Connect Phone Number", fmt.Sprintf("Could not release phone number %s", data.PhoneNumber.ValueString()), err))
+		return
+	}
+
+	err = waitFor(ctx, defaultPollInterval, func(ctx context.Context) (bool, error) {
+		response, err := conn.DescribePhoneNumber(ctx, &connect.DescribePhoneNumberInput{
+			PhoneNumberId: aws.String(data.PhoneNumberID.ValueString()),
+		})
+		if isNotFound(err) {
+			return true, nil
+		}
+
+		return err == nil && response.ClaimedPhoneNumberSummary == nil, err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for Connect Phone Number", fmt.Sprintf("Phone number %s was not released: %s", data.PhoneNumber.ValueString(), err))
+	}
+}
+
+func (r *PhoneNumberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importUnscopedConnectResource(ctx, req, resp, "phone-number", "phone_number_id")
+}
+
+// MoveState migrates the state of aws_connect_phone_number in moved blocks.
+func (r *PhoneNumberResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{connectUnscopedStateMover("aws_connect_phone_number", "phone_number_id")}
+}
+
+// flatten sets the attributes of the model read from a claimed phone number.
+func (m *PhoneNumberResourceModel) flatten(ctx context.Context, number *conntypes.ClaimedPhoneNumberSummary) {
+	m.Arn = types.StringValue(aws.ToString(number.PhoneNumberArn))
+	m.InstanceID = types.StringValue(aws.ToString(number.InstanceId))
+	m.PhoneNumber = types.StringValue(aws.ToString(number.PhoneNumber))
+	m.Description = flattenNullableString(ctx, m.Description, number.PhoneNumberDescription)
+	m.CountryCode = types.StringValue(string(number.PhoneNumberCountryCode))
+	m.Type = types.StringValue(string(number.PhoneNumberType))
+	m.Status = types.StringNull()
+
+	if number.PhoneNumberStatus != nil {
+		m.Status = types.StringValue(string(number.PhoneNumberStatus.Status))
+	}
+}
+
+// waitForPhoneNumberClaim polls a phone number until its claim, port or
+// update is no longer in progress, and returns it. It fails if the workflow
+// failed.
+func waitForPhoneNumberClaim(ctx context.Context, conn *connect.Client, phoneNumberID string) (*conntypes.ClaimedPhoneNumberSummary, error) {
+	var number *conntypes.ClaimedPhoneNumberSummary
+
+	err := waitFor(ctx, defaultPollInterval, func(ctx context.Context) (bool, error) {
+		response, err := conn.DescribePhoneNumber(ctx, &connect.DescribePhoneNumberInput{
+			PhoneNumberId: aws.String(phoneNumberID),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		number = response.ClaimedPhoneNumberSummary
+		if number == nil || number.PhoneNumberStatus == nil {
+			return true, nil
+		}
+
+		switch number.PhoneNumberStatus.Status {
+		case conntypes.PhoneNumberWorkflowStatusInProgress:
+			return false, nil
+		case conntypes.PhoneNumberWorkflowStatusFailed:
+			return false, fmt.Errorf("%s", aws.ToString(number.PhoneNumberStatus.Message))
+		default:
+			return true, nil
+		}
+	})
+
+	return number, err
+}
+
+// findPhoneNumberContactFlow returns the ID of the flow associated with a
+// phone number, null if there is none.
+func findPhoneNumberContactFlow(ctx context.Context, conn *connect.Client, instanceID string, number *conntypes.ClaimedPhoneNumberSummary) (types.String, error) {
+	associations, err := collectPages(ctx, listFlowAssociations(conn, instanceID, conntypes.ListFlowAssociationResourceTypeVoicePhoneNumber), 0, func(association conntypes.FlowAssociationSummary) bool {
+		resourceID := aws.ToString(association.ResourceId)
+		return resourceID == aws.ToString(number.PhoneNumberId) || resourceID == aws.ToString(number.PhoneNumberArn)
+	})
+	if err != nil || len(associations) == 0 {
+		return types.StringNull(), err
+	}
+
+	// Flows may be identified by ARN
+	flowID := aws.ToString(associations[0].FlowId)
+	if _, _, id, err := parseConnectArn(flowID); err == nil && id != "" {
+		flowID = id
+	}
+
+	return types.StringValue(flowID), nil
+}
+
+func listFlowAssociations(conn *connect.Client, instanceID string, resourceType conntypes.ListFlowAssociationResourceType) pageLister[conntypes.FlowAssociationSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.FlowAssociationSummary, *string, error) {
+		response, err := conn.ListFlowAssociations(ctx, &connect.ListFlowAssociationsInput{
+			InstanceId:   aws.String(instanceID),
+			ResourceType: resourceType,
+			MaxResults:   aws.Int32(100),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.FlowAssociationSummaryList, response.NextToken, nil
+	}
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PhoneNumberDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &PhoneNumberDataSource{}
+
+func NewPhoneNumberDataSource() datasource.DataSource {
+	return &PhoneNumberDataSource{}
+}
+
+type PhoneNumberDataSource struct {
+	providerData *ProviderData
+}
+
+type PhoneNumberDataSourceModel struct {
+	InstanceID    types.String `tfsdk:"instance_id"`
+	InstanceAlias types.String `tfsdk:"instance_alias"`
+	PhoneNumber   types.String `tfsdk:"phone_number"`
+	Description   types.String `tfsdk:"description"`
+	Tags          types.Map    `tfsdk:"tags"`
+	PhoneNumberID types.String `tfsdk:"phone_number_id"`
+	Arn           types.String `tfsdk:"arn"`
+	TargetArn     types.String `tfsdk:"target_arn"`
+	CountryCode   types.String `tfsdk:"country_code"`
+	Type          types.String `tfsdk:"type"`
+	Status        types.String `tfsdk:"status"`
+}
+
+func (d *PhoneNumberDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_phone_number"
+}
+
+func (d *PhoneNumberDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single phone number claimed to a Connect instance by number, description or tags",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"phone_number": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Phone number in E.164 format, e.g. +12065550100.",
+				Validators: []validator.String{
+					validE164(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Description of the phone number, matched exactly.",
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Tags the phone number must have. All tags of the phone number are returned.",
+			},
+			"phone_number_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"target_arn": schema.StringAttribute{
+				Computed:    true,
+				Description: "ARN of the instance or traffic distribution group the phone number is claimed to.",
+			},
+			"country_code": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the phone number, e.g. DID or TOLL_FREE.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the phone number, e.g. CLAIMED.",
+			},
+		},
+	}
+}
+
+func (d *PhoneNumberDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("phone_number"),
+			path.MatchRoot("description"),
+			path.MatchRoot("tags"),
+		),
+	}
+}
+
+func (d *PhoneNumberDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *PhoneNumberDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PhoneNumberDataSourceModel
+	var tags map[string]string
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.providerData.connectClient(nil)
+	input := &connect.ListPhoneNumbersV2Input{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+	}
+
+	if !data.PhoneNumber.IsNull() {
+		input.PhoneNumberPrefix = aws.String(data.PhoneNumber.ValueString())
+	}
+
+	candidates, err := collectPages(ctx, listPhoneNumbersV2(conn, input), 0, func(summary conntypes.ListPhoneNumbersSummary) bool {
+		// The number is only filtered by prefix
+		return (data.PhoneNumber.IsNull() || aws.ToString(summary.PhoneNumber) == data.PhoneNumber.ValueString()) &&
+			(data.Description.IsNull() || aws.ToString(summary.PhoneNumberDescription) == data.Description.ValueString())
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Phone Numbers", "Could not list the phone numbers of the instance", err))
+		return
+	}
+
+	// Tags are not listed, so the candidates are described
+	var matches []conntypes.ClaimedPhoneNumberSummary
+	for _, candidate := range candidates {
+		response, err := conn.DescribePhoneNumber(ctx, &connect.DescribePhoneNumberInput{
+			PhoneNumberId: candidate.PhoneNumberId,
+		})
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error reading Connect Phone Number", fmt.Sprintf("Could not read phone number %s", aws.ToString(candidate.PhoneNumber)), err))
+			return
+		}
+
+		number := response.ClaimedPhoneNumberSummary
+		if number != nil && hasTags(number.Tags, tags) {
+			matches = append(matches, *number)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Connect Phone Number Not Found", fmt.Sprintf("No phone number of instance %s matches the given criteria.", data.InstanceID.ValueString()))
+		return
+	}
+
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError("Multiple Connect Phone Numbers Found", fmt.Sprintf("%d phone numbers of instance %s match the given criteria, narrow them down to a single phone number.", len(matches), data.InstanceID.ValueString()))
+		return
+	}
+
+	number := matches[0]
+
+	data.PhoneNumber = types.StringPointerValue(number.PhoneNumber)
+	data.Description = types.StringPointerValue(number.PhoneNumberDescription)
+	data.PhoneNumberID = types.StringPointerValue(number.PhoneNumberId)
+	data.Arn = types.StringPointerValue(number.PhoneNumberArn)
+	data.TargetArn = types.StringPointerValue(number.TargetArn)
+	data.CountryCode = types.StringValue(string(number.PhoneNumberCountryCode))
+	data.Type = types.StringValue(string(number.PhoneNumberType))
+	data.Status = types.StringNull()
+
+	if number.PhoneNumberStatus != nil {
+		data.Status = types.StringValue(string(number.PhoneNumberStatus.Status))
+	}
+
+	tagValues, diags := types.MapValueFrom(ctx, types.StringType, number.Tags)
+	resp.Diagnostics.Append(diags...)
+	data.Tags = tagValues
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listPhoneNumbersV2 returns the pages of the phone numbers claimed to an
+// instance or traffic distribution group.
+func listPhoneNumbersV2(conn *connect.Client, input *connect.ListPhoneNumbersV2Input) pageLister[conntypes.ListPhoneNumbersSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.ListPhoneNumbersSummary, *string, error) {
+		page := *input
+		page.MaxResults = aws.Int32(1000)
+		page.NextToken = nextToken
+
+		response, err := conn.ListPhoneNumbersV2(ctx, &page)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.ListPhoneNumbersSummaryList, response.NextToken, nil
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// modifyPlanRefresh reads the resource again at plan time when plan_refresh
+// is enabled, and warns about every attribute changed outside Terraform since
+// the state was refreshed, e.g. in the Connect console during a plan run with
+// -refresh=false. It is called from the ModifyPlan method of resources, which
+// pass themselves to be read.
+func (p *ProviderData) modifyPlanRefresh(ctx context.Context, r resource.Resource, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to refresh on create or destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || p == nil || !p.planRefresh {
+		return
+	}
+
+	readResp := &resource.ReadResponse{
+		State: tfsdk.State{
+			Schema: req.State.Schema,
+			Raw:    req.State.Raw.Copy(),
+		},
+	}
+
+	r.Read(ctx, resource.ReadRequest{State: req.State, ProviderMeta: req.ProviderMeta}, readResp)
+
+	if readResp.Diagnostics.HasError() {
+		for _, d := range readResp.Diagnostics.Errors() {
+			resp.Diagnostics.AddWarning("Plan Refresh Failed", fmt.Sprintf("Could not check for changes made outside Terraform: %s: %s", d.Summary(), d.Detail()))
+		}
+
+		return
+	}
+
+	if readResp.State.Raw.IsNull() {
+		resp.Diagnostics.AddError(
+			"Resource Deleted Outside Terraform",
+			"The resource no longer exists in AWS, it was deleted outside Terraform since the state was refreshed. Plan again with refresh enabled to plan its re-creation.",
+		)
+
+		return
+	}
+
+	diffs, err := req.State.Raw.Diff(readResp.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Error comparing state and refreshed state", err.Error())
+		return
+	}
+
+	reported := map[string]bool{}
+	for _, diff := range diffs {
+		steps := diff.Path.Steps()
+		if len(steps) == 0 {
+			continue
+		}
+
+		name, ok := steps[0].(tftypes.AttributeName)
+		if !ok || unenforcedAttributes[string(name)] || reported[string(name)] {
+			continue
+		}
+
+		reported[string(name)] = true
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(string(name)),
+			"Changed Outside Terraform",
+			fmt.Sprintf("The value of %s in AWS differs from the state, it was changed outside Terraform since the state was refreshed, e.g. in the Connect console. The plan does not account for the change, plan again with refresh enabled to include it.", name),
+		)
+	}
+}
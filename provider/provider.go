@@ -5,21 +5,32 @@ package provider
 
 import (
 	"context"
-	"time"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/aws/middleware"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,6 +38,8 @@ import (
 var _ provider.Provider = &AwsExtProvider{}
 var _ provider.ProviderWithFunctions = &AwsExtProvider{}
 var _ provider.ProviderWithEphemeralResources = &AwsExtProvider{}
+var _ provider.ProviderWithListResources = &AwsExtProvider{}
+var _ provider.ProviderWithActions = &AwsExtProvider{}
 
 // AwsExtProvider defines the provider implementation.
 type AwsExtProvider struct {
@@ -44,6 +57,49 @@ type AwsExtProviderModel struct {
 	Region    types.String `tfsdk:"region"`
 	Profile   types.String `tfsdk:"profile"`
 	RoleArn   types.String `tfsdk:"role_arn"`
+
+	SharedConfigFiles         []string   `tfsdk:"shared_config_files"`
+	SharedCredentialsFiles    []string   `tfsdk:"shared_credentials_files"`
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
+
+	UseFIPSEndpoint      types.Bool   `tfsdk:"use_fips_endpoint"`
+	UseDualstackEndpoint types.Bool   `tfsdk:"use_dualstack_endpoint"`
+	CustomCABundle       types.String `tfsdk:"custom_ca_bundle"`
+	HTTPProxy            types.String `tfsdk:"http_proxy"`
+	HTTPSProxy           types.String `tfsdk:"https_proxy"`
+
+	AssumeRole                []AssumeRoleModel               `tfsdk:"assume_role"`
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentityModel `tfsdk:"assume_role_with_web_identity"`
+
+	MaxConcurrentRequests map[string]int64 `tfsdk:"max_concurrent_requests"`
+	MaxRetries            types.Int64      `tfsdk:"max_retries"`
+	RetryMode             types.String     `tfsdk:"retry_mode"`
+	OtelTracesEndpoint    types.String     `tfsdk:"otel_traces_endpoint"`
+	ImportOnExists        types.Bool       `tfsdk:"import_on_exists"`
+	ValidateReferences    types.Bool       `tfsdk:"validate_references"`
+	BatchRefresh          types.Bool       `tfsdk:"batch_refresh"`
+	PlanRefresh           types.Bool       `tfsdk:"plan_refresh"`
+	ReadOnly              types.Bool       `tfsdk:"read_only"`
+	ReadOnlyMode          types.String     `tfsdk:"read_only_mode"`
+	LogAPICalls           types.Bool       `tfsdk:"log_api_calls"`
+	LogResponseMetadata   types.Bool       `tfsdk:"log_response_metadata"`
+
+	OperationPolicies map[string]OperationPolicyModel `tfsdk:"operation_policies"`
+
+	Endpoints   *EndpointsModel   `tfsdk:"endpoints"`
+	DefaultTags *DefaultTagsModel `tfsdk:"default_tags"`
+	IgnoreTags  *IgnoreTagsModel  `tfsdk:"ignore_tags"`
+}
+
+// DefaultTagsModel describes the default_tags block of the provider.
+type DefaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+// IgnoreTagsModel describes the ignore_tags block of the provider.
+type IgnoreTagsModel struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
 }
 
 func (p *AwsExtProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -55,30 +111,178 @@ func (p *AwsExtProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"access_key": schema.StringAttribute{
-				Description: "AWS access key",
+				Description: "AWS access key. Defaults to the AWS_ACCESS_KEY_ID environment variable",
 				Optional:    true,
 			},
 			"secret_key": schema.StringAttribute{
-				Description: "AWS secret key",
+				Description: "AWS secret key. Defaults to the AWS_SECRET_ACCESS_KEY environment variable",
 				Optional:    true,
 			},
 			"token": schema.StringAttribute{
-				Description: "AWS session token",
+				Description: "AWS session token. Defaults to the AWS_SESSION_TOKEN environment variable",
 				Optional:    true,
 			},
 			"region": schema.StringAttribute{
-				Description: "AWS region",
+				Description: "AWS region. Defaults to the AWS_REGION or AWS_DEFAULT_REGION environment variables, then to the region of the profile",
 				Optional:    true,
 			},
 			"profile": schema.StringAttribute{
-				Description: "AWS profile",
+				Description: "AWS profile. Defaults to the AWS_PROFILE environment variable",
+				Optional:    true,
+			},
+			"shared_config_files": schema.ListAttribute{
+				Description: "Paths of the shared config files, e.g. ~/.aws/config. Defaults to the AWS_CONFIG_FILE environment variable, then to ~/.aws/config",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"shared_credentials_files": schema.ListAttribute{
+				Description: "Paths of the shared credentials files, e.g. ~/.aws/credentials. Defaults to the AWS_SHARED_CREDENTIALS_FILE environment variable, then to ~/.aws/credentials",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "Skip checking the credentials with STS GetCallerIdentity when the provider is configured, e.g. for STS-less test endpoints",
 				Optional:    true,
 			},
 			"role_arn": schema.StringAttribute{
-				Description: "AWS role ARN",
+				Description: "AWS role ARN, assumed with the default session options. Conflicts with assume_role, which supports the other options",
+				Optional:    true,
+				Validators: []validator.String{
+					validArn("iam"),
+				},
+			},
+			"use_fips_endpoint": schema.BoolAttribute{
+				Description: "Call the FIPS endpoints of the AWS services, e.g. in GovCloud. Defaults to the AWS_USE_FIPS_ENDPOINT environment variable",
+				Optional:    true,
+			},
+			"use_dualstack_endpoint": schema.BoolAttribute{
+				Description: "Call the dual-stack IPv4 and IPv6 endpoints of the AWS services. Defaults to the AWS_USE_DUALSTACK_ENDPOINT environment variable",
+				Optional:    true,
+			},
+			"custom_ca_bundle": schema.StringAttribute{
+				Description: "Path of a PEM file of the certificate authorities trusted by the AWS API calls instead of the system ones, e.g. behind a TLS inspecting proxy. Defaults to the AWS_CA_BUNDLE environment variable",
+				Optional:    true,
+			},
+			"http_proxy": schema.StringAttribute{
+				Description: "URL of the proxy of the HTTP calls, e.g. to custom endpoints. Defaults to the HTTP_PROXY environment variable, excluding the NO_PROXY hosts",
+				Optional:    true,
+			},
+			"https_proxy": schema.StringAttribute{
+				Description: "URL of the proxy of the HTTPS calls, e.g. http://proxy.example.com:3128. Defaults to the HTTPS_PROXY environment variable, excluding the NO_PROXY hosts",
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.MapAttribute{
+				Description: "Maximum number of concurrent requests per AWS service, e.g. connect, shared by all resources. Defaults to 5 for connect, other services are not limited. 0 removes the limit",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.Map{
+					mapvalidator.ValueInt64sAre(int64validator.AtLeast(0)),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries of an AWS API call on throttling and transient errors, e.g. TooManyRequestsException, with jittered exponential backoff. Defaults to 19",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_mode": schema.StringAttribute{
+				Description: "Retry mode of AWS API calls: adaptive (the default) also slows down all requests to a service once it throttles, standard only retries the throttled requests",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(aws.RetryModeAdaptive), string(aws.RetryModeStandard)),
+				},
+			},
+			"operation_policies": schema.MapNestedAttribute{
+				Description: "Retry and timeout policies by resource type without the awsext_ prefix, e.g. connect_agent_status, overriding the provider defaults",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Description: "Maximum number of attempts of an API call, including retries",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"timeout": schema.StringAttribute{
+							Description: "Timeout of the operations, e.g. 5m, used when the timeouts block of a resource does not set one",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"import_on_exists": schema.BoolAttribute{
+				Description: "Adopt existing resources of the same name on create instead of erroring, for the resources supporting it whose import_on_exists is not set. Defaults to true",
+				Optional:    true,
+			},
+			"validate_references": schema.BoolAttribute{
+				Description: "Check at plan time that the Connect instances referenced by resources exist and are active, describing each instance once per plan",
+				Optional:    true,
+			},
+			"batch_refresh": schema.BoolAttribute{
+				Description: "Refresh the resources supporting it, e.g. agent statuses and queues, from one search per instance instead of a describe per resource, speeding up plans of many resources of the same type",
+				Optional:    true,
+			},
+			"plan_refresh": schema.BoolAttribute{
+				Description: "Read the Connect resources supporting it again at plan time, warning about changes made outside Terraform since the state was refreshed, e.g. in the Connect console during a plan with -refresh=false",
+				Optional:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Make no changes in AWS, e.g. to point a configuration at production for drift detection jobs. Creates, updates, destroys and actions fail, or are skipped with a warning depending on read_only_mode. Reads and data sources are unaffected",
+				Optional:    true,
+			},
+			"read_only_mode": schema.StringAttribute{
+				Description: "Behavior of changes when read_only is true: error (the default) fails them, warn skips updates, destroys and actions with a warning, only removing destroyed resources from the state. Creates always fail",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(readOnlyModeError, readOnlyModeWarn),
+				},
+			},
+			"log_api_calls": schema.BoolAttribute{
+				Description: "Log the service, operation, duration, request ID and number of retries of every AWS API call at debug level, e.g. to diagnose throttling and latency",
+				Optional:    true,
+			},
+			"log_response_metadata": schema.BoolAttribute{
+				Description: "Log the response metadata of every AWS API call at debug level, e.g. request IDs, attempts and HTTP headers, for AWS support cases. Error diagnostics always include the operation, request ID and number of attempts of the failed call",
+				Optional:    true,
+			},
+			"otel_traces_endpoint": schema.StringAttribute{
+				Description: "OpenTelemetry OTLP/HTTP endpoint receiving a span per AWS API call, e.g. http://localhost:4318. Defaults to the OTEL_EXPORTER_OTLP_TRACES_ENDPOINT and OTEL_EXPORTER_OTLP_ENDPOINT environment variables, tracing is disabled if none is set",
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"assume_role":                   assumeRoleBlock(),
+			"assume_role_with_web_identity": assumeRoleWithWebIdentityBlock(),
+			"endpoints":                     endpointsBlock(),
+			"default_tags": schema.SingleNestedBlock{
+				Description: "Tags applied to all resources supporting tags, unless overridden by the resource tags",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						Description: "Default tags",
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators:  tagsValidators(),
+					},
+				},
+			},
+			"ignore_tags": schema.SingleNestedBlock{
+				Description: "Tags neither reported nor managed by resources",
+				Attributes: map[string]schema.Attribute{
+					"keys": schema.SetAttribute{
+						Description: "Tag keys to ignore",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"key_prefixes": schema.SetAttribute{
+						Description: "Tag key prefixes to ignore",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -98,15 +302,32 @@ func (p *AwsExtProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		addendums = append(addendums, config.WithSharedConfigProfile(data.Profile.ValueString()))
 	}
 
+	if len(data.SharedConfigFiles) > 0 {
+		addendums = append(addendums, config.WithSharedConfigFiles(expandHomePaths(data.SharedConfigFiles)))
+	}
+
+	if len(data.SharedCredentialsFiles) > 0 {
+		addendums = append(addendums, config.WithSharedCredentialsFiles(expandHomePaths(data.SharedCredentialsFiles)))
+	}
+
+	// The SDK reads AWS_REGION but not AWS_DEFAULT_REGION, unlike the CLI
 	if data.Region.ValueString() != "" {
 		addendums = append(addendums, config.WithRegion(data.Region.ValueString()))
+	} else if os.Getenv("AWS_REGION") == "" && os.Getenv("AWS_DEFAULT_REGION") != "" {
+		addendums = append(addendums, config.WithRegion(os.Getenv("AWS_DEFAULT_REGION")))
+	}
+
+	network, err := networkOptions(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Network Configuration", err.Error())
+		return
 	}
 
+	addendums = append(addendums, network...)
+
+	retry := newRetryConfig(data.MaxRetries, data.RetryMode)
 	addendums = append(addendums, config.WithRetryer(func() aws.Retryer {
-		var retryer aws.Retryer
-		retryer = retry.NewStandard()
-		retryer = retry.AddWithMaxAttempts(retryer, 20)
-		return retry.AddWithMaxBackoffDelay(retryer, 10*time.Second)
+		return newRetryer(retry)
 	}))
 
 	cfg, err := config.LoadDefaultConfig(context.TODO(), addendums...)
@@ -116,31 +337,242 @@ func (p *AwsExtProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// Identify the provider and Terraform versions in the User-Agent of all
+	// calls, e.g. in CloudTrail
+	cfg.APIOptions = append(cfg.APIOptions, middleware.AddUserAgentKeyValue("terraform-provider-awsext", p.version))
+	if req.TerraformVersion != "" {
+		cfg.APIOptions = append(cfg.APIOptions, middleware.AddUserAgentKeyValue("Terraform", req.TerraformVersion))
+	}
+
+	cfg.APIOptions = append(cfg.APIOptions, recordAPICalls(data.LogAPICalls.ValueBool(), data.LogResponseMetadata.ValueBool()))
+
+	if tracingEnabled(data.OtelTracesEndpoint.ValueString()) {
+		apiTracer, err := tracer(ctx, data.OtelTracesEndpoint.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to configure tracing", err.Error())
+			return
+		}
+
+		cfg.APIOptions = append(cfg.APIOptions, traceAPICalls(apiTracer))
+	}
+
+	if data.RoleArn.ValueString() != "" && len(data.AssumeRole) > 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("role_arn"), "Conflicting Assume Role Configuration", "role_arn cannot be set with assume_role blocks, set the role in an assume_role block instead.")
+		return
+	}
+
+	// Roles are assumed through the custom STS endpoint
+	endpoints := data.Endpoints.byService()
+
 	if data.RoleArn.ValueString() != "" {
-		stsClient := sts.NewFromConfig(cfg)
+		stsClient := sts.NewFromConfig(withEndpoint(cfg, endpoints, "sts"))
 		creds := stscreds.NewAssumeRoleProvider(stsClient, data.RoleArn.ValueString())
 		cfg.Credentials = aws.NewCredentialsCache(creds)
 	}
 
-	resp.ResourceData = cfg
+	// The web identity role is assumed first, then the assume_role chain
+	if data.AssumeRoleWithWebIdentity != nil {
+		creds, err := assumeRoleWithWebIdentity(withEndpoint(cfg, endpoints, "sts"), *data.AssumeRoleWithWebIdentity)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("assume_role_with_web_identity"), "Invalid assume role with web identity", err.Error())
+			return
+		}
+
+		cfg.Credentials = creds
+	}
+
+	for i, model := range data.AssumeRole {
+		creds, err := assumeRole(withEndpoint(cfg, endpoints, "sts"), model)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("assume_role").AtListIndex(i), "Invalid assume role", err.Error())
+			return
+		}
+
+		cfg.Credentials = creds
+	}
+
+	if !data.SkipCredentialsValidation.ValueBool() {
+		if _, err := sts.NewFromConfig(withEndpoint(cfg, endpoints, "sts")).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+			resp.Diagnostics.Append(apiError("Invalid AWS Credentials", "Could not validate the credentials of the provider with STS GetCallerIdentity. Set skip_credentials_validation to skip this check", err))
+			return
+		}
+	}
+
+	operationPolicies, err := newOperationPolicies(data.OperationPolicies)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("operation_policies"), "Invalid operation policy", err.Error())
+		return
+	}
+
+	providerData := &ProviderData{
+		Config:      cfg,
+		DefaultTags: map[string]string{},
+		endpoints:   endpoints,
+		retry:       retry,
+		semaphores:  newSemaphores(data.MaxConcurrentRequests),
+
+		operationPolicies:     operationPolicies,
+		importOnExistsDefault: data.ImportOnExists.IsNull() || data.ImportOnExists.ValueBool(),
+		validateReferences:    data.ValidateReferences.ValueBool(),
+		batchRefresh:          data.BatchRefresh.ValueBool(),
+		planRefresh:           data.PlanRefresh.ValueBool(),
+		readOnly:              data.ReadOnly.ValueBool(),
+		readOnlyMode:          readOnlyModeError,
+	}
+
+	if data.ReadOnlyMode.ValueString() != "" {
+		providerData.readOnlyMode = data.ReadOnlyMode.ValueString()
+	}
+
+	// Default and ignored tags may be unknown until the apply, e.g. when
+	// computed from another resource, in which case tags_all is planned
+	// unknown
+	if data.DefaultTags != nil {
+		tags := data.DefaultTags.Tags
+
+		if tags.IsUnknown() || !allKnown(maps.Values(tags.Elements())) {
+			providerData.tagsUnknown = true
+		} else if !tags.IsNull() {
+			resp.Diagnostics.Append(tags.ElementsAs(ctx, &providerData.DefaultTags, false)...)
+		}
+	}
+
+	if data.IgnoreTags != nil {
+		for _, set := range []struct {
+			value  types.Set
+			target *[]string
+		}{
+			{data.IgnoreTags.Keys, &providerData.IgnoreTags.Keys},
+			{data.IgnoreTags.KeyPrefixes, &providerData.IgnoreTags.KeyPrefixes},
+		} {
+			if set.value.IsUnknown() || !allKnown(slices.Values(set.value.Elements())) {
+				providerData.tagsUnknown = true
+			} else if !set.value.IsNull() {
+				resp.Diagnostics.Append(set.value.ElementsAs(ctx, set.target, false)...)
+			}
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerData.buildClients()
+
+	resp.DataSourceData = providerData
+	resp.EphemeralResourceData = providerData
+	resp.ResourceData = providerData
+	resp.ListResourceData = providerData
+	resp.ActionData = providerData
 }
 
 func (p *AwsExtProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAgentStatusResource,
+		NewUsersBulkResource,
+		NewRoutingProfileUserAssociationResource,
+		NewTagResource,
+		NewAgentStatusSetResource,
+		NewConfigBackupResource,
+		NewQueueOutboundCallerConfigResource,
+		NewHolidayCalendarResource,
+		NewUserHierarchyGroupAssociationResource,
+		NewQueueResource,
+		NewSecurityProfileResource,
+		NewContactFlowResource,
+		NewPhoneNumberResource,
+		NewInstanceResource,
+		NewInstanceAttributeResource,
+		NewBotAssociationResource,
+		NewViewResource,
+		NewRuleResource,
+		NewUserHierarchyGroupResource,
+		NewUserHierarchyStructureResource,
+		NewQueueQuickConnectAssociationResource,
+		NewHoursOfOperationOverrideResource,
+		NewIntegrationAssociationResource,
+		NewAgentStatusesResource,
 	}
 }
 
 func (p *AwsExtProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewAssumeRoleCredentialsEphemeralResource,
+		NewFederationTokenEphemeralResource,
+		NewSecretValueEphemeralResource,
+		NewSsmParameterEphemeralResource,
+		NewS3PresignedURLEphemeralResource,
+		NewKmsDataKeyEphemeralResource,
+		NewSessionTokenEphemeralResource,
+		NewCallerIdentityEphemeralResource,
+		NewConnectUserPasswordEphemeralResource,
+		NewRdsIamAuthTokenEphemeralResource,
+		NewSTSFederationTokenEphemeralResource,
+		NewEcrAuthorizationTokenEphemeralResource,
+	}
+}
+
+func (p *AwsExtProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewAgentStatusListResource,
+		NewQueueListResource,
+	}
+}
+
+func (p *AwsExtProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewPublishFlowAction,
+		NewReplicateInstanceAction,
+		NewShiftTrafficAction,
+	}
 }
 
 func (p *AwsExtProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewInstanceAttributesDataSource,
+		NewLambdaFunctionAssociationsDataSource,
+		NewApprovedOriginsDataSource,
+		NewEmailAddressesDataSource,
+		NewMetricDataV2DataSource,
+		NewCurrentMetricDataDataSource,
+		NewInstanceReplicationStatusDataSource,
+		NewInstanceExportDataSource,
+		NewPhoneNumberDataSource,
+		NewViewDataSource,
+		NewSecurityProfilePermissionsCatalogDataSource,
+		NewUserDataSource,
+		NewBotAssociationsDataSource,
+		NewInstanceDataSource,
+		NewAgentStatusesDataSource,
+		NewQueueDataSource,
+		NewRoutingProfileDataSource,
+		NewQuickConnectDataSource,
+	}
 }
 
 func (p *AwsExtProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewArnParseFunction,
+		NewArnBuildFunction,
+		NewConnectInstanceIDFromArnFunction,
+		NewConnectResourceIDFromArnFunction,
+		NewE164NormalizeFunction,
+		NewContactFlowNormalizeFunction,
+		NewContactFlowReferencesFunction,
+		NewTagsMergeFunction,
+		NewHoursOfOperationConfigFunction,
+		NewConnectNameSanitizeFunction,
+		NewArnRegionFunction,
+		NewArnPartitionFunction,
+		NewArnAccountFunction,
+		NewValidateConnectInstanceIDFunction,
+		NewFlowTemplateRenderFunction,
+		NewTaskTemplateFieldsFunction,
+		NewEvaluationFormFromYamlFunction,
+		NewIcsToHoursOfOperationOverridesFunction,
+		NewIamPolicyMergeFunction,
+		NewArnMatchesFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -150,3 +582,23 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// expandHomePaths replaces the leading ~ of paths with the home directory of
+// the user, as in the AWS provider.
+func expandHomePaths(paths []string) []string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return paths
+	}
+
+	expanded := make([]string, len(paths))
+	for i, path := range paths {
+		if path == "~" || strings.HasPrefix(path, "~/") {
+			path = filepath.Join(home, path[1:])
+		}
+
+		expanded[i] = path
+	}
+
+	return expanded
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+)
+
+// ProviderData is handed to resources by the provider's Configure method and
+// carries the provider wide settings resources need besides the AWS config.
+type ProviderData struct {
+	Config      aws.Config
+	DefaultTags map[string]string
+	IgnoreTags  ignoreTagsConfig
+
+	// tagsUnknown is set when default_tags or ignore_tags is not known yet,
+	// so tags_all cannot be planned.
+	tagsUnknown bool
+
+	// assumedRoles caches the credentials of roles assumed by override blocks.
+	assumedRoles   map[string]aws.CredentialsProvider
+	assumedRolesMu sync.Mutex
+
+	clients       clientCache
+	describeCache describeCache
+	retryers      retryers
+
+	// endpoints are the custom endpoints of the endpoints block by service.
+	endpoints map[string]string
+
+	// importOnExistsDefault is the provider's import_on_exists, applying to
+	// the resources not setting theirs.
+	importOnExistsDefault bool
+
+	// retry configures the retryers of the services, see serviceRetryer.
+	retry retryConfig
+
+	// semaphores limit the concurrent requests per service, see
+	// newSemaphores.
+	semaphores map[string]chan struct{}
+
+	// operationPolicies override retries and timeouts per resource type.
+	operationPolicies map[string]operationPolicy
+
+	// validateReferences enables the plan time checks of referenced
+	// resources, e.g. modifyPlanInstanceExists.
+	validateReferences bool
+
+	// instances and instanceAttributeValues memoize the lookups of Connect
+	// instances by region and instance ID.
+	instances               memo[*conntypes.Instance]
+	instanceAttributeValues memo[map[string]string]
+
+	// instanceAliases memoizes the IDs of Connect instances by alias, per
+	// region and account, see instanceIDByAlias.
+	instanceAliases memo[map[string]string]
+
+	// batchRefresh reads resources from snapshots of all resources of their
+	// type and instance, memoized in snapshots, e.g. agentStatusSnapshot.
+	batchRefresh bool
+	snapshots    memo[any]
+
+	// planRefresh reads resources again at plan time, see modifyPlanRefresh.
+	planRefresh bool
+
+	// readOnly fails or skips, depending on readOnlyMode, the changes of
+	// resources and actions, see readOnlyBlocked.
+	readOnly     bool
+	readOnlyMode string
+
+	// agentStatusAPI and queueAPI, when set, build the Connect API of agent
+	// statuses and queues instead of resourceConnectClient, e.g. fakes in
+	// unit tests.
+	agentStatusAPI func(override *OverrideModel) AgentStatusAPI
+	queueAPI       func(override *OverrideModel) QueueAPI
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing. The factory function will be invoked for every
+// Terraform CLI command executed to create a provider server to which the
+// CLI can reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"awsext": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccPrefix is the name prefix of the resources created by acceptance
+// tests, removed by the sweepers.
+const testAccPrefix = "tf-acc-test-"
+
+// testAccInstanceIDVariable names the environment variable of the Connect
+// instance the acceptance tests create their resources in.
+const testAccInstanceIDVariable = "AWSEXT_TEST_INSTANCE_ID"
+
+var sweepFlag = flag.Bool("sweep", false, "sweep the resources left behind by acceptance tests in the "+testAccInstanceIDVariable+" instance instead of running the tests")
+var sweepInstancesFlag = flag.Bool("sweep-instances", false, "also delete the instances left behind by acceptance tests")
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	if *sweepFlag {
+		testAccSweep()
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+// testAccSweep runs the sweepers on the resources created by acceptance
+// tests, e.g. after an interrupted run:
+//
+//	AWSEXT_TEST_INSTANCE_ID=<instance_id> go test ./provider -sweep
+func testAccSweep() {
+	instanceID := os.Getenv(testAccInstanceIDVariable)
+	if instanceID == "" {
+		log.Fatalf("%s must be set to sweep", testAccInstanceIDVariable)
+	}
+
+	cfg, err := config.LoadDefaultConfig(context.Background())
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	err = Sweep(context.Background(), cfg, SweepOptions{
+		InstanceID: instanceID,
+		Prefix:     testAccPrefix,
+		Instances:  *sweepInstancesFlag,
+		Logf:       log.Printf,
+	})
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}
+
+// testAccPreCheck skips acceptance tests unless TF_ACC is set, and fails them
+// if the environment they need is not.
+func testAccPreCheck(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("acceptance tests are skipped unless TF_ACC is set")
+	}
+
+	if os.Getenv(testAccInstanceIDVariable) == "" {
+		t.Fatalf("%s must be set for acceptance tests", testAccInstanceIDVariable)
+	}
+}
+
+// testAccAWSConfig returns the AWS config of acceptance tests, loaded from
+// the environment like the provider's.
+func testAccAWSConfig(t *testing.T) aws.Config {
+	t.Helper()
+
+	cfg, err := config.LoadDefaultConfig(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cfg
+}
+
+func TestProvider(t *testing.T) {
+	server, err := testAccProtoV6ProviderFactories["awsext"]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, diagnostic := range response.Diagnostics {
+		t.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+	}
+}
+
+func TestAccSweepDryRun(t *testing.T) {
+	testAccPreCheck(t)
+
+	err := Sweep(context.Background(), testAccAWSConfig(t), SweepOptions{
+		InstanceID: os.Getenv(testAccInstanceIDVariable),
+		Prefix:     testAccPrefix,
+		DryRun:     true,
+		Logf:       t.Logf,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &PublishFlowAction{}
+var _ action.ActionWithConfigure = &PublishFlowAction{}
+
+func NewPublishFlowAction() action.Action {
+	return &PublishFlowAction{}
+}
+
+type PublishFlowAction struct {
+	providerData *ProviderData
+}
+
+type PublishFlowActionModel struct {
+	InstanceID         types.String `tfsdk:"instance_id"`
+	ContactFlowID      types.String `tfsdk:"contact_flow_id"`
+	CreateVersion      types.Bool   `tfsdk:"create_version"`
+	VersionDescription types.String `tfsdk:"version_description"`
+}
+
+func (a *PublishFlowAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_publish_flow"
+}
+
+func (a *PublishFlowAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Publishes the saved (draft) content of a Connect flow and optionally creates a new flow version from it",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validConnectInstanceID(),
+				},
+			},
+			"contact_flow_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the flow to publish",
+			},
+			"create_version": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Create a flow version from the published content",
+			},
+			"version_description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the created flow version",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+		},
+	}
+}
+
+func (a *PublishFlowAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.providerData = providerData
+}
+
+func (a *PublishFlowAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if a.providerData.readOnlyBlocked("invoke awsext_connect_publish_flow", true, &resp.Diagnostics) {
+		return
+	}
+
+	var data PublishFlowActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultActionTimeout)
+	defer cancel()
+
+	conn := a.providerData.connectClient(nil)
+
+	saved, err := conn.DescribeContactFlow(ctx, &connect.DescribeContactFlowInput{
+		InstanceId:    aws.String(data.InstanceID.ValueString()),
+		ContactFlowId: aws.String(data.ContactFlowID.ValueString() + ":$SAVED"),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Flow", fmt.Sprintf("Could not read the saved content of Connect Flow %s", data.ContactFlowID.ValueString()), err))
+		return
+	}
+
+	_, err = conn.UpdateContactFlowContent(ctx, &connect.UpdateContactFlowContentInput{
+		InstanceId:    aws.String(data.InstanceID.ValueString()),
+		ContactFlowId: aws.String(data.ContactFlowID.ValueString()),
+		Content:       saved.ContactFlow.Content,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error publishing Connect Flow", fmt.Sprintf("Could not publish Connect Flow %s", data.ContactFlowID.ValueString()), err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Published Connect Flow %s", aws.ToString(saved.ContactFlow.Name)),
+	})
+
+	if !data.CreateVersion.ValueBool() {
+		return
+	}
+
+	version, err := conn.CreateContactFlowVersion(ctx, &connect.CreateContactFlowVersionInput{
+		InstanceId:    aws.String(data.InstanceID.ValueString()),
+		ContactFlowId: aws.String(data.ContactFlowID.ValueString()),
+		Description:   data.VersionDescription.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect Flow version", fmt.Sprintf("Could not create a version of Connect Flow %s", data.ContactFlowID.ValueString()), err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Created version %d of Connect Flow %s", aws.ToInt64(version.Version), aws.ToString(saved.ContactFlow.Name)),
+	})
+}
@@ -0,0 +1,671 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &QueueResource{}
+var _ resource.ResourceWithImportState = &QueueResource{}
+var _ resource.ResourceWithModifyPlan = &QueueResource{}
+var _ resource.ResourceWithIdentity = &QueueResource{}
+var _ resource.ResourceWithMoveState = &QueueResource{}
+
+// queueResourceType is the type of the queue resource without the provider
+// prefix, e.g. in operation_policies.
+const queueResourceType = "connect_queue"
+
+func NewQueueResource() resource.Resource {
+	return &QueueResource{}
+}
+
+type QueueResource struct {
+	providerData *ProviderData
+}
+
+type QueueResourceModel struct {
+	Arn                  types.String                    `tfsdk:"arn"`
+	QueueID              types.String                    `tfsdk:"queue_id"`
+	InstanceID           types.String                    `tfsdk:"instance_id"`
+	InstanceAlias        types.String                    `tfsdk:"instance_alias"`
+	Name                 types.String                    `tfsdk:"name"`
+	Description          NullableString                  `tfsdk:"description"`
+	HoursOfOperationID   types.String                    `tfsdk:"hours_of_operation_id"`
+	MaxContacts          types.Int32                     `tfsdk:"max_contacts"`
+	OutboundCallerConfig *QueueOutboundCallerConfigModel `tfsdk:"outbound_caller_config"`
+	Status               types.String                    `tfsdk:"status"`
+	ImportOnExists       types.Bool                      `tfsdk:"import_on_exists"`
+	Tags                 types.Map                       `tfsdk:"tags"`
+	TagsAll              types.Map                       `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                  `tfsdk:"timeouts"`
+	Override             *OverrideModel                  `tfsdk:"override"`
+}
+
+// QueueOutboundCallerConfigModel describes the outbound_caller_config of a
+// queue.
+type QueueOutboundCallerConfigModel struct {
+	OutboundCallerIDName     types.String `tfsdk:"outbound_caller_id_name"`
+	OutboundCallerIDNumberID types.String `tfsdk:"outbound_caller_id_number_id"`
+	OutboundFlowID           types.String `tfsdk:"outbound_flow_id"`
+}
+
+type QueueResourceIdentityModel struct {
+	Arn     types.String `tfsdk:"arn"`
+	QueueID types.String `tfsdk:"queue_id"`
+}
+
+func (m QueueResourceModel) identity() QueueResourceIdentityModel {
+	return QueueResourceIdentityModel{
+		Arn:     m.Arn,
+		QueueID: m.QueueID,
+	}
+}
+
+func (r *QueueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + queueResourceType
+}
+
+func (r *QueueResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = connectIdentitySchema("queue_id")
+}
+
+func (r *QueueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connect standard queue resource. Queues cannot be deleted through the API, so they are disabled on destroy",
+
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"queue_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 127),
+				},
+			},
+			"description": schema.StringAttribute{
+				CustomType: NullableStringType{},
+				Optional:   true,
+				Validators: []validator.String{
+					// Empty is allowed and the same as no description
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+			"hours_of_operation_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the hours of operation of the queue.",
+			},
+			"max_contacts": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Maximum number of contacts in the queue before it is considered full. The queue is not limited if unset.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"outbound_caller_config": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Outbound caller ID and outbound whisper flow of the queue. Do not also manage them with awsext_connect_queue_outbound_caller_config.",
+				Attributes: map[string]schema.Attribute{
+					"outbound_caller_id_name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Caller ID name shown on outbound calls of the queue.",
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 255),
+						},
+					},
+					"outbound_caller_id_number_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "ID of the claimed phone number shown as caller ID on outbound calls of the queue.",
+					},
+					"outbound_flow_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "ID of the outbound whisper flow run for the customer on outbound calls of the queue.",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(conntypes.QueueStatusEnabled)),
+				Description: "Status of the queue, ENABLED or DISABLED. The queue is disabled when destroyed.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(conntypes.QueueStatusEnabled), string(conntypes.QueueStatusDisabled)),
+				},
+			},
+			"import_on_exists": importOnExistsAttribute(),
+			"tags":             tagsAttribute(),
+			"tags_all":         tagsAllAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *QueueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *QueueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanTags(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *QueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(queueResourceType, resp) {
+		return
+	}
+
+	var data QueueResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	adopt, diags := r.providerData.importOnExists(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	tagsAll, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(queueResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.queueClient(data.Override)
+	defer r.providerData.forgetQueueSnapshot(data.Override, data.InstanceID.ValueString())
+
+	// Queues disabled on destroy are adopted again when re-created
+	if adopt {
+		adoption := adoption[conntypes.Queue]{
+			kind: "Connect Queues",
+			find: func(ctx context.Context) (conntypes.Queue, bool, error) {
+				return findQueueByName(ctx, conn, data.InstanceID.ValueString(), data.Name.ValueString())
+			},
+			update: func(ctx context.Context, queue conntypes.Queue) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				data.QueueID = types.StringValue(aws.ToString(queue.QueueId))
+				data.Arn = types.StringValue(aws.ToString(queue.QueueArn))
+				tflog.Info(ctx, fmt.Sprintf("Imported Connect Queue with ID %s, updating...", data.QueueID.ValueString()))
+
+				if err := updateQueue(ctx, conn, data, queueModelFromQueue(queue)); err != nil {
+					diags.Append(apiError("Error updating Connect Queue", "Could not update Connect Queue", err))
+					return diags
+				}
+
+				if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), ignoreTags(queue.Tags, r.providerData.IgnoreTags), tagsAll); err != nil {
+					diags.Append(apiError("Error updating Connect Queue tags", "Could not update Connect Queue tags", err))
+				}
+
+				return diags
+			},
+		}
+
+		if adoption.adopt(ctx, resp, &data, func() any { return data.identity() }) {
+			return
+		}
+	}
+
+	input := &connect.CreateQueueInput{
+		InstanceId:           aws.String(data.InstanceID.ValueString()),
+		Name:                 aws.String(data.Name.ValueString()),
+		Description:          aws.String(data.Description.ValueString()),
+		HoursOfOperationId:   aws.String(data.HoursOfOperationID.ValueString()),
+		MaxContacts:          data.MaxContacts.ValueInt32Pointer(),
+		OutboundCallerConfig: data.outboundCallerConfig(),
+	}
+
+	if len(tagsAll) > 0 {
+		input.Tags = tagsAll
+	}
+
+	response, err := conn.CreateQueue(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect Queue", "Could not create Connect Queue", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	data.QueueID = types.StringValue(aws.ToString(response.QueueId))
+	data.Arn = types.StringValue(aws.ToString(response.QueueArn))
+
+	// Queues are created enabled
+	if data.Status.ValueString() == string(conntypes.QueueStatusDisabled) {
+		if err := updateQueueStatus(ctx, conn, data.InstanceID.ValueString(), data.QueueID.ValueString(), conntypes.QueueStatusDisabled); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Queue", "Could not disable Connect Queue", err))
+		}
+	}
+
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *QueueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data QueueResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(queueResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.queueClient(data.Override)
+	queue, err := r.readQueue(ctx, conn, data)
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Queue", "Could not read Connect Queue", err))
+		return
+	}
+
+	if queue == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(ctx, r.providerData, queue)...)
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+// readQueue returns the queue of the model, or nil if it does not exist. With
+// batch_refresh, it is read from the snapshot of all standard queues of the
+// instance instead of being described.
+func (r *QueueResource) readQueue(ctx context.Context, conn QueueAPI, data QueueResourceModel) (*conntypes.Queue, error) {
+	if r.providerData.batchRefresh {
+		queues, err := r.providerData.queueSnapshot(ctx, conn, data.Override, data.InstanceID.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		queue, ok := queues[data.QueueID.ValueString()]
+		if !ok {
+			return nil, nil
+		}
+
+		return &queue, nil
+	}
+
+	response, err := conn.DescribeQueue(ctx, &connect.DescribeQueueInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		QueueId:    aws.String(data.QueueID.ValueString()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Queue, nil
+}
+
+// queueSnapshot returns all standard queues of an instance by ID, from a
+// single search shared by the Reads of all queues of the instance. Agent
+// queues, one per user, are left out.
+func (p *ProviderData) queueSnapshot(ctx context.Context, conn QueueAPI, override *OverrideModel, instanceID string) (map[string]conntypes.Queue, error) {
+	snapshot, err := p.snapshots.get(p.snapshotKey(queueResourceType, override, instanceID), func() (any, error) {
+		criteria := &conntypes.QueueSearchCriteria{QueueTypeCondition: conntypes.SearchableQueueTypeStandard}
+
+		queues, err := collectPages(ctx, searchQueues(conn, instanceID, criteria), 0, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		byID := make(map[string]conntypes.Queue, len(queues))
+		for _, queue := range queues {
+			byID[aws.ToString(queue.QueueId)] = queue
+		}
+
+		return byID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot.(map[string]conntypes.Queue), nil
+}
+
+// forgetQueueSnapshot drops the snapshot of the queues of an instance after a
+// change, so later Reads search the queues again.
+func (p *ProviderData) forgetQueueSnapshot(override *OverrideModel, instanceID string) {
+	p.snapshots.forget(p.snapshotKey(queueResourceType, override, instanceID))
+}
+
+// flatten sets the model from a queue returned by the API.
+func (m *QueueResourceModel) flatten(ctx context.Context, providerData *ProviderData, queue *conntypes.Queue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.QueueID = types.StringValue(aws.ToString(queue.QueueId))
+	m.Arn = types.StringValue(aws.ToString(queue.QueueArn))
+	m.Name = types.StringValue(aws.ToString(queue.Name))
+	m.Description = flattenNullableString(ctx, m.Description, queue.Description)
+	m.HoursOfOperationID = types.StringValue(aws.ToString(queue.HoursOfOperationId))
+	m.MaxContacts = types.Int32PointerValue(queue.MaxContacts)
+	m.Status = types.StringValue(string(queue.Status))
+	m.OutboundCallerConfig = flattenQueueOutboundCallerConfig(queue.OutboundCallerConfig)
+
+	m.Tags, m.TagsAll, diags = providerData.readTags(ctx, queue.Tags, m.Tags)
+
+	return diags
+}
+
+// flattenQueueOutboundCallerConfig returns the outbound_caller_config of an
+// outbound caller config returned by the API, which is null when none of its
+// attributes is set so that queues without one have no drift.
+func flattenQueueOutboundCallerConfig(config *conntypes.OutboundCallerConfig) *QueueOutboundCallerConfigModel {
+	if config == nil || (aws.ToString(config.OutboundCallerIdName) == "" && aws.ToString(config.OutboundCallerIdNumberId) == "" && aws.ToString(config.OutboundFlowId) == "") {
+		return nil
+	}
+
+	return &QueueOutboundCallerConfigModel{
+		OutboundCallerIDName:     optionalString(config.OutboundCallerIdName),
+		OutboundCallerIDNumberID: optionalString(config.OutboundCallerIdNumberId),
+		OutboundFlowID:           optionalString(config.OutboundFlowId),
+	}
+}
+
+// optionalString returns the value of an optional attribute from a string
+// returned by the API, null when it is empty.
+func optionalString(value *string) types.String {
+	if aws.ToString(value) == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(aws.ToString(value))
+}
+
+func (r *QueueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, queueResourceType, req, resp) {
+		return
+	}
+
+	var data, state QueueResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(queueResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.queueClient(data.Override)
+	defer r.providerData.forgetQueueSnapshot(data.Override, data.InstanceID.ValueString())
+
+	if err := updateQueue(ctx, conn, data, state); err != nil {
+		resp.Diagnostics.Append(apiError("Error updating Connect Queue", "Could not update Connect Queue", err))
+		return
+	}
+
+	if !data.TagsAll.Equal(state.TagsAll) {
+		oldTags, diags := mapFromTags(ctx, state.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		newTags, diags := mapFromTags(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), oldTags, newTags); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Queue tags", "Could not update Connect Queue tags", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateQueue updates the attributes of the queue of plan that differ from
+// state, as each attribute has its own update operation.
+func updateQueue(ctx context.Context, conn QueueAPI, plan, state QueueResourceModel) error {
+	instanceID, queueID := plan.InstanceID.ValueString(), plan.QueueID.ValueString()
+
+	if !plan.Name.Equal(state.Name) || plan.Description.ValueString() != state.Description.ValueString() {
+		_, err := conn.UpdateQueueName(ctx, &connect.UpdateQueueNameInput{
+			InstanceId:  aws.String(instanceID),
+			QueueId:     aws.String(queueID),
+			Name:        aws.String(plan.Name.ValueString()),
+			Description: aws.String(plan.Description.ValueString()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !plan.HoursOfOperationID.Equal(state.HoursOfOperationID) {
+		_, err := conn.UpdateQueueHoursOfOperation(ctx, &connect.UpdateQueueHoursOfOperationInput{
+			InstanceId:         aws.String(instanceID),
+			QueueId:            aws.String(queueID),
+			HoursOfOperationId: aws.String(plan.HoursOfOperationID.ValueString()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !plan.MaxContacts.Equal(state.MaxContacts) {
+		_, err := conn.UpdateQueueMaxContacts(ctx, &connect.UpdateQueueMaxContactsInput{
+			InstanceId:  aws.String(instanceID),
+			QueueId:     aws.String(queueID),
+			MaxContacts: plan.MaxContacts.ValueInt32Pointer(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if *plan.outboundCallerConfigOrEmpty() != *state.outboundCallerConfigOrEmpty() {
+		if err := updateQueueOutboundCallerConfig(ctx, conn, instanceID, queueID, plan.outboundCallerConfigOrEmpty().config()); err != nil {
+			return err
+		}
+	}
+
+	if !plan.Status.Equal(state.Status) {
+		return updateQueueStatus(ctx, conn, instanceID, queueID, conntypes.QueueStatus(plan.Status.ValueString()))
+	}
+
+	return nil
+}
+
+// queueModelFromQueue returns the model of an existing queue, to update only
+// the attributes of an adopted queue that differ from the plan.
+func queueModelFromQueue(queue conntypes.Queue) QueueResourceModel {
+	return QueueResourceModel{
+		Name:                 types.StringValue(aws.ToString(queue.Name)),
+		Description:          NullableString{StringValue: types.StringValue(aws.ToString(queue.Description))},
+		HoursOfOperationID:   types.StringValue(aws.ToString(queue.HoursOfOperationId)),
+		MaxContacts:          types.Int32PointerValue(queue.MaxContacts),
+		OutboundCallerConfig: flattenQueueOutboundCallerConfig(queue.OutboundCallerConfig),
+		Status:               types.StringValue(string(queue.Status)),
+	}
+}
+
+// outboundCallerConfig returns the outbound caller config of the model, nil
+// if it has none.
+func (m QueueResourceModel) outboundCallerConfig() *conntypes.OutboundCallerConfig {
+	if m.OutboundCallerConfig == nil {
+		return nil
+	}
+
+	return m.OutboundCallerConfig.config()
+}
+
+// outboundCallerConfigOrEmpty returns the outbound_caller_config of the model,
+// with null attributes if it has none, e.g. to clear it.
+func (m QueueResourceModel) outboundCallerConfigOrEmpty() *QueueOutboundCallerConfigModel {
+	if m.OutboundCallerConfig == nil {
+		return &QueueOutboundCallerConfigModel{}
+	}
+
+	return m.OutboundCallerConfig
+}
+
+func (m QueueOutboundCallerConfigModel) config() *conntypes.OutboundCallerConfig {
+	return &conntypes.OutboundCallerConfig{
+		OutboundCallerIdName:     m.OutboundCallerIDName.ValueStringPointer(),
+		OutboundCallerIdNumberId: m.OutboundCallerIDNumberID.ValueStringPointer(),
+		OutboundFlowId:           m.OutboundFlowID.ValueStringPointer(),
+	}
+}
+
+func updateQueueStatus(ctx context.Context, conn QueueAPI, instanceID, queueID string, status conntypes.QueueStatus) error {
+	_, err := conn.UpdateQueueStatus(ctx, &connect.UpdateQueueStatusInput{
+		InstanceId: aws.String(instanceID),
+		QueueId:    aws.String(queueID),
+		Status:     status,
+	})
+
+	return err
+}
+
+func (r *QueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(queueResourceType, resp) {
+		return
+	}
+
+	var data QueueResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(queueResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	// Queues cannot be deleted, so they are disabled instead and adopted
+	// again with import_on_exists if re-created
+	conn := r.providerData.queueClient(data.Override)
+	defer r.providerData.forgetQueueSnapshot(data.Override, data.InstanceID.ValueString())
+
+	err := updateQueueStatus(ctx, conn, data.InstanceID.ValueString(), data.QueueID.ValueString(), conntypes.QueueStatusDisabled)
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error updating Connect Queue", "Could not disable Connect Queue", err))
+	}
+}
+
+func (r *QueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importConnectResource(ctx, req, resp, "queue", "queue_id")
+}
+
+// MoveState migrates the state of aws_connect_queue in moved blocks.
+func (r *QueueResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{connectStateMover("aws_connect_queue", "queue_id")}
+}
+
+// searchQueues returns a lister of the standard queues of an instance
+// matching criteria.
+func searchQueues(conn QueueAPI, instanceID string, criteria *conntypes.QueueSearchCriteria) pageLister[conntypes.Queue] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.Queue, *string, error) {
+		response, err := conn.SearchQueues(ctx, &connect.SearchQueuesInput{
+			InstanceId:     aws.String(instanceID),
+			MaxResults:     aws.Int32(searchPageSize),
+			NextToken:      nextToken,
+			SearchCriteria: criteria,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Queues, response.NextToken, nil
+	}
+}
+
+// findQueueByName returns the standard queue of an instance with the given
+// name.
+func findQueueByName(ctx context.Context, conn QueueAPI, instanceID string, name string) (conntypes.Queue, bool, error) {
+	criteria := &conntypes.QueueSearchCriteria{
+		AndConditions: []conntypes.QueueSearchCriteria{
+			{StringCondition: stringCondition("name", conntypes.StringComparisonTypeExact, name)},
+			{QueueTypeCondition: conntypes.SearchableQueueTypeStandard},
+		},
+	}
+
+	return findExisting(ctx, searchQueues(conn, instanceID, criteria), func(queue conntypes.Queue) bool {
+		return aws.ToString(queue.Name) == name
+	})
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &QueueDataSource{}
+
+func NewQueueDataSource() datasource.DataSource {
+	return &QueueDataSource{}
+}
+
+type QueueDataSource struct {
+	providerData *ProviderData
+}
+
+type QueueDataSourceModel struct {
+	InstanceID           types.String                    `tfsdk:"instance_id"`
+	InstanceAlias        types.String                    `tfsdk:"instance_alias"`
+	Name                 types.String                    `tfsdk:"name"`
+	QueueID              types.String                    `tfsdk:"queue_id"`
+	Arn                  types.String                    `tfsdk:"arn"`
+	Description          types.String                    `tfsdk:"description"`
+	HoursOfOperationID   types.String                    `tfsdk:"hours_of_operation_id"`
+	MaxContacts          types.Int32                     `tfsdk:"max_contacts"`
+	OutboundCallerConfig *QueueOutboundCallerConfigModel `tfsdk:"outbound_caller_config"`
+	Status               types.String                    `tfsdk:"status"`
+	Tags                 types.Map                       `tfsdk:"tags"`
+}
+
+func (d *QueueDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_queue"
+}
+
+func (d *QueueDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a standard Connect queue by name",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the queue, matched exactly.",
+			},
+			"queue_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"hours_of_operation_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"max_contacts": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Maximum number of contacts in the queue before it is considered full, null if the queue is not limited.",
+			},
+			"outbound_caller_config": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"outbound_caller_id_name": schema.StringAttribute{
+						Computed: true,
+					},
+					"outbound_caller_id_number_id": schema.StringAttribute{
+						Computed: true,
+					},
+					"outbound_flow_id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *QueueDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *QueueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QueueDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.providerData.connectClient(nil)
+	name := data.Name.ValueString()
+
+	queues, err := collectPages(ctx, listQueues(conn, data.InstanceID.ValueString()), 0, func(queue conntypes.QueueSummary) bool {
+		return aws.ToString(queue.Name) == name
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Queues", fmt.Sprintf("Could not list the queues to find queue %s", name), err))
+		return
+	}
+
+	if len(queues) == 0 {
+		resp.Diagnostics.AddError("Connect Queue Not Found", fmt.Sprintf("Instance %s has no standard queue named %q.", data.InstanceID.ValueString(), name))
+		return
+	}
+
+	response, err := conn.DescribeQueue(ctx, &connect.DescribeQueueInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		QueueId:    queues[0].Id,
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Queue", fmt.Sprintf("Could not read queue %s", name), err))
+		return
+	}
+
+	queue := response.Queue
+	data.QueueID = types.StringPointerValue(queue.QueueId)
+	data.Arn = types.StringPointerValue(queue.QueueArn)
+	data.Description = types.StringPointerValue(queue.Description)
+	data.HoursOfOperationID = types.StringPointerValue(queue.HoursOfOperationId)
+	data.MaxContacts = types.Int32PointerValue(queue.MaxContacts)
+	data.OutboundCallerConfig = flattenQueueOutboundCallerConfig(queue.OutboundCallerConfig)
+	data.Status = types.StringValue(string(queue.Status))
+
+	tags, diags := types.MapValueFrom(ctx, types.StringType, ignoreTags(queue.Tags, d.providerData.IgnoreTags))
+	resp.Diagnostics.Append(diags...)
+	data.Tags = tags
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listQueues returns a lister of the standard queues of an instance. Agent
+// queues have no name, so they are not listed.
+func listQueues(conn QueueAPI, instanceID string) pageLister[conntypes.QueueSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.QueueSummary, *string, error) {
+		response, err := conn.ListQueues(ctx, &connect.ListQueuesInput{
+			InstanceId: aws.String(instanceID),
+			QueueTypes: []conntypes.QueueType{conntypes.QueueTypeStandard},
+			MaxResults: aws.Int32(1000),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.QueueSummaryList, response.NextToken, nil
+	}
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &QueueListResource{}
+var _ list.ListResourceWithConfigure = &QueueListResource{}
+
+func NewQueueListResource() list.ListResource {
+	return &QueueListResource{}
+}
+
+type QueueListResource struct {
+	providerData *ProviderData
+}
+
+type QueueListResourceModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+}
+
+func (r *QueueListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_queue"
+}
+
+func (r *QueueListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the standard queues of a Connect instance",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validConnectInstanceID(),
+				},
+			},
+		},
+	}
+}
+
+func (r *QueueListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *QueueListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config QueueListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := r.providerData.connectClient(nil)
+	instanceID := config.InstanceID.ValueString()
+
+	streamListResults(ctx, req, stream, listQueues(conn, instanceID), func(ctx context.Context, summary conntypes.QueueSummary, result *list.ListResult) {
+		data := QueueResourceModel{
+			Arn:        types.StringValue(aws.ToString(summary.Arn)),
+			QueueID:    types.StringValue(aws.ToString(summary.Id)),
+			InstanceID: types.StringValue(instanceID),
+			Tags:       types.MapNull(types.StringType),
+			Timeouts:   nullTimeouts(ctx),
+		}
+
+		result.DisplayName = aws.ToString(summary.Name)
+		result.Diagnostics.Append(result.Identity.Set(ctx, data.identity())...)
+
+		if !req.IncludeResource {
+			return
+		}
+
+		response, err := conn.DescribeQueue(ctx, &connect.DescribeQueueInput{
+			InstanceId: aws.String(instanceID),
+			QueueId:    summary.Id,
+		})
+		if err != nil {
+			result.Diagnostics.Append(apiError("Error reading Connect Queue", "Could not read Connect Queue", err))
+			return
+		}
+
+		result.Diagnostics.Append(data.flatten(ctx, r.providerData, response.Queue)...)
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
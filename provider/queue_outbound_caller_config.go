@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &QueueOutboundCallerConfigResource{}
+var _ resource.ResourceWithImportState = &QueueOutboundCallerConfigResource{}
+var _ resource.ResourceWithModifyPlan = &QueueOutboundCallerConfigResource{}
+
+// queueOutboundCallerConfigResourceType is the type of the queue outbound
+// caller config resource without the provider prefix, e.g. in
+// operation_policies.
+const queueOutboundCallerConfigResourceType = "connect_queue_outbound_caller_config"
+
+func NewQueueOutboundCallerConfigResource() resource.Resource {
+	return &QueueOutboundCallerConfigResource{}
+}
+
+type QueueOutboundCallerConfigResource struct {
+	providerData *ProviderData
+}
+
+type QueueOutboundCallerConfigResourceModel struct {
+	InstanceID               types.String   `tfsdk:"instance_id"`
+	InstanceAlias            types.String   `tfsdk:"instance_alias"`
+	QueueID                  types.String   `tfsdk:"queue_id"`
+	OutboundCallerIDName     types.String   `tfsdk:"outbound_caller_id_name"`
+	OutboundCallerIDNumberID types.String   `tfsdk:"outbound_caller_id_number_id"`
+	OutboundFlowID           types.String   `tfsdk:"outbound_flow_id"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	Override                 *OverrideModel `tfsdk:"override"`
+}
+
+func (r *QueueOutboundCallerConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + queueOutboundCallerConfigResourceType
+}
+
+func (r *QueueOutboundCallerConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the outbound caller ID and outbound whisper flow of a Connect queue created elsewhere",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"queue_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"outbound_caller_id_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Caller ID name shown on outbound calls of the queue.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"outbound_caller_id_number_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the claimed phone number shown as caller ID on outbound calls of the queue.",
+			},
+			"outbound_flow_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the outbound whisper flow run for the customer on outbound calls of the queue.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *QueueOutboundCallerConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *QueueOutboundCallerConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *QueueOutboundCallerConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(queueOutboundCallerConfigResourceType, resp) {
+		return
+	}
+
+	var data QueueOutboundCallerConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(queueOutboundCallerConfigResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(queueOutboundCallerConfigResourceType, data.Override)
+	defer r.providerData.forgetQueueSnapshot(data.Override, data.InstanceID.ValueString())
+
+	if err := updateQueueOutboundCallerConfig(ctx, conn, data.InstanceID.ValueString(), data.QueueID.ValueString(), data.config()); err != nil {
+		resp.Diagnostics.Append(apiError("Error updating Connect Queue", fmt.Sprintf("Could not update the outbound caller config of queue %s", data.QueueID.ValueString()), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueueOutboundCallerConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data QueueOutboundCallerConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(queueOutboundCallerConfigResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(queueOutboundCallerConfigResourceType, data.Override)
+	response, err := conn.DescribeQueue(ctx, &connect.DescribeQueueInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		QueueId:    aws.String(data.QueueID.ValueString()),
+	})
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Queue", fmt.Sprintf("Could not read queue %s", data.QueueID.ValueString()), err))
+		return
+	}
+
+	config := response.Queue.OutboundCallerConfig
+	if config == nil {
+		config = &conntypes.OutboundCallerConfig{}
+	}
+
+	data.OutboundCallerIDName = types.StringPointerValue(config.OutboundCallerIdName)
+	data.OutboundCallerIDNumberID = types.StringPointerValue(config.OutboundCallerIdNumberId)
+	data.OutboundFlowID = types.StringPointerValue(config.OutboundFlowId)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueueOutboundCallerConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, queueOutboundCallerConfigResourceType, req, resp) {
+		return
+	}
+
+	var data QueueOutboundCallerConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(queueOutboundCallerConfigResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(queueOutboundCallerConfigResourceType, data.Override)
+	defer r.providerData.forgetQueueSnapshot(data.Override, data.InstanceID.ValueString())
+
+	if err := updateQueueOutboundCallerConfig(ctx, conn, data.InstanceID.ValueString(), data.QueueID.ValueString(), data.config()); err != nil {
+		resp.Diagnostics.Append(apiError("Error updating Connect Queue", fmt.Sprintf("Could not update the outbound caller config of queue %s", data.QueueID.ValueString()), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueueOutboundCallerConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(queueOutboundCallerConfigResourceType, resp) {
+		return
+	}
+
+	var data QueueOutboundCallerConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(queueOutboundCallerConfigResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	// The queue itself is not managed, so only its outbound caller config is
+	// cleared
+	conn := r.providerData.resourceConnectClient(queueOutboundCallerConfigResourceType, data.Override)
+	defer r.providerData.forgetQueueSnapshot(data.Override, data.InstanceID.ValueString())
+
+	err := updateQueueOutboundCallerConfig(ctx, conn, data.InstanceID.ValueString(), data.QueueID.ValueString(), &conntypes.OutboundCallerConfig{})
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error updating Connect Queue", fmt.Sprintf("Could not clear the outbound caller config of queue %s", data.QueueID.ValueString()), err))
+	}
+}
+
+func (r *QueueOutboundCallerConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with format <instance_id>:<queue_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("queue_id"), parts[1])...)
+}
+
+// config returns the outbound caller config of the model.
+func (m QueueOutboundCallerConfigResourceModel) config() *conntypes.OutboundCallerConfig {
+	return &conntypes.OutboundCallerConfig{
+		OutboundCallerIdName:     m.OutboundCallerIDName.ValueStringPointer(),
+		OutboundCallerIdNumberId: m.OutboundCallerIDNumberID.ValueStringPointer(),
+		OutboundFlowId:           m.OutboundFlowID.ValueStringPointer(),
+	}
+}
+
+func updateQueueOutboundCallerConfig(ctx context.Context, conn QueueAPI, instanceID, queueID string, config *conntypes.OutboundCallerConfig) error {
+	_, err := conn.UpdateQueueOutboundCallerConfig(ctx, &connect.UpdateQueueOutboundCallerConfigInput{
+		InstanceId:           aws.String(instanceID),
+		QueueId:              aws.String(queueID),
+		OutboundCallerConfig: config,
+	})
+
+	return err
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &QueueQuickConnectAssociationResource{}
+var _ resource.ResourceWithImportState = &QueueQuickConnectAssociationResource{}
+var _ resource.ResourceWithModifyPlan = &QueueQuickConnectAssociationResource{}
+
+// queueQuickConnectAssociationResourceType is the type of the queue quick
+// connect association resource without the provider prefix, e.g. in
+// operation_policies.
+const queueQuickConnectAssociationResourceType = "connect_queue_quick_connect_association"
+
+// quickConnectBatchSize is the largest number of quick connects accepted by
+// AssociateQueueQuickConnects and DisassociateQueueQuickConnects.
+const quickConnectBatchSize = 50
+
+func NewQueueQuickConnectAssociationResource() resource.Resource {
+	return &QueueQuickConnectAssociationResource{}
+}
+
+type QueueQuickConnectAssociationResource struct {
+	providerData *ProviderData
+}
+
+type QueueQuickConnectAssociationResourceModel struct {
+	InstanceID      types.String   `tfsdk:"instance_id"`
+	InstanceAlias   types.String   `tfsdk:"instance_alias"`
+	QueueID         types.String   `tfsdk:"queue_id"`
+	QuickConnectIDs types.Set      `tfsdk:"quick_connect_ids"`
+	SkipDestroy     types.Bool     `tfsdk:"skip_destroy"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	Override        *OverrideModel `tfsdk:"override"`
+}
+
+func (r *QueueQuickConnectAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + queueQuickConnectAssociationResourceType
+}
+
+func (r *QueueQuickConnectAssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Associates a set of quick connects with a Connect queue managed elsewhere",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"queue_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"quick_connect_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the quick connects associated with the queue. Other quick connects of the queue are not managed.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			skipDestroyAttributeName: skipDestroyAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *QueueQuickConnectAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *QueueQuickConnectAssociationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *QueueQuickConnectAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(queueQuickConnectAssociationResourceType, resp) {
+		return
+	}
+
+	var data QueueQuickConnectAssociationResourceModel
+	var quickConnectIDs []string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.QuickConnectIDs.ElementsAs(ctx, &quickConnectIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(queueQuickConnectAssociationResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(queueQuickConnectAssociationResourceType, data.Override)
+	associated, err := associateQueueQuickConnects(ctx, conn, data.InstanceID.ValueString(), data.QueueID.ValueString(), quickConnectIDs)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error associating Connect Quick Connects", "Could not associate all quick connects with the queue", err))
+	}
+
+	// Save data into Terraform state, including the quick connects
+	// associated before an error
+	if len(associated) > 0 {
+		data.QuickConnectIDs, diags = types.SetValueFrom(ctx, types.StringType, associated)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *QueueQuickConnectAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data QueueQuickConnectAssociationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(queueQuickConnectAssociationResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(queueQuickConnectAssociationResourceType, data.Override)
+	quickConnects, err := collectPages(ctx, listQueueQuickConnects(conn, data.InstanceID.ValueString(), data.QueueID.ValueString()), 0, nil)
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Quick Connects", "Could not list the quick connects of the queue", err))
+		return
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(data.QuickConnectIDs.ElementsAs(ctx, &managed, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// On import, all quick connects of the queue are managed
+	quickConnectIDs := []string{}
+	for _, quickConnect := range quickConnects {
+		quickConnectID := aws.ToString(quickConnect.Id)
+		if data.QuickConnectIDs.IsNull() || slices.Contains(managed, quickConnectID) {
+			quickConnectIDs = append(quickConnectIDs, quickConnectID)
+		}
+	}
+
+	if len(quickConnectIDs) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.QuickConnectIDs, diags = types.SetValueFrom(ctx, types.StringType, quickConnectIDs)
+	resp.Diagnostics.Append(diags...)
+
+	// skip_destroy is not set on import
+	if data.SkipDestroy.IsNull() {
+		data.SkipDestroy = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueueQuickConnectAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, queueQuickConnectAssociationResourceType, req, resp) {
+		return
+	}
+
+	var data, state QueueQuickConnectAssociationResourceModel
+	var planned, prior []string
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(data.QuickConnectIDs.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.QuickConnectIDs.ElementsAs(ctx, &prior, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(queueQuickConnectAssociationResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(queueQuickConnectAssociationResourceType, data.Override)
+	added, removed := stringSetDelta(prior, planned)
+
+	// The quick connects are updated from the prior state, so quick
+	// connects whose change failed keep their prior association
+	disassociated, err := disassociateQueueQuickConnects(ctx, conn, data.InstanceID.ValueString(), data.QueueID.ValueString(), removed)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error disassociating Connect Quick Connects", "Could not disassociate all removed quick connects from the queue", err))
+	}
+
+	associated, err := associateQueueQuickConnects(ctx, conn, data.InstanceID.ValueString(), data.QueueID.ValueString(), added)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error associating Connect Quick Connects", "Could not associate all added quick connects with the queue", err))
+	}
+
+	// Save updated data into Terraform state
+	data.QuickConnectIDs, diags = types.SetValueFrom(ctx, types.StringType, applyStringSetDelta(prior, associated, disassociated))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueueQuickConnectAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(queueQuickConnectAssociationResourceType, resp) {
+		return
+	}
+
+	var data QueueQuickConnectAssociationResourceModel
+	var quickConnectIDs []string
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.QuickConnectIDs.ElementsAs(ctx, &quickConnectIDs, false)...)
+
+	skip, diags := skipDestroy(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || skip {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(queueQuickConnectAssociationResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(queueQuickConnectAssociationResourceType, data.Override)
+	disassociated, err := disassociateQueueQuickConnects(ctx, conn, data.InstanceID.ValueString(), data.QueueID.ValueString(), quickConnectIDs)
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error disassociating Connect Quick Connects", "Could not disassociate all quick connects from the queue", err))
+
+		// Keep the quick connects still associated in the state
+		data.QuickConnectIDs, diags = types.SetValueFrom(ctx, types.StringType, applyStringSetDelta(quickConnectIDs, nil, disassociated))
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *QueueQuickConnectAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instanceID, queueID, found := strings.Cut(req.ID, ":")
+
+	if !found || instanceID == "" || queueID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with format <instance_id>:<queue_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	// All quick connects of the queue are imported
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("queue_id"), queueID)...)
+}
+
+// associateQueueQuickConnects associates the quick connects with the queue in
+// batches and returns the quick connects successfully associated, even on
+// error.
+func associateQueueQuickConnects(ctx context.Context, conn *connect.Client, instanceID, queueID string, quickConnectIDs []string) ([]string, error) {
+	associated := []string{}
+
+	for batch := range slices.Chunk(quickConnectIDs, quickConnectBatchSize) {
+		_, err := conn.AssociateQueueQuickConnects(ctx, &connect.AssociateQueueQuickConnectsInput{
+			InstanceId:      aws.String(instanceID),
+			QueueId:         aws.String(queueID),
+			QuickConnectIds: batch,
+		})
+		if err != nil {
+			return associated, err
+		}
+
+		associated = append(associated, batch...)
+	}
+
+	return associated, nil
+}
+
+// disassociateQueueQuickConnects disassociates the quick connects from the
+// queue in batches and returns the quick connects successfully disassociated,
+// even on error.
+func disassociateQueueQuickConnects(ctx context.Context, conn *connect.Client, instanceID, queueID string, quickConnectIDs []string) ([]string, error) {
+	disassociated := []string{}
+
+	for batch := range slices.Chunk(quickConnectIDs, quickConnectBatchSize) {
+		_, err := conn.DisassociateQueueQuickConnects(ctx, &connect.DisassociateQueueQuickConnectsInput{
+			InstanceId:      aws.String(instanceID),
+			QueueId:         aws.String(queueID),
+			QuickConnectIds: batch,
+		})
+		if err != nil {
+			return disassociated, err
+		}
+
+		disassociated = append(disassociated, batch...)
+	}
+
+	return disassociated, nil
+}
+
+func listQueueQuickConnects(conn *connect.Client, instanceID, queueID string) pageLister[conntypes.QuickConnectSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.QuickConnectSummary, *string, error) {
+		response, err := conn.ListQueueQuickConnects(ctx, &connect.ListQueueQuickConnectsInput{
+			InstanceId: aws.String(instanceID),
+			QueueId:    aws.String(queueID),
+			MaxResults: aws.Int32(100),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.QuickConnectSummaryList, response.NextToken, nil
+	}
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeQueueAPI is a QueueAPI recording the update operations called, failing
+// the operations listed in errs. The other operations panic.
+type fakeQueueAPI struct {
+	QueueAPI
+
+	queues []conntypes.Queue
+	errs   map[string]error
+	calls  []string
+}
+
+func (f *fakeQueueAPI) call(operation string) error {
+	f.calls = append(f.calls, operation)
+
+	return f.errs[operation]
+}
+
+func (f *fakeQueueAPI) UpdateQueueName(ctx context.Context, params *connect.UpdateQueueNameInput, optFns ...func(*connect.Options)) (*connect.UpdateQueueNameOutput, error) {
+	return &connect.UpdateQueueNameOutput{}, f.call("UpdateQueueName")
+}
+
+func (f *fakeQueueAPI) UpdateQueueHoursOfOperation(ctx context.Context, params *connect.UpdateQueueHoursOfOperationInput, optFns ...func(*connect.Options)) (*connect.UpdateQueueHoursOfOperationOutput, error) {
+	return &connect.UpdateQueueHoursOfOperationOutput{}, f.call("UpdateQueueHoursOfOperation")
+}
+
+func (f *fakeQueueAPI) UpdateQueueMaxContacts(ctx context.Context, params *connect.UpdateQueueMaxContactsInput, optFns ...func(*connect.Options)) (*connect.UpdateQueueMaxContactsOutput, error) {
+	return &connect.UpdateQueueMaxContactsOutput{}, f.call("UpdateQueueMaxContacts")
+}
+
+func (f *fakeQueueAPI) UpdateQueueOutboundCallerConfig(ctx context.Context, params *connect.UpdateQueueOutboundCallerConfigInput, optFns ...func(*connect.Options)) (*connect.UpdateQueueOutboundCallerConfigOutput, error) {
+	return &connect.UpdateQueueOutboundCallerConfigOutput{}, f.call("UpdateQueueOutboundCallerConfig")
+}
+
+func (f *fakeQueueAPI) UpdateQueueStatus(ctx context.Context, params *connect.UpdateQueueStatusInput, optFns ...func(*connect.Options)) (*connect.UpdateQueueStatusOutput, error) {
+	return &connect.UpdateQueueStatusOutput{}, f.call("UpdateQueueStatus")
+}
+
+func (f *fakeQueueAPI) SearchQueues(ctx context.Context, params *connect.SearchQueuesInput, optFns ...func(*connect.Options)) (*connect.SearchQueuesOutput, error) {
+	return &connect.SearchQueuesOutput{Queues: f.queues}, f.call("SearchQueues")
+}
+
+func (f *fakeQueueAPI) DescribeQueue(ctx context.Context, params *connect.DescribeQueueInput, optFns ...func(*connect.Options)) (*connect.DescribeQueueOutput, error) {
+	for _, queue := range f.queues {
+		if aws.ToString(queue.QueueId) == aws.ToString(params.QueueId) {
+			return &connect.DescribeQueueOutput{Queue: &queue}, f.call("DescribeQueue")
+		}
+	}
+
+	f.call("DescribeQueue")
+
+	return nil, &smithy.GenericAPIError{Code: "ResourceNotFoundException"}
+}
+
+func TestReadQueue(t *testing.T) {
+	queues := []conntypes.Queue{
+		{QueueId: aws.String("queue-0"), Name: aws.String("Sales")},
+		{QueueId: aws.String("queue-1"), Name: aws.String("Support")},
+	}
+
+	tests := map[string]struct {
+		batchRefresh bool
+		wantCalls    []string
+	}{
+		"describes each queue": {
+			wantCalls: []string{"DescribeQueue", "DescribeQueue", "DescribeQueue"},
+		},
+		"searches the queues once with batch_refresh": {
+			batchRefresh: true,
+			wantCalls:    []string{"SearchQueues"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			api := &fakeQueueAPI{queues: queues}
+			r := &QueueResource{providerData: &ProviderData{batchRefresh: test.batchRefresh}}
+
+			for _, id := range []string{"queue-0", "queue-1"} {
+				queue, err := r.readQueue(ctx, api, QueueResourceModel{InstanceID: types.StringValue("instance"), QueueID: types.StringValue(id)})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if queue == nil || aws.ToString(queue.QueueId) != id {
+					t.Errorf("got queue %v, want %s", queue, id)
+				}
+			}
+
+			queue, err := r.readQueue(ctx, api, QueueResourceModel{InstanceID: types.StringValue("instance"), QueueID: types.StringValue("deleted")})
+			if queue != nil || (err != nil && !isNotFound(err)) {
+				t.Errorf("got queue %v and error %v for a deleted queue", queue, err)
+			}
+
+			if !slices.Equal(api.calls, test.wantCalls) {
+				t.Errorf("calls: got %v, want %v", api.calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestUpdateQueue(t *testing.T) {
+	state := QueueResourceModel{
+		InstanceID:         types.StringValue("instance"),
+		QueueID:            types.StringValue("queue"),
+		Name:               types.StringValue("Sales"),
+		Description:        NullableString{StringValue: types.StringNull()},
+		HoursOfOperationID: types.StringValue("hours"),
+		MaxContacts:        types.Int32Null(),
+		Status:             types.StringValue("ENABLED"),
+	}
+
+	accessDenied := &smithy.GenericAPIError{Code: "AccessDeniedException"}
+
+	tests := map[string]struct {
+		change    func(plan *QueueResourceModel)
+		errs      map[string]error
+		wantCalls []string
+		wantErr   error
+	}{
+		"updates nothing without changes": {
+			change: func(plan *QueueResourceModel) {},
+		},
+		"updates the name and description together": {
+			change: func(plan *QueueResourceModel) {
+				plan.Description = NullableString{StringValue: types.StringValue("Sales queue")}
+			},
+			wantCalls: []string{"UpdateQueueName"},
+		},
+		"updates each changed attribute": {
+			change: func(plan *QueueResourceModel) {
+				plan.HoursOfOperationID = types.StringValue("other")
+				plan.MaxContacts = types.Int32Value(10)
+				plan.OutboundCallerConfig = &QueueOutboundCallerConfigModel{
+					OutboundCallerIDName:     types.StringValue("Sales"),
+					OutboundCallerIDNumberID: types.StringNull(),
+					OutboundFlowID:           types.StringNull(),
+				}
+				plan.Status = types.StringValue("DISABLED")
+			},
+			wantCalls: []string{"UpdateQueueHoursOfOperation", "UpdateQueueMaxContacts", "UpdateQueueOutboundCallerConfig", "UpdateQueueStatus"},
+		},
+		"stops at the first error": {
+			change: func(plan *QueueResourceModel) {
+				plan.Name = types.StringValue("Support")
+				plan.Status = types.StringValue("DISABLED")
+			},
+			errs:      map[string]error{"UpdateQueueName": accessDenied},
+			wantCalls: []string{"UpdateQueueName"},
+			wantErr:   accessDenied,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeQueueAPI{errs: test.errs}
+			plan := state
+			test.change(&plan)
+
+			err := updateQueue(context.Background(), api, plan, state)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got error %v, want %v", err, test.wantErr)
+			}
+
+			if !slices.Equal(api.calls, test.wantCalls) {
+				t.Errorf("calls: got %v, want %v", api.calls, test.wantCalls)
+			}
+		})
+	}
+}
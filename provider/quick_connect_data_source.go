@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &QuickConnectDataSource{}
+
+func NewQuickConnectDataSource() datasource.DataSource {
+	return &QuickConnectDataSource{}
+}
+
+type QuickConnectDataSource struct {
+	providerData *ProviderData
+}
+
+type QuickConnectDataSourceModel struct {
+	InstanceID       types.String `tfsdk:"instance_id"`
+	InstanceAlias    types.String `tfsdk:"instance_alias"`
+	Name             types.String `tfsdk:"name"`
+	QuickConnectID   types.String `tfsdk:"quick_connect_id"`
+	Arn              types.String `tfsdk:"arn"`
+	Description      types.String `tfsdk:"description"`
+	QuickConnectType types.String `tfsdk:"quick_connect_type"`
+	UserID           types.String `tfsdk:"user_id"`
+	QueueID          types.String `tfsdk:"queue_id"`
+	ContactFlowID    types.String `tfsdk:"contact_flow_id"`
+	PhoneNumber      types.String `tfsdk:"phone_number"`
+	Tags             types.Map    `tfsdk:"tags"`
+}
+
+func (d *QuickConnectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_quick_connect"
+}
+
+func (d *QuickConnectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Connect quick connect by name",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the quick connect, matched exactly.",
+			},
+			"quick_connect_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"quick_connect_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the quick connect, USER, QUEUE or PHONE_NUMBER.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the user transferred to by a USER quick connect.",
+			},
+			"queue_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the queue transferred to by a QUEUE quick connect.",
+			},
+			"contact_flow_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the flow run on transfer by a USER or QUEUE quick connect.",
+			},
+			"phone_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "Phone number in E.164 format called by a PHONE_NUMBER quick connect.",
+			},
+			"tags": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *QuickConnectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *QuickConnectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QuickConnectDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.providerData.connectClient(nil)
+	name := data.Name.ValueString()
+
+	quickConnects, err := collectPages(ctx, listQuickConnects(conn, data.InstanceID.ValueString()), 0, func(quickConnect conntypes.QuickConnectSummary) bool {
+		return aws.ToString(quickConnect.Name) == name
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Quick Connects", fmt.Sprintf("Could not list the quick connects to find quick connect %s", name), err))
+		return
+	}
+
+	if len(quickConnects) == 0 {
+		resp.Diagnostics.AddError("Connect Quick Connect Not Found", fmt.Sprintf("Instance %s has no quick connect named %q.", data.InstanceID.ValueString(), name))
+		return
+	}
+
+	response, err := conn.DescribeQuickConnect(ctx, &connect.DescribeQuickConnectInput{
+		InstanceId:     aws.String(data.InstanceID.ValueString()),
+		QuickConnectId: quickConnects[0].Id,
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Quick Connect", fmt.Sprintf("Could not read quick connect %s", name), err))
+		return
+	}
+
+	quickConnect := response.QuickConnect
+	config := quickConnect.QuickConnectConfig
+	if config == nil {
+		config = &conntypes.QuickConnectConfig{}
+	}
+
+	data.QuickConnectID = types.StringPointerValue(quickConnect.QuickConnectId)
+	data.Arn = types.StringPointerValue(quickConnect.QuickConnectARN)
+	data.Description = types.StringPointerValue(quickConnect.Description)
+	data.QuickConnectType = types.StringValue(string(config.QuickConnectType))
+	data.UserID = types.StringNull()
+	data.QueueID = types.StringNull()
+	data.ContactFlowID = types.StringNull()
+	data.PhoneNumber = types.StringNull()
+
+	// Only the config of the type of the quick connect is set
+	if config.UserConfig != nil {
+		data.UserID = types.StringPointerValue(config.UserConfig.UserId)
+		data.ContactFlowID = types.StringPointerValue(config.UserConfig.ContactFlowId)
+	}
+
+	if config.QueueConfig != nil {
+		data.QueueID = types.StringPointerValue(config.QueueConfig.QueueId)
+		data.ContactFlowID = types.StringPointerValue(config.QueueConfig.ContactFlowId)
+	}
+
+	if config.PhoneConfig != nil {
+		data.PhoneNumber = types.StringPointerValue(config.PhoneConfig.PhoneNumber)
+	}
+
+	tags, diags := types.MapValueFrom(ctx, types.StringType, ignoreTags(quickConnect.Tags, d.providerData.IgnoreTags))
+	resp.Diagnostics.Append(diags...)
+	data.Tags = tags
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listQuickConnects returns a lister of the quick connects of an instance.
+func listQuickConnects(conn *connect.Client, instanceID string) pageLister[conntypes.QuickConnectSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.QuickConnectSummary, *string, error) {
+		response, err := conn.ListQuickConnects(ctx, &connect.ListQuickConnectsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int32(1000),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.QuickConnectSummaryList, response.NextToken, nil
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// retryers holds the retryer of each service. Retryers are shared by all
+// clients of a service, so a throttle observed by one resource slows down the
+// requests of all others instead of each retrying at full rate.
+type retryers struct {
+	mu       sync.Mutex
+	services map[string]aws.Retryer
+}
+
+// defaultMaxRetries is the number of retries of an API call when the provider
+// does not set max_retries.
+const defaultMaxRetries = 19
+
+// retryConfig is the retry configuration of the provider.
+type retryConfig struct {
+	mode       aws.RetryMode
+	maxRetries int
+}
+
+// newRetryConfig returns the retry configuration of the provider's max_retries
+// and retry_mode, which may be null.
+func newRetryConfig(maxRetries types.Int64, mode types.String) retryConfig {
+	config := retryConfig{
+		mode:       aws.RetryModeAdaptive,
+		maxRetries: defaultMaxRetries,
+	}
+
+	if !maxRetries.IsNull() {
+		config.maxRetries = int(maxRetries.ValueInt64())
+	}
+
+	if mode.ValueString() != "" {
+		config.mode = aws.RetryMode(mode.ValueString())
+	}
+
+	return config
+}
+
+// newRetryer returns a retryer of the provider. Throttling errors, e.g.
+// TooManyRequestsException, and retryableErrorCodes are retried with jittered
+// exponential backoff. The adaptive mode also limits the request rate on the
+// client side once the service throttles, and lifts the limit as requests
+// succeed again. The SDK's retry quota is disabled, so a burst of throttles
+// during a large plan is retried instead of surfacing as quota errors.
+func newRetryer(config retryConfig) aws.Retryer {
+	standardOptions := func(o *retry.StandardOptions) {
+		o.RateLimiter = ratelimit.None
+	}
+
+	var retryer aws.Retryer
+	if config.mode == aws.RetryModeStandard {
+		retryer = retry.NewStandard(standardOptions)
+	} else {
+		retryer = retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+			o.StandardOptions = append(o.StandardOptions, standardOptions)
+		})
+	}
+
+	retryer = retry.AddWithErrorCodes(retryer, retryableErrorCodes...)
+	retryer = retry.AddWithMaxAttempts(retryer, config.maxRetries+1)
+	return retry.AddWithMaxBackoffDelay(retryer, 10*time.Second)
+}
+
+// serviceRetryer returns the retryer shared by the clients of service.
+func (p *ProviderData) serviceRetryer(service string) aws.Retryer {
+	p.retryers.mu.Lock()
+	defer p.retryers.mu.Unlock()
+
+	if p.retryers.services == nil {
+		p.retryers.services = map[string]aws.Retryer{}
+	}
+
+	retryer, ok := p.retryers.services[service]
+	if !ok {
+		retryer = newRetryer(p.retry)
+		p.retryers.services[service] = retryer
+	}
+
+	return retryer
+}
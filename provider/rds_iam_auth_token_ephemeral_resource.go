@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &RdsIamAuthTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &RdsIamAuthTokenEphemeralResource{}
+
+// RDS IAM authentication tokens are presigned connect requests valid for 15
+// minutes, signed without a payload.
+const (
+	rdsIamAuthTokenExpiresIn = 15 * time.Minute
+	emptyPayloadHash         = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+func NewRdsIamAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &RdsIamAuthTokenEphemeralResource{}
+}
+
+type RdsIamAuthTokenEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type RdsIamAuthTokenEphemeralResourceModel struct {
+	Endpoint   types.String `tfsdk:"endpoint"`
+	Username   types.String `tfsdk:"username"`
+	Region     types.String `tfsdk:"region"`
+	Token      types.String `tfsdk:"token"`
+	Expiration types.String `tfsdk:"expiration"`
+}
+
+func (r *RdsIamAuthTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rds_iam_auth_token"
+}
+
+func (r *RdsIamAuthTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "RDS IAM authentication token, used as the password of a database user authenticating with IAM",
+
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Required:    true,
+				Description: "Endpoint of the database instance or proxy with its port, e.g. `mydb.123456789012.us-east-1.rds.amazonaws.com:5432`.",
+				Validators: []validator.String{
+					validHostPort(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "Database user the token authenticates.",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Region of the database. Defaults to the region of the provider.",
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp at which the token expires. New connections cannot be opened with it afterwards.",
+			},
+		},
+	}
+}
+
+func (r *RdsIamAuthTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *RdsIamAuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data RdsIamAuthTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg := r.providerData.awsConfig(nil)
+
+	region := cfg.Region
+	if data.Region.ValueString() != "" {
+		region = data.Region.ValueString()
+	}
+
+	credentials, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error generating RDS IAM Auth Token", "Could not retrieve the provider credentials", err))
+		return
+	}
+
+	now := time.Now()
+	token, err := buildRdsIamAuthToken(ctx, data.Endpoint.ValueString(), data.Username.ValueString(), region, credentials, now)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error generating RDS IAM Auth Token", "Could not sign the RDS IAM authentication token", err))
+		return
+	}
+
+	data.Token = types.StringValue(token)
+	data.Expiration = types.StringValue(now.Add(rdsIamAuthTokenExpiresIn).UTC().Format(time.RFC3339))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// buildRdsIamAuthToken presigns the rds-db connect request of a database
+// user, as done by the RDS auth feature of the SDK. The token is the
+// presigned URL without its scheme.
+func buildRdsIamAuthToken(ctx context.Context, endpoint string, username string, region string, credentials aws.Credentials, signingTime time.Time) (string, error) {
+	query := url.Values{
+		"Action":        {"connect"},
+		"DBUser":        {username},
+		"X-Amz-Expires": {fmt.Sprintf("%d", int(rdsIamAuthTokenExpiresIn.Seconds()))},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint+"/?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	signed, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, req, emptyPayloadHash, "rds-db", region, signingTime)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(signed, "https://"), nil
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Modes of a read only provider, see the read_only_mode provider attribute.
+const (
+	readOnlyModeError = "error"
+	readOnlyModeWarn  = "warn"
+)
+
+// readOnlyBlocked reports whether a change must be skipped because the
+// provider is read only. The change fails unless it can be a no-op and the
+// mode is warn, in which case a warning is reported instead. operation
+// describes the change, e.g. "update awsext_connect_agent_status".
+func (p *ProviderData) readOnlyBlocked(operation string, noOp bool, diags *diag.Diagnostics) bool {
+	if p == nil || !p.readOnly {
+		return false
+	}
+
+	if noOp && p.readOnlyMode == readOnlyModeWarn {
+		diags.AddWarning("Read Only Provider", fmt.Sprintf("The provider is read only and does not %s: no changes are made in AWS.", operation))
+	} else {
+		diags.AddError("Read Only Provider", fmt.Sprintf("The provider is read only and cannot %s. Set read_only to false in the provider configuration to apply changes.", operation))
+	}
+
+	return true
+}
+
+// readOnlyCreate reports whether Create must return without changes. Creates
+// always fail in read only mode, as there is nothing to record in the state
+// of a resource that was not created.
+func (p *ProviderData) readOnlyCreate(resourceType string, resp *resource.CreateResponse) bool {
+	return p.readOnlyBlocked("create awsext_"+resourceType, false, &resp.Diagnostics)
+}
+
+// readOnlyUpdate reports whether Update must return without changes. In warn
+// mode, the planned state is saved with unknown values taken from the prior
+// state, and the next refresh reports the values in AWS as drift.
+func (p *ProviderData) readOnlyUpdate(ctx context.Context, resourceType string, req resource.UpdateRequest, resp *resource.UpdateResponse) bool {
+	if !p.readOnlyBlocked("update awsext_"+resourceType, true, &resp.Diagnostics) {
+		return false
+	}
+
+	if resp.Diagnostics.HasError() {
+		return true
+	}
+
+	state, err := tftypes.Transform(req.Plan.Raw, func(attributePath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if value.IsKnown() {
+			return value, nil
+		}
+
+		prior, _, err := tftypes.WalkAttributePath(req.State.Raw, attributePath)
+		if priorValue, ok := prior.(tftypes.Value); err == nil && ok && priorValue.Type().Equal(value.Type()) {
+			return priorValue, nil
+		}
+
+		return tftypes.NewValue(value.Type(), nil), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error saving state", fmt.Sprintf("Could not save the planned state of the read only update: %s", err))
+		return true
+	}
+
+	resp.State.Raw = state
+
+	return true
+}
+
+// readOnlyDelete reports whether Delete must return without changes. In warn
+// mode, the resource is only removed from the state, as with skip_destroy.
+func (p *ProviderData) readOnlyDelete(resourceType string, resp *resource.DeleteResponse) bool {
+	return p.readOnlyBlocked("destroy awsext_"+resourceType, true, &resp.Diagnostics)
+}
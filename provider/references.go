@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// modifyPlanInstanceExists errors at plan time if the Connect instance of
+// the planned resource does not exist or failed to be created, when the
+// provider validate_references flag is set, instead of failing every resource
+// of the instance on apply. Instances still being created only warn, as they
+// may be active by the time the plan is applied.
+func (p *ProviderData) modifyPlanInstanceExists(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() || p == nil || !p.validateReferences {
+		return
+	}
+
+	var instanceID types.String
+	var override *OverrideModel
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("instance_id"), &instanceID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("override"), &override)...)
+
+	if resp.Diagnostics.HasError() || instanceID.IsUnknown() || instanceID.IsNull() {
+		return
+	}
+
+	if override != nil && (override.Region.IsUnknown() || override.RoleArn.IsUnknown()) {
+		return
+	}
+
+	instance, err := p.describeInstance(ctx, override, instanceID.ValueString())
+
+	switch {
+	case isNotFound(err):
+		resp.Diagnostics.AddAttributeError(path.Root("instance_id"), "Connect Instance Not Found", fmt.Sprintf("Connect instance %s not found in region %s.", instanceID.ValueString(), p.awsConfig(override).Region))
+	case err != nil:
+		resp.Diagnostics.Append(apiError("Error reading Connect Instance", fmt.Sprintf("Could not check that Connect instance %s exists", instanceID.ValueString()), err))
+	case instance.InstanceStatus == conntypes.InstanceStatusCreationInProgress:
+		resp.Diagnostics.AddAttributeWarning(path.Root("instance_id"), "Connect Instance Not Active", fmt.Sprintf("Connect instance %s is still being created, applying the plan fails if it is not active by then.", instanceID.ValueString()))
+	case instance.InstanceStatus != conntypes.InstanceStatusActive:
+		resp.Diagnostics.AddAttributeError(path.Root("instance_id"), "Connect Instance Not Active", fmt.Sprintf("Connect instance %s is %s, resources can only be managed in active instances.", instanceID.ValueString(), instance.InstanceStatus))
+	}
+}
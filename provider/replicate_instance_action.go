@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &ReplicateInstanceAction{}
+var _ action.ActionWithConfigure = &ReplicateInstanceAction{}
+
+func NewReplicateInstanceAction() action.Action {
+	return &ReplicateInstanceAction{}
+}
+
+type ReplicateInstanceAction struct {
+	providerData *ProviderData
+}
+
+type ReplicateInstanceActionModel struct {
+	InstanceID    types.String `tfsdk:"instance_id"`
+	ReplicaRegion types.String `tfsdk:"replica_region"`
+	ReplicaAlias  types.String `tfsdk:"replica_alias"`
+	Wait          types.Bool   `tfsdk:"wait"`
+}
+
+func (a *ReplicateInstanceAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_replicate_instance"
+}
+
+func (a *ReplicateInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Replicates a Connect instance to another region for Connect Global Resiliency",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID or ARN of the instance to replicate",
+				Validators: []validator.String{
+					validConnectInstanceIDOrArn(),
+				},
+			},
+			"replica_region": schema.StringAttribute{
+				Required:    true,
+				Description: "Region of the replica instance",
+			},
+			"replica_alias": schema.StringAttribute{
+				Required:    true,
+				Description: "Alias of the replica instance",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 45),
+				},
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait for the replica instance to become active, defaults to true",
+			},
+		},
+	}
+}
+
+func (a *ReplicateInstanceAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.providerData = providerData
+}
+
+func (a *ReplicateInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if a.providerData.readOnlyBlocked("invoke awsext_connect_replicate_instance", true, &resp.Diagnostics) {
+		return
+	}
+
+	var data ReplicateInstanceActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultActionTimeout)
+	defer cancel()
+
+	conn := a.providerData.connectClient(nil)
+
+	response, err := conn.ReplicateInstance(ctx, &connect.ReplicateInstanceInput{
+		InstanceId:    aws.String(data.InstanceID.ValueString()),
+		ReplicaRegion: aws.String(data.ReplicaRegion.ValueString()),
+		ReplicaAlias:  aws.String(data.ReplicaAlias.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error replicating Connect Instance", "Could not replicate Connect Instance", err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Replicating Connect Instance to %s as %s", data.ReplicaRegion.ValueString(), aws.ToString(response.Arn)),
+	})
+
+	if !data.Wait.IsNull() && !data.Wait.ValueBool() {
+		return
+	}
+
+	replicaConn := a.providerData.connectClient(&OverrideModel{Region: data.ReplicaRegion})
+
+	err = waitFor(ctx, defaultPollInterval, func(ctx context.Context) (bool, error) {
+		instance, err := replicaConn.DescribeInstance(ctx, &connect.DescribeInstanceInput{
+			InstanceId: response.Id,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		switch instance.Instance.InstanceStatus {
+		case conntypes.InstanceStatusActive:
+			return true, nil
+		case conntypes.InstanceStatusCreationFailed:
+			reason := "unknown reason"
+			if instance.Instance.StatusReason != nil {
+				reason = aws.ToString(instance.Instance.StatusReason.Message)
+			}
+			return false, fmt.Errorf("replica instance creation failed: %s", reason)
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for Connect Instance replica", fmt.Sprintf("Connect Instance replica %s did not become active: %s", aws.ToString(response.Arn), err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Connect Instance replica %s is active", aws.ToString(response.Arn)),
+	})
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RoutingProfileDataSource{}
+
+func NewRoutingProfileDataSource() datasource.DataSource {
+	return &RoutingProfileDataSource{}
+}
+
+type RoutingProfileDataSource struct {
+	providerData *ProviderData
+}
+
+type RoutingProfileDataSourceModel struct {
+	InstanceID               types.String            `tfsdk:"instance_id"`
+	InstanceAlias            types.String            `tfsdk:"instance_alias"`
+	Name                     types.String            `tfsdk:"name"`
+	RoutingProfileID         types.String            `tfsdk:"routing_profile_id"`
+	Arn                      types.String            `tfsdk:"arn"`
+	Description              types.String            `tfsdk:"description"`
+	DefaultOutboundQueueID   types.String            `tfsdk:"default_outbound_queue_id"`
+	AgentAvailabilityTimer   types.String            `tfsdk:"agent_availability_timer"`
+	MediaConcurrencies       []MediaConcurrencyModel `tfsdk:"media_concurrencies"`
+	IsDefault                types.Bool              `tfsdk:"is_default"`
+	NumberOfAssociatedQueues types.Int64             `tfsdk:"number_of_associated_queues"`
+	NumberOfAssociatedUsers  types.Int64             `tfsdk:"number_of_associated_users"`
+	Tags                     types.Map               `tfsdk:"tags"`
+}
+
+type MediaConcurrencyModel struct {
+	Channel              types.String `tfsdk:"channel"`
+	Concurrency          types.Int32  `tfsdk:"concurrency"`
+	CrossChannelBehavior types.String `tfsdk:"cross_channel_behavior"`
+}
+
+func (d *RoutingProfileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_routing_profile"
+}
+
+func (d *RoutingProfileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Connect routing profile by name",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the routing profile, matched exactly.",
+			},
+			"routing_profile_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"default_outbound_queue_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"agent_availability_timer": schema.StringAttribute{
+				Computed:    true,
+				Description: "Whether agents are routed contacts by the time since their last contact, TIME_SINCE_LAST_ACTIVITY, or since they were last routed one, TIME_SINCE_LAST_INBOUND.",
+			},
+			"media_concurrencies": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"channel": schema.StringAttribute{
+							Computed: true,
+						},
+						"concurrency": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of contacts of the channel an agent can handle at the same time.",
+						},
+						"cross_channel_behavior": schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether agents handling a contact of the channel can be routed contacts of other channels, ROUTE_ANY_CHANNEL or ROUTE_CURRENT_CHANNEL_ONLY.",
+						},
+					},
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the routing profile is the default routing profile of the instance.",
+			},
+			"number_of_associated_queues": schema.Int64Attribute{
+				Computed: true,
+			},
+			"number_of_associated_users": schema.Int64Attribute{
+				Computed: true,
+			},
+			"tags": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *RoutingProfileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *RoutingProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RoutingProfileDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.providerData.connectClient(nil)
+	name := data.Name.ValueString()
+
+	profiles, err := collectPages(ctx, listRoutingProfiles(conn, data.InstanceID.ValueString()), 0, func(profile conntypes.RoutingProfileSummary) bool {
+		return aws.ToString(profile.Name) == name
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Routing Profiles", fmt.Sprintf("Could not list the routing profiles to find routing profile %s", name), err))
+		return
+	}
+
+	if len(profiles) == 0 {
+		resp.Diagnostics.AddError("Connect Routing Profile Not Found", fmt.Sprintf("Instance %s has no routing profile named %q.", data.InstanceID.ValueString(), name))
+		return
+	}
+
+	response, err := conn.DescribeRoutingProfile(ctx, &connect.DescribeRoutingProfileInput{
+		InstanceId:       aws.String(data.InstanceID.ValueString()),
+		RoutingProfileId: profiles[0].Id,
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Routing Profile", fmt.Sprintf("Could not read routing profile %s", name), err))
+		return
+	}
+
+	profile := response.RoutingProfile
+	data.RoutingProfileID = types.StringPointerValue(profile.RoutingProfileId)
+	data.Arn = types.StringPointerValue(profile.RoutingProfileArn)
+	data.Description = types.StringPointerValue(profile.Description)
+	data.DefaultOutboundQueueID = types.StringPointerValue(profile.DefaultOutboundQueueId)
+	data.AgentAvailabilityTimer = optionalString(aws.String(string(profile.AgentAvailabilityTimer)))
+	data.IsDefault = types.BoolValue(profile.IsDefault)
+	data.NumberOfAssociatedQueues = types.Int64PointerValue(profile.NumberOfAssociatedQueues)
+	data.NumberOfAssociatedUsers = types.Int64PointerValue(profile.NumberOfAssociatedUsers)
+
+	data.MediaConcurrencies = []MediaConcurrencyModel{}
+	for _, concurrency := range profile.MediaConcurrencies {
+		model := MediaConcurrencyModel{
+			Channel:              types.StringValue(string(concurrency.Channel)),
+			Concurrency:          types.Int32PointerValue(concurrency.Concurrency),
+			CrossChannelBehavior: types.StringNull(),
+		}
+
+		if concurrency.CrossChannelBehavior != nil {
+			model.CrossChannelBehavior = types.StringValue(string(concurrency.CrossChannelBehavior.BehaviorType))
+		}
+
+		data.MediaConcurrencies = append(data.MediaConcurrencies, model)
+	}
+
+	tags, diags := types.MapValueFrom(ctx, types.StringType, ignoreTags(profile.Tags, d.providerData.IgnoreTags))
+	resp.Diagnostics.Append(diags...)
+	data.Tags = tags
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &RoutingProfileUserAssociationResource{}
+var _ resource.ResourceWithImportState = &RoutingProfileUserAssociationResource{}
+var _ resource.ResourceWithModifyPlan = &RoutingProfileUserAssociationResource{}
+
+// routingProfileUserAssociationResourceType is the type of the routing
+// profile user association resource without the provider prefix, e.g. in
+// operation_policies.
+const routingProfileUserAssociationResourceType = "connect_routing_profile_user_association"
+
+func NewRoutingProfileUserAssociationResource() resource.Resource {
+	return &RoutingProfileUserAssociationResource{}
+}
+
+type RoutingProfileUserAssociationResource struct {
+	providerData *ProviderData
+}
+
+type RoutingProfileUserAssociationResourceModel struct {
+	InstanceID               types.String   `tfsdk:"instance_id"`
+	InstanceAlias            types.String   `tfsdk:"instance_alias"`
+	RoutingProfileID         types.String   `tfsdk:"routing_profile_id"`
+	UserIDs                  types.Set      `tfsdk:"user_ids"`
+	FallbackRoutingProfileID types.String   `tfsdk:"fallback_routing_profile_id"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	Override                 *OverrideModel `tfsdk:"override"`
+}
+
+func (r *RoutingProfileUserAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + routingProfileUserAssociationResourceType
+}
+
+func (r *RoutingProfileUserAssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns a set of users to a Connect routing profile",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"routing_profile_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the users assigned to the routing profile. Other users of the routing profile are not managed.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"fallback_routing_profile_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Routing profile the users removed from user_ids, or all users on destroy, are assigned to. Users always have a routing profile, so they keep this one if it is not set.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *RoutingProfileUserAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *RoutingProfileUserAssociationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *RoutingProfileUserAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(routingProfileUserAssociationResourceType, resp) {
+		return
+	}
+
+	var data RoutingProfileUserAssociationResourceModel
+	var userIDs []string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.UserIDs.ElementsAs(ctx, &userIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(routingProfileUserAssociationResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(routingProfileUserAssociationResourceType, data.Override)
+	assigned, err := assignRoutingProfile(ctx, conn, data.InstanceID.ValueString(), data.RoutingProfileID.ValueString(), userIDs)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error assigning Connect Routing Profile", "Could not assign the routing profile to all users", err))
+	}
+
+	// Save data into Terraform state, including the users assigned before an
+	// error
+	data.UserIDs, diags = types.SetValueFrom(ctx, types.StringType, assigned)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// assignRoutingProfile assigns the routing profile to the users concurrently
+// and returns the users successfully assigned, even on error.
+func assignRoutingProfile(ctx context.Context, conn *connect.Client, instanceID, routingProfileID string, userIDs []string) ([]string, error) {
+	var mu sync.Mutex
+	assigned := []string{}
+
+	err := forEachConcurrently(userIDs, userOperationConcurrency, func(userID string) error {
+		_, err := conn.UpdateUserRoutingProfile(ctx, &connect.UpdateUserRoutingProfileInput{
+			InstanceId:       aws.String(instanceID),
+			UserId:           aws.String(userID),
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+		if err != nil {
+			return fmt.Errorf("assigning user %s: %w", userID, err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		assigned = append(assigned, userID)
+
+		return nil
+	})
+
+	slices.Sort(assigned)
+
+	return assigned, err
+}
+
+func (r *RoutingProfileUserAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoutingProfileUserAssociationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(routingProfileUserAssociationResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(routingProfileUserAssociationResourceType, data.Override)
+	criteria := &conntypes.UserSearchCriteria{
+		StringCondition: stringCondition("RoutingProfileId", conntypes.StringComparisonTypeExact, data.RoutingProfileID.ValueString()),
+	}
+
+	users, err := collectPages(ctx, searchUsers(conn, data.InstanceID.ValueString(), criteria), 0, nil)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error searching Connect Users", "Could not search the users of the routing profile", err))
+		return
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(data.UserIDs.ElementsAs(ctx, &managed, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// On import, all users of the routing profile are managed
+	userIDs := []string{}
+	for _, user := range users {
+		userID := aws.ToString(user.Id)
+		if aws.ToString(user.RoutingProfileId) == data.RoutingProfileID.ValueString() && (data.UserIDs.IsNull() || slices.Contains(managed, userID)) {
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	if len(userIDs) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.UserIDs, diags = types.SetValueFrom(ctx, types.StringType, userIDs)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoutingProfileUserAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, routingProfileUserAssociationResourceType, req, resp) {
+		return
+	}
+
+	var data, state RoutingProfileUserAssociationResourceModel
+	var planned, prior []string
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(data.UserIDs.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.UserIDs.ElementsAs(ctx, &prior, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(routingProfileUserAssociationResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(routingProfileUserAssociationResourceType, data.Override)
+	instanceID := data.InstanceID.ValueString()
+
+	var added, removed []string
+	for _, userID := range planned {
+		if !slices.Contains(prior, userID) {
+			added = append(added, userID)
+		}
+	}
+	for _, userID := range prior {
+		if !slices.Contains(planned, userID) {
+			removed = append(removed, userID)
+		}
+	}
+
+	// The users are updated from the prior state, so users whose change
+	// failed keep their prior assignment
+	userIDs := slices.DeleteFunc(slices.Clone(prior), func(userID string) bool {
+		return slices.Contains(removed, userID)
+	})
+
+	assigned, err := assignRoutingProfile(ctx, conn, instanceID, data.RoutingProfileID.ValueString(), added)
+	userIDs = append(userIDs, assigned...)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error assigning Connect Routing Profile", "Could not assign the routing profile to all users", err))
+	}
+
+	if !data.FallbackRoutingProfileID.IsNull() {
+		unassigned, err := assignRoutingProfile(ctx, conn, instanceID, data.FallbackRoutingProfileID.ValueString(), removed)
+
+		for _, userID := range removed {
+			if !slices.Contains(unassigned, userID) {
+				userIDs = append(userIDs, userID)
+			}
+		}
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error assigning Connect Routing Profile", "Could not assign the fallback routing profile to all removed users", err))
+		}
+	}
+
+	// Save updated data into Terraform state
+	data.UserIDs, diags = types.SetValueFrom(ctx, types.StringType, userIDs)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoutingProfileUserAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(routingProfileUserAssociationResourceType, resp) {
+		return
+	}
+
+	var data RoutingProfileUserAssociationResourceModel
+	var userIDs []string
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.UserIDs.ElementsAs(ctx, &userIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Users always have a routing profile, so they can only be moved
+	if data.FallbackRoutingProfileID.IsNull() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(routingProfileUserAssociationResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(routingProfileUserAssociationResourceType, data.Override)
+	unassigned, err := assignRoutingProfile(ctx, conn, data.InstanceID.ValueString(), data.FallbackRoutingProfileID.ValueString(), userIDs)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error assigning Connect Routing Profile", "Could not assign the fallback routing profile to all users", err))
+
+		// Keep the users still assigned in the state
+		remaining := slices.DeleteFunc(userIDs, func(userID string) bool {
+			return slices.Contains(unassigned, userID)
+		})
+
+		data.UserIDs, diags = types.SetValueFrom(ctx, types.StringType, remaining)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *RoutingProfileUserAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with format <instance_id>:<routing_profile_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	// All users of the routing profile are imported
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("routing_profile_id"), parts[1])...)
+}
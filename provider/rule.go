@@ -0,0 +1,557 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RuleResource{}
+var _ resource.ResourceWithImportState = &RuleResource{}
+var _ resource.ResourceWithModifyPlan = &RuleResource{}
+var _ resource.ResourceWithIdentity = &RuleResource{}
+var _ resource.ResourceWithConfigValidators = &RuleResource{}
+
+// ruleResourceType is the type of the rule resource without the provider
+// prefix, e.g. in operation_policies.
+const ruleResourceType = "connect_rule"
+
+func NewRuleResource() resource.Resource {
+	return &RuleResource{}
+}
+
+type RuleResource struct {
+	providerData *ProviderData
+}
+
+type RuleResourceModel struct {
+	Arn                   types.String                 `tfsdk:"arn"`
+	RuleID                types.String                 `tfsdk:"rule_id"`
+	InstanceID            types.String                 `tfsdk:"instance_id"`
+	InstanceAlias         types.String                 `tfsdk:"instance_alias"`
+	Name                  types.String                 `tfsdk:"name"`
+	TriggerEventSource    *RuleTriggerEventSourceModel `tfsdk:"trigger_event_source"`
+	Function              NormalizedJSON               `tfsdk:"function"`
+	TaskActions           []RuleTaskActionModel        `tfsdk:"task_actions"`
+	EventBridgeActions    []RuleEventBridgeActionModel `tfsdk:"event_bridge_actions"`
+	AssignContactCategory types.Bool                   `tfsdk:"assign_contact_category"`
+	PublishStatus         types.String                 `tfsdk:"publish_status"`
+	Timeouts              timeouts.Value               `tfsdk:"timeouts"`
+	Override              *OverrideModel               `tfsdk:"override"`
+}
+
+// RuleTriggerEventSourceModel describes the trigger_event_source of a rule.
+type RuleTriggerEventSourceModel struct {
+	EventSourceName          types.String `tfsdk:"event_source_name"`
+	IntegrationAssociationID types.String `tfsdk:"integration_association_id"`
+}
+
+// RuleTaskActionModel describes a CREATE_TASK action of a rule.
+type RuleTaskActionModel struct {
+	Name          types.String                      `tfsdk:"name"`
+	Description   types.String                      `tfsdk:"description"`
+	ContactFlowID types.String                      `tfsdk:"contact_flow_id"`
+	References    map[string]RuleTaskReferenceModel `tfsdk:"references"`
+}
+
+// RuleTaskReferenceModel describes a reference of the tasks created by a
+// rule, e.g. a URL shown to the agent.
+type RuleTaskReferenceModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+// RuleEventBridgeActionModel describes a GENERATE_EVENTBRIDGE_EVENT action
+// of a rule.
+type RuleEventBridgeActionModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type RuleResourceIdentityModel struct {
+	Arn    types.String `tfsdk:"arn"`
+	RuleID types.String `tfsdk:"rule_id"`
+}
+
+func (m RuleResourceModel) identity() RuleResourceIdentityModel {
+	return RuleResourceIdentityModel{
+		Arn:    m.Arn,
+		RuleID: m.RuleID,
+	}
+}
+
+// actions returns the actions of the rule, grouped by type.
+func (m RuleResourceModel) actions() []conntypes.RuleAction {
+	actions := []conntypes.RuleAction{}
+
+	for _, task := range m.TaskActions {
+		references := map[string]conntypes.Reference{}
+		for name, reference := range task.References {
+			references[name] = conntypes.Reference{
+				Type:  conntypes.ReferenceType(reference.Type.ValueString()),
+				Value: aws.String(reference.Value.ValueString()),
+			}
+		}
+
+		action := conntypes.RuleAction{
+			ActionType: conntypes.ActionTypeCreateTask,
+			TaskAction: &conntypes.TaskActionDefinition{
+				Name:          aws.String(task.Name.ValueString()),
+				ContactFlowId: aws.String(task.ContactFlowID.ValueString()),
+				References:    references,
+			},
+		}
+
+		if !task.Description.IsNull() {
+			action.TaskAction.Description = aws.String(task.Description.ValueString())
+		}
+
+		actions = append(actions, action)
+	}
+
+	for _, event := range m.EventBridgeActions {
+		actions = append(actions, conntypes.RuleAction{
+			ActionType: conntypes.ActionTypeGenerateEventbridgeEvent,
+			EventBridgeAction: &conntypes.EventBridgeActionDefinition{
+				Name: aws.String(event.Name.ValueString()),
+			},
+		})
+	}
+
+	if m.AssignContactCategory.ValueBool() {
+		actions = append(actions, conntypes.RuleAction{
+			ActionType:                  conntypes.ActionTypeAssignContactCategory,
+			AssignContactCategoryAction: &conntypes.AssignContactCategoryActionDefinition{},
+		})
+	}
+
+	return actions
+}
+
+func (r *RuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + ruleResourceType
+}
+
+func (r *RuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = connectIdentitySchema("rule_id")
+}
+
+func (r *RuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	eventSourceNames := []string{}
+	for _, eventSourceName := range conntypes.EventSourceName("").Values() {
+		eventSourceNames = append(eventSourceNames, string(eventSourceName))
+	}
+
+	referenceTypes := []string{}
+	for _, referenceType := range conntypes.ReferenceType("").Values() {
+		referenceTypes = append(referenceTypes, string(referenceType))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connect rule, e.g. a Contact Lens rule, run when its trigger event matches its function. Only task, EventBridge and contact category actions are supported",
+
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rule_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"trigger_event_source": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Event triggering the rule. Changing it replaces the rule.",
+				Attributes: map[string]schema.Attribute{
+					"event_source_name": schema.StringAttribute{
+						Required:    true,
+						Description: "Name of the event source, e.g. OnPostCallAnalysisAvailable or OnRealTimeCallAnalysisAvailable.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(eventSourceNames...),
+						},
+					},
+					"integration_association_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "ID of the integration association of third party event sources, e.g. OnZendeskTicketCreate.",
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"function": schema.StringAttribute{
+				CustomType:  NormalizedJSONType{},
+				Required:    true,
+				Description: "Conditions of the rule, as JSON in the rule function language. Differences in key ordering or whitespace are ignored.",
+			},
+			"task_actions": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Tasks created when the rule is triggered.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the task.",
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Description: "Description of the task.",
+						},
+						"contact_flow_id": schema.StringAttribute{
+							Required:    true,
+							Description: "ID of the flow run for the task.",
+						},
+						"references": schema.MapNestedAttribute{
+							Optional:    true,
+							Description: "References shown to the agent in the task, by name.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required:    true,
+										Description: "Type of the reference, e.g. URL or STRING.",
+										Validators: []validator.String{
+											stringvalidator.OneOf(referenceTypes...),
+										},
+									},
+									"value": schema.StringAttribute{
+										Required:    true,
+										Description: "Value of the reference, which can contain $.ContactLens and other rule placeholders.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"event_bridge_actions": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "EventBridge events generated when the rule is triggered.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the event.",
+						},
+					},
+				},
+			},
+			"assign_contact_category": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Assign the name of the rule as a category of the contacts matching it.",
+			},
+			"publish_status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(conntypes.RulePublishStatusPublished)),
+				Description: "PUBLISHED to run the rule, or DRAFT to only save it.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(conntypes.RulePublishStatusPublished), string(conntypes.RulePublishStatusDraft)),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *RuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		// The API requires at least one action
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("task_actions"),
+			path.MatchRoot("event_bridge_actions"),
+			path.MatchRoot("assign_contact_category"),
+		),
+	}
+}
+
+func (r *RuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *RuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *RuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(ruleResourceType, resp) {
+		return
+	}
+
+	var data RuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(ruleResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	triggerEventSource := &conntypes.RuleTriggerEventSource{
+		EventSourceName: conntypes.EventSourceName(data.TriggerEventSource.EventSourceName.ValueString()),
+	}
+
+	if !data.TriggerEventSource.IntegrationAssociationID.IsNull() {
+		triggerEventSource.IntegrationAssociationId = aws.String(data.TriggerEventSource.IntegrationAssociationID.ValueString())
+	}
+
+	conn := r.providerData.resourceConnectClient(ruleResourceType, data.Override)
+	response, err := conn.CreateRule(ctx, &connect.CreateRuleInput{
+		InstanceId:         aws.String(data.InstanceID.ValueString()),
+		Name:               aws.String(data.Name.ValueString()),
+		TriggerEventSource: triggerEventSource,
+		Function:           aws.String(data.Function.ValueString()),
+		Actions:            data.actions(),
+		PublishStatus:      conntypes.RulePublishStatus(data.PublishStatus.ValueString()),
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect Rule", "Could not create Connect Rule", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	data.RuleID = types.StringValue(aws.ToString(response.RuleId))
+	data.Arn = types.StringValue(aws.ToString(response.RuleArn))
+
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *RuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(ruleResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(ruleResourceType, data.Override)
+	response, err := conn.DescribeRule(ctx, &connect.DescribeRuleInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		RuleId:     aws.String(data.RuleID.ValueString()),
+	})
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Rule", "Could not read Connect Rule", err))
+		return
+	}
+
+	rule := response.Rule
+
+	data.RuleID = types.StringValue(aws.ToString(rule.RuleId))
+	data.Arn = types.StringValue(aws.ToString(rule.RuleArn))
+	data.Name = types.StringValue(aws.ToString(rule.Name))
+	data.PublishStatus = types.StringValue(string(rule.PublishStatus))
+
+	// The function is kept as configured when it only differs by
+	// formatting, by the semantic equality of NormalizedJSON
+	data.Function = NormalizedJSON{StringValue: types.StringValue(aws.ToString(rule.Function))}
+
+	if rule.TriggerEventSource != nil {
+		data.TriggerEventSource = &RuleTriggerEventSourceModel{
+			EventSourceName:          types.StringValue(string(rule.TriggerEventSource.EventSourceName)),
+			IntegrationAssociationID: optionalString(rule.TriggerEventSource.IntegrationAssociationId),
+		}
+	}
+
+	data.flattenActions(rule.Actions)
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+// flattenActions sets the actions of the model from the actions of a rule.
+// Actions of other types, e.g. added in the console, are ignored.
+func (m *RuleResourceModel) flattenActions(actions []conntypes.RuleAction) {
+	m.TaskActions = nil
+	m.EventBridgeActions = nil
+	m.AssignContactCategory = types.BoolValue(false)
+
+	for _, action := range actions {
+		switch {
+		case action.ActionType == conntypes.ActionTypeCreateTask && action.TaskAction != nil:
+			task := RuleTaskActionModel{
+				Name:          types.StringValue(aws.ToString(action.TaskAction.Name)),
+				Description:   optionalString(action.TaskAction.Description),
+				ContactFlowID: types.StringValue(aws.ToString(action.TaskAction.ContactFlowId)),
+			}
+
+			if len(action.TaskAction.References) > 0 {
+				task.References = map[string]RuleTaskReferenceModel{}
+				for name, reference := range action.TaskAction.References {
+					task.References[name] = RuleTaskReferenceModel{
+						Type:  types.StringValue(string(reference.Type)),
+						Value: types.StringValue(aws.ToString(reference.Value)),
+					}
+				}
+			}
+
+			m.TaskActions = append(m.TaskActions, task)
+		case action.ActionType == conntypes.ActionTypeGenerateEventbridgeEvent && action.EventBridgeAction != nil:
+			m.EventBridgeActions = append(m.EventBridgeActions, RuleEventBridgeActionModel{
+				Name: types.StringValue(aws.ToString(action.EventBridgeAction.Name)),
+			})
+		case action.ActionType == conntypes.ActionTypeAssignContactCategory:
+			m.AssignContactCategory = types.BoolValue(true)
+		}
+	}
+}
+
+func (r *RuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, ruleResourceType, req, resp) {
+		return
+	}
+
+	var data RuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(ruleResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	// The rule is replaced as a whole, except for its trigger
+	conn := r.providerData.resourceConnectClient(ruleResourceType, data.Override)
+	_, err := conn.UpdateRule(ctx, &connect.UpdateRuleInput{
+		InstanceId:    aws.String(data.InstanceID.ValueString()),
+		RuleId:        aws.String(data.RuleID.ValueString()),
+		Name:          aws.String(data.Name.ValueString()),
+		Function:      aws.String(data.Function.ValueString()),
+		Actions:       data.actions(),
+		PublishStatus: conntypes.RulePublishStatus(data.PublishStatus.ValueString()),
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error updating Connect Rule", "Could not update Connect Rule", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(ruleResourceType, resp) {
+		return
+	}
+
+	var data RuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(ruleResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(ruleResourceType, data.Override)
+	_, err := conn.DeleteRule(ctx, &connect.DeleteRuleInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		RuleId:     aws.String(data.RuleID.ValueString()),
+	})
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error deleting Connect Rule", "Could not delete Connect Rule", err))
+	}
+}
+
+func (r *RuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importConnectResource(ctx, req, resp, "rule", "rule_id")
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &S3PresignedURLEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &S3PresignedURLEphemeralResource{}
+
+func NewS3PresignedURLEphemeralResource() ephemeral.EphemeralResource {
+	return &S3PresignedURLEphemeralResource{}
+}
+
+type S3PresignedURLEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type S3PresignedURLEphemeralResourceModel struct {
+	Bucket        types.String `tfsdk:"bucket"`
+	Key           types.String `tfsdk:"key"`
+	Method        types.String `tfsdk:"method"`
+	ExpiresIn     types.Int64  `tfsdk:"expires_in"`
+	ContentType   types.String `tfsdk:"content_type"`
+	URL           types.String `tfsdk:"url"`
+	SignedHeaders types.Map    `tfsdk:"signed_headers"`
+	Expiration    types.String `tfsdk:"expiration"`
+}
+
+func (r *S3PresignedURLEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_presigned_url"
+}
+
+func (r *S3PresignedURLEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Presigned S3 object URL",
+
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required: true,
+			},
+			"key": schema.StringAttribute{
+				Required: true,
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Description: "HTTP method the URL is signed for, `GET` or `PUT`. Defaults to `GET`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("GET", "PUT"),
+				},
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of seconds the URL is valid for. Defaults to 900.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 604800),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Content type the upload must be sent with. Only used with `PUT`.",
+			},
+			"url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"signed_headers": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Headers that must be sent along with the request.",
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp at which the URL expires.",
+			},
+		},
+	}
+}
+
+func (r *S3PresignedURLEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *S3PresignedURLEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data S3PresignedURLEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresIn := 900 * time.Second
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	presigner := s3.NewPresignClient(r.providerData.s3Client(), s3.WithPresignExpires(expiresIn))
+
+	var request *v4.PresignedHTTPRequest
+	var err error
+
+	if data.Method.ValueString() == "PUT" {
+		input := &s3.PutObjectInput{
+			Bucket: aws.String(data.Bucket.ValueString()),
+			Key:    aws.String(data.Key.ValueString()),
+		}
+
+		if data.ContentType.ValueString() != "" {
+			input.ContentType = aws.String(data.ContentType.ValueString())
+		}
+
+		request, err = presigner.PresignPutObject(ctx, input)
+	} else {
+		request, err = presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(data.Bucket.ValueString()),
+			Key:    aws.String(data.Key.ValueString()),
+		})
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error presigning S3 URL", "Could not presign S3 URL", err))
+		return
+	}
+
+	headers := map[string]string{}
+	for name, values := range request.SignedHeader {
+		// Host is implied by the URL itself
+		if strings.EqualFold(name, "host") {
+			continue
+		}
+		headers[name] = strings.Join(values, ",")
+	}
+
+	signedHeaders, diags := types.MapValueFrom(ctx, types.StringType, headers)
+	resp.Diagnostics.Append(diags...)
+
+	data.URL = types.StringValue(request.URL)
+	data.SignedHeaders = signedHeaders
+	data.Expiration = types.StringValue(time.Now().Add(expiresIn).UTC().Format(time.RFC3339))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
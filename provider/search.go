@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+)
+
+// searchPageSize is the largest page size accepted by the Connect Search*
+// APIs.
+const searchPageSize = 100
+
+// stringCondition returns the leaf condition of the search criteria of a
+// Connect Search* API. Searches are not case sensitive, so callers matching
+// names exactly must still compare the results.
+func stringCondition(fieldName string, comparison conntypes.StringComparisonType, value string) *conntypes.StringCondition {
+	return &conntypes.StringCondition{
+		ComparisonType: comparison,
+		FieldName:      aws.String(fieldName),
+		Value:          aws.String(value),
+	}
+}
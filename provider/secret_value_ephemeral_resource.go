@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &SecretValueEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &SecretValueEphemeralResource{}
+
+func NewSecretValueEphemeralResource() ephemeral.EphemeralResource {
+	return &SecretValueEphemeralResource{}
+}
+
+type SecretValueEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type SecretValueEphemeralResourceModel struct {
+	SecretID     types.String `tfsdk:"secret_id"`
+	VersionID    types.String `tfsdk:"version_id"`
+	VersionStage types.String `tfsdk:"version_stage"`
+	Arn          types.String `tfsdk:"arn"`
+	Name         types.String `tfsdk:"name"`
+	SecretString types.String `tfsdk:"secret_string"`
+	SecretBinary types.String `tfsdk:"secret_binary"`
+}
+
+func (r *SecretValueEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secretsmanager_secret_value"
+}
+
+func (r *SecretValueEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Secrets Manager secret value",
+
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ARN or name of the secret.",
+			},
+			"version_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Version of the secret to retrieve.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("version_stage")),
+				},
+			},
+			"version_stage": schema.StringAttribute{
+				Optional:    true,
+				Description: "Staging label of the version to retrieve. Defaults to `AWSCURRENT`.",
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"secret_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"secret_binary": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded binary secret.",
+			},
+		},
+	}
+}
+
+func (r *SecretValueEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *SecretValueEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SecretValueEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.providerData.secretsManagerClient()
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(data.SecretID.ValueString()),
+	}
+
+	if data.VersionID.ValueString() != "" {
+		input.VersionId = aws.String(data.VersionID.ValueString())
+	}
+
+	if data.VersionStage.ValueString() != "" {
+		input.VersionStage = aws.String(data.VersionStage.ValueString())
+	}
+
+	response, err := conn.GetSecretValue(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Secrets Manager Secret Value", "Could not read Secrets Manager Secret Value", err))
+		return
+	}
+
+	data.Arn = types.StringValue(aws.ToString(response.ARN))
+	data.Name = types.StringValue(aws.ToString(response.Name))
+	data.VersionID = types.StringValue(aws.ToString(response.VersionId))
+	data.SecretString = types.StringPointerValue(response.SecretString)
+
+	if response.SecretBinary != nil {
+		data.SecretBinary = types.StringValue(base64.StdEncoding.EncodeToString(response.SecretBinary))
+	}
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,520 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SecurityProfileResource{}
+var _ resource.ResourceWithImportState = &SecurityProfileResource{}
+var _ resource.ResourceWithModifyPlan = &SecurityProfileResource{}
+var _ resource.ResourceWithIdentity = &SecurityProfileResource{}
+var _ resource.ResourceWithMoveState = &SecurityProfileResource{}
+
+// securityProfileResourceType is the type of the security profile resource
+// without the provider prefix, e.g. in operation_policies.
+const securityProfileResourceType = "connect_security_profile"
+
+// restrictableResources are the resources whose access a security profile can
+// restrict by tags or by hierarchy.
+var restrictableResources = []string{"User", "SecurityProfile", "Queue", "RoutingProfile", "Contact"}
+
+func NewSecurityProfileResource() resource.Resource {
+	return &SecurityProfileResource{}
+}
+
+type SecurityProfileResource struct {
+	providerData *ProviderData
+}
+
+type SecurityProfileResourceModel struct {
+	Arn                                  types.String   `tfsdk:"arn"`
+	SecurityProfileID                    types.String   `tfsdk:"security_profile_id"`
+	InstanceID                           types.String   `tfsdk:"instance_id"`
+	InstanceAlias                        types.String   `tfsdk:"instance_alias"`
+	Name                                 types.String   `tfsdk:"name"`
+	Description                          NullableString `tfsdk:"description"`
+	Permissions                          types.Set      `tfsdk:"permissions"`
+	AllowedAccessControlTags             types.Map      `tfsdk:"allowed_access_control_tags"`
+	TagRestrictedResources               types.Set      `tfsdk:"tag_restricted_resources"`
+	AllowedAccessControlHierarchyGroupID types.String   `tfsdk:"allowed_access_control_hierarchy_group_id"`
+	HierarchyRestrictedResources         types.Set      `tfsdk:"hierarchy_restricted_resources"`
+	Tags                                 types.Map      `tfsdk:"tags"`
+	TagsAll                              types.Map      `tfsdk:"tags_all"`
+	Timeouts                             timeouts.Value `tfsdk:"timeouts"`
+	Override                             *OverrideModel `tfsdk:"override"`
+}
+
+type SecurityProfileResourceIdentityModel struct {
+	Arn               types.String `tfsdk:"arn"`
+	SecurityProfileID types.String `tfsdk:"security_profile_id"`
+}
+
+func (m SecurityProfileResourceModel) identity() SecurityProfileResourceIdentityModel {
+	return SecurityProfileResourceIdentityModel{
+		Arn:               m.Arn,
+		SecurityProfileID: m.SecurityProfileID,
+	}
+}
+
+func (r *SecurityProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + securityProfileResourceType
+}
+
+func (r *SecurityProfileResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = connectIdentitySchema("security_profile_id")
+}
+
+func (r *SecurityProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connect security profile resource, updating only the permissions added or removed by the configuration",
+
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_profile_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the security profile. Changing it replaces the security profile.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 127),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				CustomType: NullableStringType{},
+				Optional:   true,
+				Validators: []validator.String{
+					// Empty is allowed and the same as no description
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Permissions granted by the security profile, e.g. BasicAgentAccess, see the awsext_connect_security_profile_permissions_catalog data source. Only the permissions added or removed by the configuration are changed, permissions granted concurrently outside Terraform are kept until the next apply.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(500),
+				},
+			},
+			"allowed_access_control_tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Tags the resources listed in tag_restricted_resources must have to be accessed by users of the security profile.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtMost(4),
+				},
+			},
+			"tag_restricted_resources": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Resources whose access is restricted by allowed_access_control_tags, e.g. User or Queue.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(restrictableResources...)),
+				},
+			},
+			"allowed_access_control_hierarchy_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the hierarchy group the resources listed in hierarchy_restricted_resources must belong to, at any level, to be accessed by users of the security profile.",
+			},
+			"hierarchy_restricted_resources": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Resources whose access is restricted by allowed_access_control_hierarchy_group_id, e.g. User.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(restrictableResources...)),
+				},
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *SecurityProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *SecurityProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanTags(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *SecurityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(securityProfileResourceType, resp) {
+		return
+	}
+
+	var data SecurityProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	tagsAll, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	access, diags := data.accessControl(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(securityProfileResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(securityProfileResourceType, data.Override)
+	input := &connect.CreateSecurityProfileInput{
+		InstanceId:                           aws.String(data.InstanceID.ValueString()),
+		SecurityProfileName:                  aws.String(data.Name.ValueString()),
+		Description:                          aws.String(data.Description.ValueString()),
+		Permissions:                          access.permissions,
+		AllowedAccessControlTags:             access.allowedAccessControlTags,
+		TagRestrictedResources:               access.tagRestrictedResources,
+		AllowedAccessControlHierarchyGroupId: data.AllowedAccessControlHierarchyGroupID.ValueStringPointer(),
+		HierarchyRestrictedResources:         access.hierarchyRestrictedResources,
+	}
+
+	if len(tagsAll) > 0 {
+		input.Tags = tagsAll
+	}
+
+	response, err := conn.CreateSecurityProfile(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect Security Profile", "Could not create Connect Security Profile", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	data.SecurityProfileID = types.StringValue(aws.ToString(response.SecurityProfileId))
+	data.Arn = types.StringValue(aws.ToString(response.SecurityProfileArn))
+
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *SecurityProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(securityProfileResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(securityProfileResourceType, data.Override)
+	response, err := conn.DescribeSecurityProfile(ctx, &connect.DescribeSecurityProfileInput{
+		InstanceId:        aws.String(data.InstanceID.ValueString()),
+		SecurityProfileId: aws.String(data.SecurityProfileID.ValueString()),
+	})
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Security Profile", "Could not read Connect Security Profile", err))
+		return
+	}
+
+	permissions, err := collectPages(ctx, listSecurityProfilePermissions(conn, data.InstanceID.ValueString(), data.SecurityProfileID.ValueString()), 0, nil)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect Security Profile", "Could not list the permissions of Connect Security Profile", err))
+		return
+	}
+
+	profile := response.SecurityProfile
+
+	data.SecurityProfileID = types.StringValue(aws.ToString(profile.Id))
+	data.Arn = types.StringValue(aws.ToString(profile.Arn))
+	data.Name = types.StringValue(aws.ToString(profile.SecurityProfileName))
+	data.Description = flattenNullableString(ctx, data.Description, profile.Description)
+	data.AllowedAccessControlHierarchyGroupID = optionalString(profile.AllowedAccessControlHierarchyGroupId)
+
+	data.Permissions, diags = flattenOptionalStringSet(ctx, data.Permissions, permissions)
+	resp.Diagnostics.Append(diags...)
+	data.TagRestrictedResources, diags = flattenOptionalStringSet(ctx, data.TagRestrictedResources, profile.TagRestrictedResources)
+	resp.Diagnostics.Append(diags...)
+	data.HierarchyRestrictedResources, diags = flattenOptionalStringSet(ctx, data.HierarchyRestrictedResources, profile.HierarchyRestrictedResources)
+	resp.Diagnostics.Append(diags...)
+
+	if len(profile.AllowedAccessControlTags) > 0 || !data.AllowedAccessControlTags.IsNull() {
+		data.AllowedAccessControlTags, diags = types.MapValueFrom(ctx, types.StringType, profile.AllowedAccessControlTags)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.Tags, data.TagsAll, diags = r.providerData.readTags(ctx, profile.Tags, data.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+// flattenOptionalStringSet returns the value of an optional set attribute
+// from strings returned by the API, null when there are none and the prior
+// value is null so that unset attributes have no drift.
+func flattenOptionalStringSet(ctx context.Context, prior types.Set, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 && prior.IsNull() {
+		return prior, nil
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, append([]string{}, values...))
+}
+
+func (r *SecurityProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, securityProfileResourceType, req, resp) {
+		return
+	}
+
+	var data, state SecurityProfileResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	access, diags := data.accessControl(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	prior, diags := state.accessControl(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(securityProfileResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(securityProfileResourceType, data.Override)
+	input := &connect.UpdateSecurityProfileInput{
+		InstanceId:                           aws.String(data.InstanceID.ValueString()),
+		SecurityProfileId:                    aws.String(data.SecurityProfileID.ValueString()),
+		Description:                          aws.String(data.Description.ValueString()),
+		AllowedAccessControlTags:             access.allowedAccessControlTags,
+		TagRestrictedResources:               access.tagRestrictedResources,
+		AllowedAccessControlHierarchyGroupId: aws.String(data.AllowedAccessControlHierarchyGroupID.ValueString()),
+		HierarchyRestrictedResources:         access.hierarchyRestrictedResources,
+	}
+
+	added, removed := stringSetDelta(prior.permissions, access.permissions)
+
+	// Permissions are omitted, and left unchanged, unless the configuration
+	// adds or removes some. The delta is then applied to the permissions in
+	// AWS rather than replacing them with the configured ones.
+	if len(added) > 0 || len(removed) > 0 {
+		current, err := collectPages(ctx, listSecurityProfilePermissions(conn, data.InstanceID.ValueString(), data.SecurityProfileID.ValueString()), 0, nil)
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Security Profile", "Could not list the permissions of Connect Security Profile", err))
+			return
+		}
+
+		tflog.Debug(ctx, "Updating Connect Security Profile permissions", map[string]interface{}{
+			"added":   added,
+			"removed": removed,
+		})
+
+		input.Permissions = applyStringSetDelta(current, added, removed)
+	}
+
+	if _, err := conn.UpdateSecurityProfile(ctx, input); err != nil {
+		resp.Diagnostics.Append(apiError("Error updating Connect Security Profile", "Could not update Connect Security Profile", err))
+		return
+	}
+
+	if !data.TagsAll.Equal(state.TagsAll) {
+		oldTags, diags := mapFromTags(ctx, state.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		newTags, diags := mapFromTags(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), oldTags, newTags); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect Security Profile tags", "Could not update Connect Security Profile tags", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// stringSetDelta returns the strings of to missing from from, and the strings
+// of from missing from to.
+func stringSetDelta(from, to []string) (added, removed []string) {
+	for _, value := range to {
+		if !slices.Contains(from, value) {
+			added = append(added, value)
+		}
+	}
+
+	for _, value := range from {
+		if !slices.Contains(to, value) {
+			removed = append(removed, value)
+		}
+	}
+
+	return added, removed
+}
+
+// applyStringSetDelta returns values without removed and with added, sorted.
+func applyStringSetDelta(values, added, removed []string) []string {
+	result := []string{}
+	for _, value := range values {
+		if !slices.Contains(removed, value) && !slices.Contains(added, value) {
+			result = append(result, value)
+		}
+	}
+
+	result = append(result, added...)
+	slices.Sort(result)
+
+	return result
+}
+
+func (r *SecurityProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(securityProfileResourceType, resp) {
+		return
+	}
+
+	var data SecurityProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(securityProfileResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(securityProfileResourceType, data.Override)
+	_, err := conn.DeleteSecurityProfile(ctx, &connect.DeleteSecurityProfileInput{
+		InstanceId:        aws.String(data.InstanceID.ValueString()),
+		SecurityProfileId: aws.String(data.SecurityProfileID.ValueString()),
+	})
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error deleting Connect Security Profile", "Could not delete Connect Security Profile", err))
+	}
+}
+
+func (r *SecurityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importConnectResource(ctx, req, resp, "security-profile", "security_profile_id")
+}
+
+// MoveState migrates the state of aws_connect_security_profile in moved blocks.
+func (r *SecurityProfileResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{connectStateMover("aws_connect_security_profile", "security_profile_id")}
+}
+
+// securityProfileAccessControl holds the permissions and access control
+// settings of a security profile model as sent to the API. Restrictions are
+// empty rather than nil when unset, so that updates clear them.
+type securityProfileAccessControl struct {
+	permissions                  []string
+	allowedAccessControlTags     map[string]string
+	tagRestrictedResources       []string
+	hierarchyRestrictedResources []string
+}
+
+// accessControl returns the permissions and access control settings of the
+// model.
+func (m SecurityProfileResourceModel) accessControl(ctx context.Context) (securityProfileAccessControl, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	access := securityProfileAccessControl{
+		permissions:                  []string{},
+		allowedAccessControlTags:     map[string]string{},
+		tagRestrictedResources:       []string{},
+		hierarchyRestrictedResources: []string{},
+	}
+
+	diags.Append(m.Permissions.ElementsAs(ctx, &access.permissions, false)...)
+	diags.Append(m.AllowedAccessControlTags.ElementsAs(ctx, &access.allowedAccessControlTags, false)...)
+	diags.Append(m.TagRestrictedResources.ElementsAs(ctx, &access.tagRestrictedResources, false)...)
+	diags.Append(m.HierarchyRestrictedResources.ElementsAs(ctx, &access.hierarchyRestrictedResources, false)...)
+
+	return access, diags
+}
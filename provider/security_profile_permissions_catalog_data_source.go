@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SecurityProfilePermissionsCatalogDataSource{}
+
+// adminSecurityProfileName is the name of the security profile Connect
+// creates with every permission in new instances.
+const adminSecurityProfileName = "Admin"
+
+func NewSecurityProfilePermissionsCatalogDataSource() datasource.DataSource {
+	return &SecurityProfilePermissionsCatalogDataSource{}
+}
+
+type SecurityProfilePermissionsCatalogDataSource struct {
+	providerData *ProviderData
+}
+
+type SecurityProfilePermissionsCatalogDataSourceModel struct {
+	InstanceID          types.String `tfsdk:"instance_id"`
+	InstanceAlias       types.String `tfsdk:"instance_alias"`
+	SecurityProfileName types.String `tfsdk:"security_profile_name"`
+	Permissions         types.List   `tfsdk:"permissions"`
+	Groups              types.Map    `tfsdk:"groups"`
+}
+
+func (d *SecurityProfilePermissionsCatalogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_security_profile_permissions_catalog"
+}
+
+func (d *SecurityProfilePermissionsCatalogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the catalog of security profile permission names of a Connect instance, grouped by area",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"security_profile_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Security profile whose permissions are the catalog. Defaults to Admin, which Connect creates with every permission. Connect has no API listing all permissions, so the catalog is only complete if this profile has them all.",
+			},
+			"permissions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Permission names, e.g. Users.Create, sorted.",
+			},
+			"groups": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "Permission names by group, the part of the name before the first dot, e.g. Users for Users.Create. Names without a dot, e.g. BasicAgentAccess, are their own group.",
+			},
+		},
+	}
+}
+
+func (d *SecurityProfilePermissionsCatalogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *SecurityProfilePermissionsCatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecurityProfilePermissionsCatalogDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SecurityProfileName.IsNull() {
+		data.SecurityProfileName = types.StringValue(adminSecurityProfileName)
+	}
+
+	conn := d.providerData.connectClient(nil)
+	instanceID := data.InstanceID.ValueString()
+	name := data.SecurityProfileName.ValueString()
+
+	profiles, err := collectPages(ctx, listSecurityProfiles(conn, instanceID), 0, func(profile conntypes.SecurityProfileSummary) bool {
+		return aws.ToString(profile.Name) == name
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Security Profiles", "Could not list the security profiles of the instance", err))
+		return
+	}
+
+	if len(profiles) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("security_profile_name"), "Connect Security Profile Not Found", fmt.Sprintf("Instance %s has no security profile named %q.", instanceID, name))
+		return
+	}
+
+	permissions, err := collectPages(ctx, listSecurityProfilePermissions(conn, instanceID, aws.ToString(profiles[0].Id)), 0, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Security Profile Permissions", fmt.Sprintf("Could not list the permissions of security profile %s", name), err))
+		return
+	}
+
+	sort.Strings(permissions)
+
+	permissionValues, diags := types.ListValueFrom(ctx, types.StringType, permissions)
+	resp.Diagnostics.Append(diags...)
+	data.Permissions = permissionValues
+
+	groupValues, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, permissionGroups(permissions))
+	resp.Diagnostics.Append(diags...)
+	data.Groups = groupValues
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// permissionGroups groups sorted security profile permission names by the
+// part of the name before the first dot.
+func permissionGroups(permissions []string) map[string][]string {
+	groups := map[string][]string{}
+
+	for _, permission := range permissions {
+		group, _, _ := strings.Cut(permission, ".")
+		groups[group] = append(groups[group], permission)
+	}
+
+	return groups
+}
+
+// listSecurityProfiles returns the pages of the security profiles of an
+// instance.
+func listSecurityProfiles(conn *connect.Client, instanceID string) pageLister[conntypes.SecurityProfileSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.SecurityProfileSummary, *string, error) {
+		response, err := conn.ListSecurityProfiles(ctx, &connect.ListSecurityProfilesInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int32(1000),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.SecurityProfileSummaryList, response.NextToken, nil
+	}
+}
+
+// listSecurityProfilePermissions returns the pages of the permission names of
+// a security profile.
+func listSecurityProfilePermissions(conn *connect.Client, instanceID, securityProfileID string) pageLister[string] {
+	return func(ctx context.Context, nextToken *string) ([]string, *string, error) {
+		response, err := conn.ListSecurityProfilePermissions(ctx, &connect.ListSecurityProfilePermissionsInput{
+			InstanceId:        aws.String(instanceID),
+			SecurityProfileId: aws.String(securityProfileID),
+			MaxResults:        aws.Int32(1000),
+			NextToken:         nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Permissions, response.NextToken, nil
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &SessionTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &SessionTokenEphemeralResource{}
+
+func NewSessionTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &SessionTokenEphemeralResource{}
+}
+
+type SessionTokenEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type SessionTokenEphemeralResourceModel struct {
+	DurationSeconds types.Int32  `tfsdk:"duration_seconds"`
+	SerialNumber    types.String `tfsdk:"serial_number"`
+	TokenCode       types.String `tfsdk:"token_code"`
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	SessionToken    types.String `tfsdk:"session_token"`
+	Expiration      types.String `tfsdk:"expiration"`
+}
+
+func (r *SessionTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sts_session_token"
+}
+
+func (r *SessionTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Temporary session credentials obtained with STS GetSessionToken",
+
+		Attributes: map[string]schema.Attribute{
+			"duration_seconds": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Duration, in seconds, of the session.",
+				Validators: []validator.Int32{
+					int32validator.Between(900, 129600),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				Optional:    true,
+				Description: "Identification number (serial number or ARN) of the MFA device.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("token_code")),
+				},
+			},
+			"token_code": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Current code displayed by the MFA device.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("serial_number")),
+				},
+			},
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp at which the credentials expire.",
+			},
+		},
+	}
+}
+
+func (r *SessionTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *SessionTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SessionTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.providerData.stsClient()
+	input := &sts.GetSessionTokenInput{
+		DurationSeconds: data.DurationSeconds.ValueInt32Pointer(),
+	}
+
+	if data.SerialNumber.ValueString() != "" {
+		input.SerialNumber = aws.String(data.SerialNumber.ValueString())
+		input.TokenCode = aws.String(data.TokenCode.ValueString())
+	}
+
+	response, err := conn.GetSessionToken(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error getting session token", "Could not get STS session token", err))
+		return
+	}
+
+	data.AccessKeyID = types.StringValue(aws.ToString(response.Credentials.AccessKeyId))
+	data.SecretAccessKey = types.StringValue(aws.ToString(response.Credentials.SecretAccessKey))
+	data.SessionToken = types.StringValue(aws.ToString(response.Credentials.SessionToken))
+	data.Expiration = types.StringValue(aws.ToTime(response.Credentials.Expiration).Format(time.RFC3339))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &ShiftTrafficAction{}
+var _ action.ActionWithConfigure = &ShiftTrafficAction{}
+var _ action.ActionWithValidateConfig = &ShiftTrafficAction{}
+
+func NewShiftTrafficAction() action.Action {
+	return &ShiftTrafficAction{}
+}
+
+type ShiftTrafficAction struct {
+	providerData *ProviderData
+}
+
+type ShiftTrafficActionModel struct {
+	TrafficDistributionGroupID types.String `tfsdk:"traffic_distribution_group_id"`
+	Distribution               types.Map    `tfsdk:"distribution"`
+	Wait                       types.Bool   `tfsdk:"wait"`
+}
+
+func (a *ShiftTrafficAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_shift_traffic"
+}
+
+func (a *ShiftTrafficAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Shifts the telephony traffic of a Connect traffic distribution group between regions, e.g. to fail over to the replica region",
+
+		Attributes: map[string]schema.Attribute{
+			"traffic_distribution_group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID or ARN of the traffic distribution group",
+			},
+			"distribution": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.Int32Type,
+				Description: "Percentage of the telephony traffic by region, adding up to 100",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait for the traffic distribution group update to complete, defaults to true",
+			},
+		},
+	}
+}
+
+func (a *ShiftTrafficAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var distribution types.Map
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("distribution"), &distribution)...)
+
+	if resp.Diagnostics.HasError() || distribution.IsNull() || distribution.IsUnknown() {
+		return
+	}
+
+	percentages := map[string]types.Int32{}
+	resp.Diagnostics.Append(distribution.ElementsAs(ctx, &percentages, false)...)
+
+	var total int32
+	for region, percentage := range percentages {
+		if percentage.IsUnknown() {
+			return
+		}
+
+		if percentage.ValueInt32() < 0 || percentage.ValueInt32() > 100 {
+			resp.Diagnostics.AddAttributeError(path.Root("distribution").AtMapKey(region), "Invalid Traffic Percentage", "The percentage must be between 0 and 100.")
+		}
+
+		total += percentage.ValueInt32()
+	}
+
+	if total != 100 {
+		resp.Diagnostics.AddAttributeError(path.Root("distribution"), "Invalid Traffic Distribution", fmt.Sprintf("The percentages must add up to 100, got %d.", total))
+	}
+}
+
+func (a *ShiftTrafficAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.providerData = providerData
+}
+
+func (a *ShiftTrafficAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if a.providerData.readOnlyBlocked("invoke awsext_connect_shift_traffic", true, &resp.Diagnostics) {
+		return
+	}
+
+	var data ShiftTrafficActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	percentages := map[string]int32{}
+	resp.Diagnostics.Append(data.Distribution.ElementsAs(ctx, &percentages, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultActionTimeout)
+	defer cancel()
+
+	conn := a.providerData.connectClient(nil)
+
+	telephony := &conntypes.TelephonyConfig{}
+	for _, region := range sortedKeys(percentages) {
+		telephony.Distributions = append(telephony.Distributions, conntypes.Distribution{
+			Region:     aws.String(region),
+			Percentage: percentages[region],
+		})
+	}
+
+	_, err := conn.UpdateTrafficDistribution(ctx, &connect.UpdateTrafficDistributionInput{
+		Id:              aws.String(data.TrafficDistributionGroupID.ValueString()),
+		TelephonyConfig: telephony,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error updating Connect Traffic Distribution", "Could not update Connect Traffic Distribution", err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Shifting traffic of %s to %v", data.TrafficDistributionGroupID.ValueString(), percentages),
+	})
+
+	if !data.Wait.IsNull() && !data.Wait.ValueBool() {
+		return
+	}
+
+	err = waitFor(ctx, defaultPollInterval, func(ctx context.Context) (bool, error) {
+		group, err := conn.DescribeTrafficDistributionGroup(ctx, &connect.DescribeTrafficDistributionGroupInput{
+			TrafficDistributionGroupId: aws.String(data.TrafficDistributionGroupID.ValueString()),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return group.TrafficDistributionGroup.Status != conntypes.TrafficDistributionGroupStatusUpdateInProgress, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for Connect Traffic Distribution", fmt.Sprintf("Connect Traffic Distribution update did not complete: %s", err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Traffic of %s shifted", data.TrafficDistributionGroupID.ValueString()),
+	})
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// skipDestroyAttributeName is the name of the attribute of association
+// resources that removes them from the state without disassociating.
+const skipDestroyAttributeName = "skip_destroy"
+
+// skipDestroyAttribute returns the schema of the skip_destroy attribute
+// shared by association resources.
+func skipDestroyAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+		Description: "On destroy, remove the association from the state without disassociating it in AWS, e.g. to hand it over to another configuration.",
+	}
+}
+
+// skipDestroy reads the skip_destroy attribute from the state and reports
+// whether Delete should leave the association in place.
+func skipDestroy(ctx context.Context, state tfsdk.State) (bool, diag.Diagnostics) {
+	var value types.Bool
+
+	diags := state.GetAttribute(ctx, path.Root(skipDestroyAttributeName), &value)
+
+	return value.ValueBool(), diags
+}
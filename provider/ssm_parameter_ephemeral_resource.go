@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &SsmParameterEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &SsmParameterEphemeralResource{}
+
+func NewSsmParameterEphemeralResource() ephemeral.EphemeralResource {
+	return &SsmParameterEphemeralResource{}
+}
+
+type SsmParameterEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type SsmParameterEphemeralResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	WithDecryption types.Bool   `tfsdk:"with_decryption"`
+	Arn            types.String `tfsdk:"arn"`
+	Type           types.String `tfsdk:"type"`
+	Value          types.String `tfsdk:"value"`
+	Version        types.Int64  `tfsdk:"version"`
+}
+
+func (r *SsmParameterEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssm_parameter"
+}
+
+func (r *SsmParameterEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "SSM parameter value",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name or ARN of the parameter. A version or label selector (e.g. `name:3`) may be appended.",
+			},
+			"with_decryption": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Decrypt SecureString values. Defaults to `true`.",
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Computed: true,
+			},
+			"value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *SsmParameterEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *SsmParameterEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SsmParameterEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	withDecryption := true
+	if !data.WithDecryption.IsNull() {
+		withDecryption = data.WithDecryption.ValueBool()
+	}
+
+	conn := r.providerData.ssmClient()
+	input := &ssm.GetParameterInput{
+		Name:           aws.String(data.Name.ValueString()),
+		WithDecryption: aws.Bool(withDecryption),
+	}
+
+	response, err := conn.GetParameter(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading SSM Parameter", fmt.Sprintf("Could not read SSM Parameter %s", data.Name.ValueString()), err))
+		return
+	}
+
+	data.Arn = types.StringValue(aws.ToString(response.Parameter.ARN))
+	data.Type = types.StringValue(string(response.Parameter.Type))
+	data.Value = types.StringValue(aws.ToString(response.Parameter.Value))
+	data.Version = types.Int64Value(response.Parameter.Version)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
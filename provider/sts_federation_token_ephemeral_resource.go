@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &STSFederationTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &STSFederationTokenEphemeralResource{}
+
+// federatedUserNamePattern matches the names accepted by GetFederationToken.
+var federatedUserNamePattern = regexp.MustCompile(`^[\w+=,.@-]{2,32}$`)
+
+func NewSTSFederationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &STSFederationTokenEphemeralResource{}
+}
+
+type STSFederationTokenEphemeralResource struct {
+	providerData *ProviderData
+}
+
+type STSFederationTokenEphemeralResourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	Policy           types.String `tfsdk:"policy"`
+	DurationSeconds  types.Int32  `tfsdk:"duration_seconds"`
+	FederatedUserArn types.String `tfsdk:"federated_user_arn"`
+	FederatedUserID  types.String `tfsdk:"federated_user_id"`
+	AccessKeyID      types.String `tfsdk:"access_key_id"`
+	SecretAccessKey  types.String `tfsdk:"secret_access_key"`
+	SessionToken     types.String `tfsdk:"session_token"`
+	Expiration       types.String `tfsdk:"expiration"`
+}
+
+func (r *STSFederationTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sts_federation_token"
+}
+
+func (r *STSFederationTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Temporary credentials of a federated user obtained with STS GetFederationToken",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the federated user, shown in CloudTrail.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(federatedUserNamePattern, "must be 2 to 32 letters, digits or any of +=,.@_-"),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Optional:    true,
+				Description: "IAM policy JSON restricting the permissions of the credentials to the intersection with those of the provider credentials. Without a policy the credentials have no permissions, except on resources whose policy allows the federated user.",
+			},
+			"duration_seconds": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Duration, in seconds, of the credentials.",
+				Validators: []validator.Int32{
+					int32validator.Between(900, 129600),
+				},
+			},
+			"federated_user_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"federated_user_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp at which the credentials expire.",
+			},
+		},
+	}
+}
+
+func (r *STSFederationTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *STSFederationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data STSFederationTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.providerData.stsClient()
+	input := &sts.GetFederationTokenInput{
+		Name:            aws.String(data.Name.ValueString()),
+		DurationSeconds: data.DurationSeconds.ValueInt32Pointer(),
+	}
+
+	if data.Policy.ValueString() != "" {
+		input.Policy = aws.String(data.Policy.ValueString())
+	}
+
+	response, err := conn.GetFederationToken(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error getting federation token", fmt.Sprintf("Could not get STS federation token for %s", data.Name.ValueString()), err))
+		return
+	}
+
+	if response.FederatedUser != nil {
+		data.FederatedUserArn = types.StringValue(aws.ToString(response.FederatedUser.Arn))
+		data.FederatedUserID = types.StringValue(aws.ToString(response.FederatedUser.FederatedUserId))
+	}
+
+	data.AccessKeyID = types.StringValue(aws.ToString(response.Credentials.AccessKeyId))
+	data.SecretAccessKey = types.StringValue(aws.ToString(response.Credentials.SecretAccessKey))
+	data.SessionToken = types.StringValue(aws.ToString(response.Credentials.SessionToken))
+	data.Expiration = types.StringValue(aws.ToTime(response.Credentials.Expiration).Format(time.RFC3339))
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
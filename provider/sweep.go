@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+)
+
+// SweepOptions selects the resources removed by Sweep.
+type SweepOptions struct {
+	// InstanceID is the Connect instance to sweep.
+	InstanceID string
+	// Prefix is the name prefix of the resources to sweep. It is required so
+	// a sweep never touches resources not created for tests or demos.
+	Prefix string
+	// Instances also deletes the other instances whose alias starts with the
+	// prefix, e.g. created by the acceptance tests of instances.
+	Instances bool
+	// DryRun only reports the resources that would be swept.
+	DryRun bool
+	// Logf reports every swept resource.
+	Logf func(format string, args ...any)
+}
+
+// sweeper removes the resources of one type matching the options. Resources
+// the API cannot delete, e.g. agent statuses, are disabled instead.
+type sweeper struct {
+	resourceType string
+	sweep        func(ctx context.Context, providerData *ProviderData, options SweepOptions) error
+}
+
+// sweepers are run by Sweep in order, so resources are swept before the
+// resources they depend on.
+var sweepers = []sweeper{
+	{resourceType: "awsext_connect_agent_status", sweep: sweepAgentStatuses},
+	{resourceType: "awsext_connect_queue", sweep: sweepQueues},
+	{resourceType: "awsext_connect_contact_flow", sweep: sweepContactFlows},
+	{resourceType: "awsext_connect_instance", sweep: sweepInstances},
+}
+
+// Sweep deletes or disables the resources of a Connect instance whose name
+// starts with the prefix, e.g. orphans of tests and experiments. All sweepers
+// run even if one fails, and their errors are joined.
+func Sweep(ctx context.Context, cfg aws.Config, options SweepOptions) error {
+	if options.InstanceID == "" || options.Prefix == "" {
+		return errors.New("sweeping requires an instance ID and a name prefix")
+	}
+
+	if options.Logf == nil {
+		options.Logf = func(string, ...any) {}
+	}
+
+	providerData := &ProviderData{
+		Config:     cfg,
+		semaphores: newSemaphores(nil),
+	}
+
+	var errs []error
+	for _, s := range sweepers {
+		if err := s.sweep(ctx, providerData, options); err != nil {
+			errs = append(errs, fmt.Errorf("sweeping %s: %w", s.resourceType, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweepAgentStatuses disables the custom agent statuses matching the options
+// and renames them like on_destroy = "disable_and_rename", as agent statuses
+// cannot be deleted. Renaming frees their names for the next tests.
+func sweepAgentStatuses(ctx context.Context, providerData *ProviderData, options SweepOptions) error {
+	conn := providerData.connectClient(nil)
+	criteria := &conntypes.AgentStatusSearchCriteria{
+		AndConditions: []conntypes.AgentStatusSearchCriteria{
+			{StringCondition: stringCondition("name", conntypes.StringComparisonTypeStartsWith, options.Prefix)},
+			{StringCondition: stringCondition("type", conntypes.StringComparisonTypeExact, string(conntypes.AgentStatusTypeCustom))},
+		},
+	}
+
+	statuses, err := collectPages(ctx, searchAgentStatuses(conn, options.InstanceID, criteria), 0, func(status conntypes.AgentStatus) bool {
+		// Searches are not case sensitive
+		return status.Type == conntypes.AgentStatusTypeCustom && strings.HasPrefix(aws.ToString(status.Name), options.Prefix)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, status := range statuses {
+		options.Logf("disabling agent status %s (%s)", aws.ToString(status.Name), aws.ToString(status.AgentStatusId))
+		if options.DryRun {
+			continue
+		}
+
+		_, err = conn.UpdateAgentStatus(ctx, &connect.UpdateAgentStatusInput{
+			AgentStatusId: status.AgentStatusId,
+			InstanceId:    aws.String(options.InstanceID),
+			Name:          aws.String(deletedAgentStatusNameWithID(aws.ToString(status.Name), aws.ToString(status.AgentStatusId))),
+			State:         conntypes.AgentStatusStateDisabled,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sweepQueues deletes the standard queues matching the options. Queues still
+// used by routing profiles or quick connects fail to delete.
+func sweepQueues(ctx context.Context, providerData *ProviderData, options SweepOptions) error {
+	conn := providerData.connectClient(nil)
+	queues, err := collectPages(ctx, listQueues(conn, options.InstanceID), 0, func(queue conntypes.QueueSummary) bool {
+		return strings.HasPrefix(aws.ToString(queue.Name), options.Prefix)
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, queue := range queues {
+		options.Logf("deleting queue %s (%s)", aws.ToString(queue.Name), aws.ToString(queue.Id))
+		if options.DryRun {
+			continue
+		}
+
+		_, err = conn.DeleteQueue(ctx, &connect.DeleteQueueInput{
+			InstanceId: aws.String(options.InstanceID),
+			QueueId:    queue.Id,
+		})
+		if err != nil && !isNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting queue %s: %w", aws.ToString(queue.Name), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweepContactFlows deletes the flows matching the options, after the queues
+// whose outbound flow they may be.
+func sweepContactFlows(ctx context.Context, providerData *ProviderData, options SweepOptions) error {
+	conn := providerData.connectClient(nil)
+	flows, err := collectPages(ctx, listContactFlows(conn, options.InstanceID), 0, func(flow conntypes.ContactFlowSummary) bool {
+		return strings.HasPrefix(aws.ToString(flow.Name), options.Prefix)
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, flow := range flows {
+		options.Logf("deleting flow %s (%s)", aws.ToString(flow.Name), aws.ToString(flow.Id))
+		if options.DryRun {
+			continue
+		}
+
+		_, err = conn.DeleteContactFlow(ctx, &connect.DeleteContactFlowInput{
+			InstanceId:    aws.String(options.InstanceID),
+			ContactFlowId: flow.Id,
+		})
+		if err != nil && !isNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting flow %s: %w", aws.ToString(flow.Name), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweepInstances deletes the instances whose alias matches the prefix when
+// options.Instances is set, never the swept instance itself.
+func sweepInstances(ctx context.Context, providerData *ProviderData, options SweepOptions) error {
+	if !options.Instances {
+		return nil
+	}
+
+	conn := providerData.connectClient(nil)
+	instances, err := collectPages(ctx, listInstances(conn), 0, func(instance conntypes.InstanceSummary) bool {
+		return aws.ToString(instance.Id) != options.InstanceID && strings.HasPrefix(aws.ToString(instance.InstanceAlias), options.Prefix)
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, instance := range instances {
+		options.Logf("deleting instance %s (%s)", aws.ToString(instance.InstanceAlias), aws.ToString(instance.Id))
+		if options.DryRun {
+			continue
+		}
+
+		_, err = conn.DeleteInstance(ctx, &connect.DeleteInstanceInput{
+			InstanceId: instance.Id,
+		})
+		if err != nil && !isNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting instance %s: %w", aws.ToString(instance.InstanceAlias), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// listContactFlows returns a lister of the flows of an instance.
+func listContactFlows(conn *connect.Client, instanceID string) pageLister[conntypes.ContactFlowSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.ContactFlowSummary, *string, error) {
+		response, err := conn.ListContactFlows(ctx, &connect.ListContactFlowsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int32(1000),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.ContactFlowSummaryList, response.NextToken, nil
+	}
+}
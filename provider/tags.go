@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// awsTagKeyPrefix is reserved for tags managed by AWS itself, which can
+// neither be set nor removed by users.
+const awsTagKeyPrefix = "aws:"
+
+// ignoreTagsConfig describes tag keys that are neither reported nor managed.
+type ignoreTagsConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// mergeTags merges tag maps in order, later maps winning over earlier ones.
+func mergeTags(tagMaps ...map[string]string) map[string]string {
+	merged := map[string]string{}
+
+	for _, tags := range tagMaps {
+		for key, value := range tags {
+			merged[key] = value
+		}
+	}
+
+	return merged
+}
+
+// ignoreTags returns the tags without AWS reserved tags and without the keys
+// matched by the ignore configuration.
+func ignoreTags(tags map[string]string, ignore ignoreTagsConfig) map[string]string {
+	result := map[string]string{}
+
+	for key, value := range tags {
+		if ignore.matches(key) {
+			continue
+		}
+
+		result[key] = value
+	}
+
+	return result
+}
+
+func (c ignoreTagsConfig) matches(key string) bool {
+	if strings.HasPrefix(key, awsTagKeyPrefix) {
+		return true
+	}
+
+	for _, ignored := range c.Keys {
+		if key == ignored {
+			return true
+		}
+	}
+
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tagsAttribute returns the schema of the tags attribute of taggable
+// resources.
+func tagsAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Description: "Tags of the resource. Tags with the same key in the provider default_tags are overridden.",
+		Validators:  tagsValidators(),
+	}
+}
+
+// tagsAllAttribute returns the schema of the tags_all attribute of taggable
+// resources.
+func tagsAllAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "Tags of the resource, including the provider default_tags.",
+	}
+}
+
+// tagsAll returns the effective tags of a resource: the provider default tags
+// merged with the resource tags, without ignored tags.
+func (p *ProviderData) tagsAll(tags map[string]string) map[string]string {
+	return ignoreTags(mergeTags(p.DefaultTags, tags), p.IgnoreTags)
+}
+
+// modifyPlanTags plans tags_all from the planned tags and the provider
+// default tags. It is called from the ModifyPlan method of taggable
+// resources.
+func (p *ProviderData) modifyPlanTags(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() || p == nil {
+		return
+	}
+
+	var tags types.Map
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A single unknown tag value, e.g. the name of a resource not yet
+	// created, or unknown default or ignored tags make tags_all unknown
+	if tags.IsUnknown() || p.tagsUnknown || !allKnown(maps.Values(tags.Elements())) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	configured := map[string]string{}
+	for key, value := range tags.Elements() {
+		if value, ok := value.(types.String); ok && !value.IsNull() {
+			configured[key] = value.ValueString()
+		}
+	}
+
+	for key, value := range configured {
+		if value == "" {
+			continue
+		}
+
+		if p.IgnoreTags.matches(key) {
+			resp.Diagnostics.AddAttributeWarning(path.Root("tags").AtMapKey(key), "Ignored Tag", fmt.Sprintf("Tag %s matches the provider ignore_tags and is not managed.", key))
+		}
+	}
+
+	tagsAll, diags := types.MapValueFrom(ctx, types.StringType, p.tagsAll(configured))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// allKnown reports whether none of values is unknown.
+func allKnown(values iter.Seq[attr.Value]) bool {
+	for value := range values {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// readTags computes tags and tags_all from the tags reported by AWS. Ignored
+// tags are dropped, and default tags are only reported in tags when they are
+// also configured on the resource.
+func (p *ProviderData) readTags(ctx context.Context, remote map[string]string, prior types.Map) (types.Map, types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configured := map[string]string{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &configured, false)...)
+	}
+
+	tagsAll := ignoreTags(remote, p.IgnoreTags)
+	tags := map[string]string{}
+
+	for key, value := range tagsAll {
+		if defaultValue, isDefault := p.DefaultTags[key]; isDefault && defaultValue == value {
+			if _, isConfigured := configured[key]; !isConfigured {
+				continue
+			}
+		}
+
+		tags[key] = value
+	}
+
+	tagsAllValue, d := types.MapValueFrom(ctx, types.StringType, tagsAll)
+	diags.Append(d...)
+
+	tagsValue := types.MapNull(types.StringType)
+	if len(tags) > 0 || (!prior.IsNull() && len(prior.Elements()) == 0) {
+		tagsValue, d = types.MapValueFrom(ctx, types.StringType, tags)
+		diags.Append(d...)
+	}
+
+	return tagsValue, tagsAllValue, diags
+}
+
+// tagsDiff returns the tags to add or change and the keys to remove to go
+// from oldTags to newTags.
+func tagsDiff(oldTags map[string]string, newTags map[string]string) (map[string]string, []string) {
+	updated := map[string]string{}
+	removed := []string{}
+
+	for key, value := range newTags {
+		if oldValue, ok := oldTags[key]; !ok || oldValue != value {
+			updated[key] = value
+		}
+	}
+
+	for key := range oldTags {
+		if _, ok := newTags[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+
+	return updated, removed
+}
+
+// updateConnectTags tags and untags a Connect resource so that its tags go
+// from oldTags to newTags.
+func updateConnectTags(ctx context.Context, conn ConnectTaggingAPI, resourceArn string, oldTags map[string]string, newTags map[string]string) error {
+	updated, removed := tagsDiff(oldTags, newTags)
+
+	if len(removed) > 0 {
+		_, err := conn.UntagResource(ctx, &connect.UntagResourceInput{
+			ResourceArn: aws.String(resourceArn),
+			TagKeys:     removed,
+		})
+		if err != nil {
+			return fmt.Errorf("untagging %s: %w", resourceArn, err)
+		}
+	}
+
+	if len(updated) > 0 {
+		_, err := conn.TagResource(ctx, &connect.TagResourceInput{
+			ResourceArn: aws.String(resourceArn),
+			Tags:        updated,
+		})
+		if err != nil {
+			return fmt.Errorf("tagging %s: %w", resourceArn, err)
+		}
+	}
+
+	return nil
+}
+
+// connectResourceTags returns the tags of a Connect resource.
+func connectResourceTags(ctx context.Context, conn ConnectTaggingAPI, resourceArn string) (map[string]string, error) {
+	response, err := conn.ListTagsForResource(ctx, &connect.ListTagsForResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing tags of %s: %w", resourceArn, err)
+	}
+
+	return response.Tags, nil
+}
+
+// mapFromTags converts a tags or tags_all value into a Go map.
+func mapFromTags(ctx context.Context, tags types.Map) (map[string]string, diag.Diagnostics) {
+	result := map[string]string{}
+
+	if tags.IsNull() || tags.IsUnknown() {
+		return result, nil
+	}
+
+	diags := tags.ElementsAs(ctx, &result, false)
+
+	return result, diags
+}
+
+// hasTags reports whether tags contains all wanted tags with the same
+// values.
+func hasTags(tags map[string]string, wanted map[string]string) bool {
+	for key, value := range wanted {
+		if actual, ok := tags[key]; !ok || actual != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TagsMergeFunction{}
+
+func NewTagsMergeFunction() function.Function {
+	return &TagsMergeFunction{}
+}
+
+type TagsMergeFunction struct{}
+
+func (f *TagsMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_merge"
+}
+
+func (f *TagsMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merge tag maps the way the provider computes effective tags",
+		MarkdownDescription: "Merges the tag maps in order, later maps winning over earlier ones, then removes AWS reserved `aws:` tags " +
+			"and the tags matched by `ignore_keys` or `ignore_key_prefixes`. Pass the default tags first and the resource tags last " +
+			"to compute the same `tags_all` the provider does.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "tags",
+				MarkdownDescription: "Tag maps to merge, in increasing order of precedence.",
+				ElementType:         types.MapType{ElemType: types.StringType},
+			},
+			function.SetParameter{
+				Name:                "ignore_keys",
+				MarkdownDescription: "Tag keys to remove from the result.",
+				ElementType:         types.StringType,
+			},
+			function.SetParameter{
+				Name:                "ignore_key_prefixes",
+				MarkdownDescription: "Tag key prefixes to remove from the result.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *TagsMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tagMaps []map[string]string
+	var ignore ignoreTagsConfig
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tagMaps, &ignore.Keys, &ignore.KeyPrefixes))
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, ignoreTags(mergeTags(tagMaps...), ignore)))
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestModifyPlanTags(t *testing.T) {
+	tagsSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+		},
+	}
+	tagsType := tftypes.Map{ElementType: tftypes.String}
+
+	tests := map[string]struct {
+		tags        tftypes.Value
+		defaultTags map[string]string
+		tagsUnknown bool
+		want        types.Map
+	}{
+		"known tags": {
+			tags:        tftypes.NewValue(tagsType, map[string]tftypes.Value{"Owner": tftypes.NewValue(tftypes.String, "ops")}),
+			defaultTags: map[string]string{"Team": "contact-center"},
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Owner": types.StringValue("ops"),
+				"Team":  types.StringValue("contact-center"),
+			}),
+		},
+		"unknown tag value": {
+			tags: tftypes.NewValue(tagsType, map[string]tftypes.Value{
+				"Owner": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"Team":  tftypes.NewValue(tftypes.String, "contact-center"),
+			}),
+			want: types.MapUnknown(types.StringType),
+		},
+		"unknown tags": {
+			tags: tftypes.NewValue(tagsType, tftypes.UnknownValue),
+			want: types.MapUnknown(types.StringType),
+		},
+		"unknown default tags": {
+			tags:        tftypes.NewValue(tagsType, map[string]tftypes.Value{"Owner": tftypes.NewValue(tftypes.String, "ops")}),
+			tagsUnknown: true,
+			want:        types.MapUnknown(types.StringType),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			p := &ProviderData{DefaultTags: test.defaultTags, tagsUnknown: test.tagsUnknown}
+
+			raw := tftypes.NewValue(tagsSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"tags":     test.tags,
+				"tags_all": tftypes.NewValue(tagsType, tftypes.UnknownValue),
+			})
+			req := resource.ModifyPlanRequest{Plan: tfsdk.Plan{Schema: tagsSchema, Raw: raw}}
+			resp := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: tagsSchema, Raw: raw}}
+
+			p.modifyPlanTags(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var tagsAll types.Map
+			resp.Plan.GetAttribute(ctx, path.Root("tags_all"), &tagsAll)
+			if !tagsAll.Equal(test.want) {
+				t.Errorf("tags_all: got %s, want %s", tagsAll, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	connecttypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TaskTemplateFieldsFunction{}
+
+// taskTemplateFieldAttributes are the attributes accepted in the definition of
+// a single task template field.
+var taskTemplateFieldAttributes = []string{"name", "type", "description", "options", "required", "read_only", "invisible", "default"}
+
+type taskTemplateFieldID struct {
+	Name string `json:"Name"`
+}
+
+type taskTemplateField struct {
+	Id                  taskTemplateFieldID `json:"Id"`
+	Type                string              `json:"Type"`
+	Description         string              `json:"Description,omitempty"`
+	SingleSelectOptions []string            `json:"SingleSelectOptions,omitempty"`
+}
+
+type taskTemplateFieldRef struct {
+	Id taskTemplateFieldID `json:"Id"`
+}
+
+type taskTemplateConstraints struct {
+	RequiredFields  []taskTemplateFieldRef `json:"RequiredFields"`
+	ReadOnlyFields  []taskTemplateFieldRef `json:"ReadOnlyFields"`
+	InvisibleFields []taskTemplateFieldRef `json:"InvisibleFields"`
+}
+
+type taskTemplateDefaultFieldValue struct {
+	Id           taskTemplateFieldID `json:"Id"`
+	DefaultValue string              `json:"DefaultValue"`
+}
+
+type taskTemplateDefaults struct {
+	DefaultFieldValues []taskTemplateDefaultFieldValue `json:"DefaultFieldValues"`
+}
+
+// taskTemplateDefinition mirrors the Fields, Constraints and Defaults of the
+// CreateTaskTemplate request.
+type taskTemplateDefinition struct {
+	Fields      []taskTemplateField     `json:"Fields"`
+	Constraints taskTemplateConstraints `json:"Constraints"`
+	Defaults    taskTemplateDefaults    `json:"Defaults"`
+}
+
+func NewTaskTemplateFieldsFunction() function.Function {
+	return &TaskTemplateFieldsFunction{}
+}
+
+type TaskTemplateFieldsFunction struct{}
+
+func (f *TaskTemplateFieldsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "task_template_fields"
+}
+
+func (f *TaskTemplateFieldsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build task template fields, constraints and defaults",
+		MarkdownDescription: "Converts a concise description of task template fields into the JSON object with the `Fields`, " +
+			"`Constraints` and `Defaults` expected by CreateTaskTemplate. `fields` is either an object keyed by field name, in which " +
+			"case fields are ordered by name, or a list of objects with a `name` attribute. Each field has a `type` and optionally " +
+			"a `description`, `options` (for `SINGLE_SELECT` fields), `required`, `read_only`, `invisible` and a `default` value.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "fields",
+				MarkdownDescription: "Object keyed by field name or list of field objects.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TaskTemplateFieldsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+
+	if resp.Error != nil {
+		return
+	}
+
+	fields, err := dynamicToGo(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	definition, err := buildTaskTemplateDefinition(fields)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, err := marshalCanonicalJSON(definition)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func buildTaskTemplateDefinition(input any) (taskTemplateDefinition, error) {
+	definition := taskTemplateDefinition{
+		Fields: []taskTemplateField{},
+		Constraints: taskTemplateConstraints{
+			RequiredFields:  []taskTemplateFieldRef{},
+			ReadOnlyFields:  []taskTemplateFieldRef{},
+			InvisibleFields: []taskTemplateFieldRef{},
+		},
+		Defaults: taskTemplateDefaults{
+			DefaultFieldValues: []taskTemplateDefaultFieldValue{},
+		},
+	}
+
+	var specs []map[string]any
+
+	switch v := input.(type) {
+	case map[string]any:
+		for _, name := range sortedKeys(v) {
+			spec, ok := v[name].(map[string]any)
+			if !ok {
+				return definition, fmt.Errorf("field %s must be an object", name)
+			}
+			if _, ok := spec["name"]; ok {
+				return definition, fmt.Errorf("field %s: name is taken from the object key and must not be set", name)
+			}
+
+			spec["name"] = name
+			specs = append(specs, spec)
+		}
+	case []any:
+		for i, element := range v {
+			spec, ok := element.(map[string]any)
+			if !ok {
+				return definition, fmt.Errorf("field %d must be an object", i)
+			}
+
+			specs = append(specs, spec)
+		}
+	default:
+		return definition, fmt.Errorf("fields must be an object or a list of objects")
+	}
+
+	seen := map[string]bool{}
+
+	for i, spec := range specs {
+		name, ok := spec["name"].(string)
+		if !ok || name == "" {
+			return definition, fmt.Errorf("field %d: name must be a non-empty string", i)
+		}
+		if seen[name] {
+			return definition, fmt.Errorf("field %s is defined more than once", name)
+		}
+		seen[name] = true
+
+		if err := addTaskTemplateField(&definition, name, spec); err != nil {
+			return definition, fmt.Errorf("field %s: %w", name, err)
+		}
+	}
+
+	return definition, nil
+}
+
+func addTaskTemplateField(definition *taskTemplateDefinition, name string, spec map[string]any) error {
+	for key := range spec {
+		if !slices.Contains(taskTemplateFieldAttributes, key) {
+			return fmt.Errorf("unsupported attribute %s, expected one of %s", key, strings.Join(taskTemplateFieldAttributes, ", "))
+		}
+	}
+
+	id := taskTemplateFieldID{Name: name}
+
+	fieldType, _ := spec["type"].(string)
+	fieldType = strings.ToUpper(fieldType)
+	if !slices.Contains(connecttypes.TaskTemplateFieldType("").Values(), connecttypes.TaskTemplateFieldType(fieldType)) {
+		return fmt.Errorf("type must be one of %v", connecttypes.TaskTemplateFieldType("").Values())
+	}
+
+	field := taskTemplateField{Id: id, Type: fieldType}
+
+	if description, ok := spec["description"]; ok && description != nil {
+		if field.Description, ok = description.(string); !ok {
+			return fmt.Errorf("description must be a string")
+		}
+	}
+
+	if options, ok := spec["options"]; ok && options != nil {
+		if fieldType != string(connecttypes.TaskTemplateFieldTypeSingleSelect) {
+			return fmt.Errorf("options are only supported for %s fields", connecttypes.TaskTemplateFieldTypeSingleSelect)
+		}
+
+		list, ok := options.([]any)
+		if !ok {
+			return fmt.Errorf("options must be a list of strings")
+		}
+
+		for _, option := range list {
+			value, ok := option.(string)
+			if !ok {
+				return fmt.Errorf("options must be a list of strings")
+			}
+			field.SingleSelectOptions = append(field.SingleSelectOptions, value)
+		}
+	} else if fieldType == string(connecttypes.TaskTemplateFieldTypeSingleSelect) {
+		return fmt.Errorf("options are required for %s fields", connecttypes.TaskTemplateFieldTypeSingleSelect)
+	}
+
+	definition.Fields = append(definition.Fields, field)
+
+	constraints := map[string]*[]taskTemplateFieldRef{
+		"required":  &definition.Constraints.RequiredFields,
+		"read_only": &definition.Constraints.ReadOnlyFields,
+		"invisible": &definition.Constraints.InvisibleFields,
+	}
+
+	for _, key := range sortedKeys(constraints) {
+		value, ok := spec[key]
+		if !ok || value == nil {
+			continue
+		}
+
+		enabled, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("%s must be a bool", key)
+		}
+
+		if enabled {
+			*constraints[key] = append(*constraints[key], taskTemplateFieldRef{Id: id})
+		}
+	}
+
+	if value, ok := spec["default"]; ok && value != nil {
+		var defaultValue string
+
+		switch v := value.(type) {
+		case string:
+			defaultValue = v
+		case bool:
+			defaultValue = fmt.Sprint(v)
+		case *big.Float:
+			defaultValue = v.Text('f', -1)
+		default:
+			return fmt.Errorf("default must be a string, number or bool")
+		}
+
+		if fieldType == string(connecttypes.TaskTemplateFieldTypeSingleSelect) && !slices.Contains(field.SingleSelectOptions, defaultValue) {
+			return fmt.Errorf("default %q is not one of the options", defaultValue)
+		}
+
+		definition.Defaults.DefaultFieldValues = append(definition.Defaults.DefaultFieldValues, taskTemplateDefaultFieldValue{
+			Id:           id,
+			DefaultValue: defaultValue,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Default operation timeouts of resources, used when the timeouts block does
+// not set one.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
+
+// timeoutsBlock returns the timeouts block shared by all resources.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.BlockAll(ctx)
+}
+
+// withTimeout derives a context bounded by the configured timeout of an
+// operation, e.g. withTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout).
+func withTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	duration, diags := timeout(ctx, defaultTimeout)
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+
+	return ctx, cancel, diags
+}
+
+// nullTimeouts returns an unset timeouts block, for resources read without
+// configuration such as list results.
+func nullTimeouts(ctx context.Context) timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(timeoutsBlock(ctx).Type().(attr.TypeWithAttributeTypes).AttributeTypes()),
+	}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation name of the spans of AWS API calls.
+const tracerName = "github.com/USAN/terraform-provider-awsext"
+
+// Spans are exported in the background so the collector never delays API
+// calls: batched every tracingBatchTimeout, and dropped rather than retried
+// when the collector does not answer within tracingExportTimeout.
+const (
+	tracingBatchTimeout  = time.Second
+	tracingExportTimeout = 5 * time.Second
+)
+
+// tracerProviders caches the tracer provider of each OTLP endpoint, as the
+// provider is configured once per Terraform operation in the same process.
+var (
+	tracerProviders   = map[string]*sdktrace.TracerProvider{}
+	tracerProvidersMu sync.Mutex
+)
+
+// tracingEnabled reports whether traces should be exported, i.e. whether an
+// endpoint is configured in the provider or by the OTLP environment variables.
+func tracingEnabled(endpoint string) bool {
+	return endpoint != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != ""
+}
+
+// tracer returns the tracer exporting spans over OTLP/HTTP to endpoint, or to
+// the endpoint of the OTLP environment variables if it is empty.
+func tracer(ctx context.Context, endpoint string) (trace.Tracer, error) {
+	tracerProvidersMu.Lock()
+	defer tracerProvidersMu.Unlock()
+
+	if tracerProvider, ok := tracerProviders[endpoint]; ok {
+		return tracerProvider.Tracer(tracerName), nil
+	}
+
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithTimeout(tracingExportTimeout),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}),
+	}
+	if endpoint != "" {
+		options = append(options, otlptracehttp.WithEndpointURL(endpoint))
+	}
+
+	exporter, err := otlptracehttp.New(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	// The spans still batched when Terraform stops the provider are flushed
+	// by ShutdownTracing
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter, sdktrace.WithBatchTimeout(tracingBatchTimeout)),
+		sdktrace.WithResource(sdkresource.NewSchemaless(attribute.String("service.name", "terraform-provider-awsext"))),
+	)
+
+	tracerProviders[endpoint] = tracerProvider
+
+	return tracerProvider.Tracer(tracerName), nil
+}
+
+// ShutdownTracing exports the spans not exported yet and stops the tracer
+// providers. It is called once the provider server has stopped.
+func ShutdownTracing(ctx context.Context) error {
+	tracerProvidersMu.Lock()
+	defer tracerProvidersMu.Unlock()
+
+	var errs []error
+	for endpoint, tracerProvider := range tracerProviders {
+		errs = append(errs, tracerProvider.Shutdown(ctx))
+		delete(tracerProviders, endpoint)
+	}
+
+	return errors.Join(errs...)
+}
+
+// traceAPICalls returns the middleware emitting a span per AWS API call,
+// covering all its attempts, with the AWS request ID and error code.
+func traceAPICalls(tracer trace.Tracer) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Added after the service metadata middleware, which sets the service
+		// and operation names.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("AwsExtTracing", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			service := awsmiddleware.GetServiceID(ctx)
+			operation := awsmiddleware.GetOperationName(ctx)
+
+			ctx, span := tracer.Start(ctx, service+"."+operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attribute.String("rpc.system", "aws-api"),
+					attribute.String("rpc.service", service),
+					attribute.String("rpc.method", operation),
+					attribute.String("cloud.region", awsmiddleware.GetRegion(ctx)),
+				),
+			)
+			defer span.End()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				span.SetAttributes(attribute.String("aws.request_id", requestID))
+			}
+
+			if attempts, ok := retry.GetAttemptResults(metadata); ok {
+				span.SetAttributes(attribute.Int("aws.attempts", len(attempts.Results)))
+			}
+
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+
+				if code := errorCode(err); code != "" {
+					span.SetAttributes(attribute.String("aws.error_code", code))
+				}
+			}
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTracerExportsInBackground(t *testing.T) {
+	var exports atomic.Int32
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A slow collector
+		time.Sleep(300 * time.Millisecond)
+		exports.Add(1)
+	}))
+	defer collector.Close()
+
+	ctx := context.Background()
+	tracer, err := tracer(ctx, collector.URL+"/v1/traces")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	_, span := tracer.Start(ctx, "Connect.DescribeQueue")
+	span.End()
+
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("ending the span took %s, waiting for the collector", elapsed)
+	}
+
+	if err := ShutdownTracing(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if exports.Load() != 1 {
+		t.Errorf("got %d exports on shutdown, want 1", exports.Load())
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// stateUpgrade migrates a state, decoded from its raw JSON, from one schema
+// version to the next.
+type stateUpgrade func(state map[string]any) error
+
+// renameAttribute returns a stateUpgrade renaming the top level attribute from
+// to to, e.g. after renaming an attribute of the schema.
+func renameAttribute(from, to string) stateUpgrade {
+	return func(state map[string]any) error {
+		if value, ok := state[from]; ok {
+			state[to] = value
+			delete(state, from)
+		}
+
+		return nil
+	}
+}
+
+// removeAttribute returns a stateUpgrade dropping the top level attribute
+// name, e.g. after removing it from the schema.
+func removeAttribute(name string) stateUpgrade {
+	return func(state map[string]any) error {
+		delete(state, name)
+		return nil
+	}
+}
+
+// nullEmptyString returns a stateUpgrade setting the top level string
+// attribute name to null when empty, e.g. after dropping its "" default.
+func nullEmptyString(name string) stateUpgrade {
+	return func(state map[string]any) error {
+		if value, ok := state[name]; ok && value == "" {
+			state[name] = nil
+		}
+
+		return nil
+	}
+}
+
+// schemaVersion returns the current schema version of a resource with the
+// given state upgrades, where upgrades[v] migrates a state of version v to
+// version v+1.
+func schemaVersion(upgrades []stateUpgrade) int64 {
+	return int64(len(upgrades))
+}
+
+// stateUpgraders returns the UpgradeState implementation of a resource with
+// the given state upgrades. The state of every prior version is migrated to
+// the current schema version by applying the upgrades of all versions since
+// in order.
+func stateUpgraders(upgrades []stateUpgrade) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(upgrades))
+
+	for version := range upgrades {
+		pending := upgrades[version:]
+
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The prior state is missing.")
+					return
+				}
+
+				var state map[string]any
+				if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Could not decode the prior state of version %d: %s", version, err))
+					return
+				}
+
+				for i, upgrade := range pending {
+					if err := upgrade(state); err != nil {
+						resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Could not upgrade the state from version %d: %s", version+i, err))
+						return
+					}
+				}
+
+				upgraded, err := json.Marshal(state)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Could not encode the upgraded state: %s", err))
+					return
+				}
+
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		}
+	}
+
+	return upgraders
+}
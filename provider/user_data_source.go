@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+type UserDataSource struct {
+	providerData *ProviderData
+}
+
+type UserDataSourceModel struct {
+	InstanceID         types.String `tfsdk:"instance_id"`
+	InstanceAlias      types.String `tfsdk:"instance_alias"`
+	Username           types.String `tfsdk:"username"`
+	UserID             types.String `tfsdk:"user_id"`
+	Arn                types.String `tfsdk:"arn"`
+	FirstName          types.String `tfsdk:"first_name"`
+	LastName           types.String `tfsdk:"last_name"`
+	DirectoryUserID    types.String `tfsdk:"directory_user_id"`
+	RoutingProfileID   types.String `tfsdk:"routing_profile_id"`
+	SecurityProfileIDs types.Set    `tfsdk:"security_profile_ids"`
+	HierarchyGroupID   types.String `tfsdk:"hierarchy_group_id"`
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Connect user by username, e.g. a user provisioned by SSO",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "Username of the user, matched exactly.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"first_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"last_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"directory_user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the user in the directory of the instance, for instances using an existing directory.",
+			},
+			"routing_profile_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"security_profile_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"hierarchy_group_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the hierarchy group of the user, null if the user is in no hierarchy group.",
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.providerData.connectClient(nil)
+	username := data.Username.ValueString()
+	criteria := &conntypes.UserSearchCriteria{
+		StringCondition: stringCondition("Username", conntypes.StringComparisonTypeExact, username),
+	}
+
+	users, err := collectPages(ctx, searchUsers(conn, data.InstanceID.ValueString(), criteria), 0, func(user conntypes.UserSearchSummary) bool {
+		return aws.ToString(user.Username) == username
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error searching Connect Users", fmt.Sprintf("Could not search for user %s", username), err))
+		return
+	}
+
+	if len(users) == 0 {
+		resp.Diagnostics.AddError("Connect User Not Found", fmt.Sprintf("Instance %s has no user named %q.", data.InstanceID.ValueString(), username))
+		return
+	}
+
+	user := users[0]
+	identity := user.IdentityInfo
+	if identity == nil {
+		identity = &conntypes.UserIdentityInfoLite{}
+	}
+
+	data.UserID = types.StringPointerValue(user.Id)
+	data.Arn = types.StringPointerValue(user.Arn)
+	data.FirstName = types.StringPointerValue(identity.FirstName)
+	data.LastName = types.StringPointerValue(identity.LastName)
+	data.DirectoryUserID = types.StringPointerValue(user.DirectoryUserId)
+	data.RoutingProfileID = types.StringPointerValue(user.RoutingProfileId)
+	data.HierarchyGroupID = types.StringPointerValue(user.HierarchyGroupId)
+
+	securityProfileIDs, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, user.SecurityProfileIds...))
+	resp.Diagnostics.Append(diags...)
+	data.SecurityProfileIDs = securityProfileIDs
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
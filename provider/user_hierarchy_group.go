@@ -0,0 +1,483 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &UserHierarchyGroupResource{}
+var _ resource.ResourceWithImportState = &UserHierarchyGroupResource{}
+var _ resource.ResourceWithModifyPlan = &UserHierarchyGroupResource{}
+var _ resource.ResourceWithIdentity = &UserHierarchyGroupResource{}
+var _ resource.ResourceWithMoveState = &UserHierarchyGroupResource{}
+
+// userHierarchyGroupResourceType is the type of the user hierarchy group
+// resource without the provider prefix, e.g. in operation_policies.
+const userHierarchyGroupResourceType = "connect_user_hierarchy_group"
+
+func NewUserHierarchyGroupResource() resource.Resource {
+	return &UserHierarchyGroupResource{}
+}
+
+type UserHierarchyGroupResource struct {
+	providerData *ProviderData
+}
+
+type UserHierarchyGroupResourceModel struct {
+	Arn              types.String   `tfsdk:"arn"`
+	HierarchyGroupID types.String   `tfsdk:"hierarchy_group_id"`
+	InstanceID       types.String   `tfsdk:"instance_id"`
+	InstanceAlias    types.String   `tfsdk:"instance_alias"`
+	Name             types.String   `tfsdk:"name"`
+	ParentGroupID    types.String   `tfsdk:"parent_group_id"`
+	LevelID          types.String   `tfsdk:"level_id"`
+	ImportOnExists   types.Bool     `tfsdk:"import_on_exists"`
+	Tags             types.Map      `tfsdk:"tags"`
+	TagsAll          types.Map      `tfsdk:"tags_all"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	Override         *OverrideModel `tfsdk:"override"`
+}
+
+type UserHierarchyGroupResourceIdentityModel struct {
+	Arn              types.String `tfsdk:"arn"`
+	HierarchyGroupID types.String `tfsdk:"hierarchy_group_id"`
+}
+
+func (m UserHierarchyGroupResourceModel) identity() UserHierarchyGroupResourceIdentityModel {
+	return UserHierarchyGroupResourceIdentityModel{
+		Arn:              m.Arn,
+		HierarchyGroupID: m.HierarchyGroupID,
+	}
+}
+
+func (r *UserHierarchyGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + userHierarchyGroupResourceType
+}
+
+func (r *UserHierarchyGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = connectIdentitySchema("hierarchy_group_id")
+}
+
+func (r *UserHierarchyGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connect user hierarchy group, at the level below its parent group, or at the first level of the hierarchy structure",
+
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hierarchy_group_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"parent_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the parent group, none for groups at the first level. Changing it replaces the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"level_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the level of the hierarchy structure the group is at.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"import_on_exists": importOnExistsAttribute(),
+			"tags":             tagsAttribute(),
+			"tags_all":         tagsAllAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *UserHierarchyGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *UserHierarchyGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanTags(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *UserHierarchyGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(userHierarchyGroupResourceType, resp) {
+		return
+	}
+
+	var data UserHierarchyGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	adopt, diags := r.providerData.importOnExists(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	tagsAll, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(userHierarchyGroupResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(userHierarchyGroupResourceType, data.Override)
+
+	if adopt {
+		adoption := adoption[conntypes.HierarchyGroup]{
+			kind: "Connect User Hierarchy Groups",
+			find: func(ctx context.Context) (conntypes.HierarchyGroup, bool, error) {
+				return findUserHierarchyGroupByName(ctx, conn, data.InstanceID.ValueString(), data.Name.ValueString(), data.ParentGroupID.ValueString())
+			},
+			update: func(ctx context.Context, group conntypes.HierarchyGroup) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				data.HierarchyGroupID = types.StringValue(aws.ToString(group.Id))
+				data.Arn = types.StringValue(aws.ToString(group.Arn))
+				data.LevelID = types.StringValue(aws.ToString(group.LevelId))
+				tflog.Info(ctx, fmt.Sprintf("Imported Connect User Hierarchy Group with ID %s, updating...", data.HierarchyGroupID.ValueString()))
+
+				if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), ignoreTags(group.Tags, r.providerData.IgnoreTags), tagsAll); err != nil {
+					diags.Append(apiError("Error updating Connect User Hierarchy Group tags", "Could not update Connect User Hierarchy Group tags", err))
+				}
+
+				return diags
+			},
+		}
+
+		if adoption.adopt(ctx, resp, &data, func() any { return data.identity() }) {
+			return
+		}
+	}
+
+	input := &connect.CreateUserHierarchyGroupInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		Name:       aws.String(data.Name.ValueString()),
+	}
+
+	if !data.ParentGroupID.IsNull() {
+		input.ParentGroupId = aws.String(data.ParentGroupID.ValueString())
+	}
+
+	if len(tagsAll) > 0 {
+		input.Tags = tagsAll
+	}
+
+	response, err := conn.CreateUserHierarchyGroup(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect User Hierarchy Group", "Could not create Connect User Hierarchy Group", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	data.HierarchyGroupID = types.StringValue(aws.ToString(response.HierarchyGroupId))
+	data.Arn = types.StringValue(aws.ToString(response.HierarchyGroupArn))
+
+	// The level is only returned by DescribeUserHierarchyGroup
+	group, err := describeUserHierarchyGroup(ctx, conn, data.InstanceID.ValueString(), data.HierarchyGroupID.ValueString())
+
+	if err != nil {
+		data.LevelID = types.StringNull()
+		resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+		resp.Diagnostics.Append(apiError("Error reading Connect User Hierarchy Group", "Could not read the created Connect User Hierarchy Group", err))
+		return
+	}
+
+	data.LevelID = types.StringValue(aws.ToString(group.LevelId))
+
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *UserHierarchyGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserHierarchyGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(userHierarchyGroupResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(userHierarchyGroupResourceType, data.Override)
+	group, err := describeUserHierarchyGroup(ctx, conn, data.InstanceID.ValueString(), data.HierarchyGroupID.ValueString())
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect User Hierarchy Group", "Could not read Connect User Hierarchy Group", err))
+		return
+	}
+
+	data.HierarchyGroupID = types.StringValue(aws.ToString(group.Id))
+	data.Arn = types.StringValue(aws.ToString(group.Arn))
+	data.Name = types.StringValue(aws.ToString(group.Name))
+	data.LevelID = types.StringValue(aws.ToString(group.LevelId))
+	data.ParentGroupID = types.StringNull()
+
+	if parent := hierarchyGroupParent(group.HierarchyPath); parent != nil {
+		data.ParentGroupID = types.StringValue(aws.ToString(parent.Id))
+	}
+
+	data.Tags, data.TagsAll, diags = r.providerData.readTags(ctx, group.Tags, data.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *UserHierarchyGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, userHierarchyGroupResourceType, req, resp) {
+		return
+	}
+
+	var data, state UserHierarchyGroupResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(userHierarchyGroupResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(userHierarchyGroupResourceType, data.Override)
+
+	if !data.Name.Equal(state.Name) {
+		_, err := conn.UpdateUserHierarchyGroupName(ctx, &connect.UpdateUserHierarchyGroupNameInput{
+			InstanceId:       aws.String(data.InstanceID.ValueString()),
+			HierarchyGroupId: aws.String(data.HierarchyGroupID.ValueString()),
+			Name:             aws.String(data.Name.ValueString()),
+		})
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect User Hierarchy Group", "Could not update the name of Connect User Hierarchy Group", err))
+			return
+		}
+	}
+
+	if !data.TagsAll.Equal(state.TagsAll) {
+		oldTags, diags := mapFromTags(ctx, state.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		newTags, diags := mapFromTags(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), oldTags, newTags); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect User Hierarchy Group tags", "Could not update Connect User Hierarchy Group tags", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserHierarchyGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(userHierarchyGroupResourceType, resp) {
+		return
+	}
+
+	var data UserHierarchyGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(userHierarchyGroupResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(userHierarchyGroupResourceType, data.Override)
+
+	// Child groups destroyed in the same apply may not be deleted yet, so
+	// deleting their parent is retried until the delete timeout
+	var err error
+	waitErr := waitFor(ctx, defaultPollInterval, func(ctx context.Context) (bool, error) {
+		_, err = conn.DeleteUserHierarchyGroup(ctx, &connect.DeleteUserHierarchyGroupInput{
+			InstanceId:       aws.String(data.InstanceID.ValueString()),
+			HierarchyGroupId: aws.String(data.HierarchyGroupID.ValueString()),
+		})
+
+		if isResourceInUse(err) {
+			tflog.Debug(ctx, fmt.Sprintf("Connect User Hierarchy Group %s is still in use, retrying", data.HierarchyGroupID.ValueString()))
+			return false, nil
+		}
+
+		return true, nil
+	})
+
+	if err == nil && waitErr != nil {
+		err = waitErr
+	}
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error deleting Connect User Hierarchy Group", "Could not delete Connect User Hierarchy Group", err))
+	}
+}
+
+func (r *UserHierarchyGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importConnectResource(ctx, req, resp, "agent-group", "hierarchy_group_id")
+}
+
+// MoveState migrates the state of aws_connect_user_hierarchy_group in moved blocks.
+func (r *UserHierarchyGroupResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{connectStateMover("aws_connect_user_hierarchy_group", "hierarchy_group_id")}
+}
+
+func describeUserHierarchyGroup(ctx context.Context, conn *connect.Client, instanceID, hierarchyGroupID string) (*conntypes.HierarchyGroup, error) {
+	response, err := conn.DescribeUserHierarchyGroup(ctx, &connect.DescribeUserHierarchyGroupInput{
+		InstanceId:       aws.String(instanceID),
+		HierarchyGroupId: aws.String(hierarchyGroupID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.HierarchyGroup, nil
+}
+
+// hierarchyGroupParent returns the parent of a group from its path, which
+// lists the group and its ancestors from the first level down, or nil for
+// groups at the first level.
+func hierarchyGroupParent(hierarchyPath *conntypes.HierarchyPath) *conntypes.HierarchyGroupSummary {
+	if hierarchyPath == nil {
+		return nil
+	}
+
+	levels := []*conntypes.HierarchyGroupSummary{}
+	for _, level := range []*conntypes.HierarchyGroupSummary{hierarchyPath.LevelOne, hierarchyPath.LevelTwo, hierarchyPath.LevelThree, hierarchyPath.LevelFour, hierarchyPath.LevelFive} {
+		if level != nil {
+			levels = append(levels, level)
+		}
+	}
+
+	if len(levels) < 2 {
+		return nil
+	}
+
+	return levels[len(levels)-2]
+}
+
+func searchUserHierarchyGroups(conn *connect.Client, instanceID string, criteria *conntypes.UserHierarchyGroupSearchCriteria) pageLister[conntypes.HierarchyGroup] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.HierarchyGroup, *string, error) {
+		response, err := conn.SearchUserHierarchyGroups(ctx, &connect.SearchUserHierarchyGroupsInput{
+			InstanceId:     aws.String(instanceID),
+			MaxResults:     aws.Int32(searchPageSize),
+			NextToken:      nextToken,
+			SearchCriteria: criteria,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.UserHierarchyGroups, response.NextToken, nil
+	}
+}
+
+// findUserHierarchyGroupByName returns the group of an instance with the given
+// name and parent, at the first level if parentGroupID is empty, as groups
+// with different parents may have the same name.
+func findUserHierarchyGroupByName(ctx context.Context, conn *connect.Client, instanceID string, name string, parentGroupID string) (conntypes.HierarchyGroup, bool, error) {
+	criteria := &conntypes.UserHierarchyGroupSearchCriteria{
+		StringCondition: stringCondition("name", conntypes.StringComparisonTypeExact, name),
+	}
+
+	return findExisting(ctx, searchUserHierarchyGroups(conn, instanceID, criteria), func(group conntypes.HierarchyGroup) bool {
+		if aws.ToString(group.Name) != name {
+			return false
+		}
+
+		parent := hierarchyGroupParent(group.HierarchyPath)
+		if parent == nil {
+			return parentGroupID == ""
+		}
+
+		return aws.ToString(parent.Id) == parentGroupID
+	})
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &UserHierarchyGroupAssociationResource{}
+var _ resource.ResourceWithImportState = &UserHierarchyGroupAssociationResource{}
+var _ resource.ResourceWithModifyPlan = &UserHierarchyGroupAssociationResource{}
+
+// userHierarchyGroupAssociationResourceType is the type of the user hierarchy
+// group association resource without the provider prefix, e.g. in
+// operation_policies.
+const userHierarchyGroupAssociationResourceType = "connect_user_hierarchy_group_association"
+
+func NewUserHierarchyGroupAssociationResource() resource.Resource {
+	return &UserHierarchyGroupAssociationResource{}
+}
+
+type UserHierarchyGroupAssociationResource struct {
+	providerData *ProviderData
+}
+
+type UserHierarchyGroupAssociationResourceModel struct {
+	InstanceID       types.String   `tfsdk:"instance_id"`
+	InstanceAlias    types.String   `tfsdk:"instance_alias"`
+	UserID           types.String   `tfsdk:"user_id"`
+	HierarchyGroupID types.String   `tfsdk:"hierarchy_group_id"`
+	SkipDestroy      types.Bool     `tfsdk:"skip_destroy"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	Override         *OverrideModel `tfsdk:"override"`
+}
+
+func (r *UserHierarchyGroupAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + userHierarchyGroupAssociationResourceType
+}
+
+func (r *UserHierarchyGroupAssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns a Connect user created elsewhere to a hierarchy group",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"user_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hierarchy_group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the hierarchy group the user is assigned to. Do not also set the hierarchy_group_id of the user in awsext_connect_users_bulk.",
+			},
+			skipDestroyAttributeName: skipDestroyAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *UserHierarchyGroupAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *UserHierarchyGroupAssociationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *UserHierarchyGroupAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(userHierarchyGroupAssociationResourceType, resp) {
+		return
+	}
+
+	var data UserHierarchyGroupAssociationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(userHierarchyGroupAssociationResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(userHierarchyGroupAssociationResourceType, data.Override)
+
+	if err := updateUserHierarchy(ctx, conn, data.InstanceID.ValueString(), data.UserID.ValueString(), data.HierarchyGroupID.ValueStringPointer()); err != nil {
+		resp.Diagnostics.Append(apiError("Error updating Connect User", fmt.Sprintf("Could not assign user %s to hierarchy group %s", data.UserID.ValueString(), data.HierarchyGroupID.ValueString()), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserHierarchyGroupAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserHierarchyGroupAssociationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(userHierarchyGroupAssociationResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(userHierarchyGroupAssociationResourceType, data.Override)
+	response, err := conn.DescribeUser(ctx, &connect.DescribeUserInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		UserId:     aws.String(data.UserID.ValueString()),
+	})
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect User", fmt.Sprintf("Could not read user %s", data.UserID.ValueString()), err))
+		return
+	}
+
+	// The association is gone once the user is in no hierarchy group
+	if aws.ToString(response.User.HierarchyGroupId) == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.HierarchyGroupID = types.StringPointerValue(response.User.HierarchyGroupId)
+
+	// skip_destroy is not set on import
+	if data.SkipDestroy.IsNull() {
+		data.SkipDestroy = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserHierarchyGroupAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, userHierarchyGroupAssociationResourceType, req, resp) {
+		return
+	}
+
+	var data UserHierarchyGroupAssociationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(userHierarchyGroupAssociationResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(userHierarchyGroupAssociationResourceType, data.Override)
+
+	if err := updateUserHierarchy(ctx, conn, data.InstanceID.ValueString(), data.UserID.ValueString(), data.HierarchyGroupID.ValueStringPointer()); err != nil {
+		resp.Diagnostics.Append(apiError("Error updating Connect User", fmt.Sprintf("Could not assign user %s to hierarchy group %s", data.UserID.ValueString(), data.HierarchyGroupID.ValueString()), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserHierarchyGroupAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(userHierarchyGroupAssociationResourceType, resp) {
+		return
+	}
+
+	var data UserHierarchyGroupAssociationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	skip, diags := skipDestroy(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || skip {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(userHierarchyGroupAssociationResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	// Without a hierarchy group, the user is removed from the hierarchy
+	conn := r.providerData.resourceConnectClient(userHierarchyGroupAssociationResourceType, data.Override)
+	err := updateUserHierarchy(ctx, conn, data.InstanceID.ValueString(), data.UserID.ValueString(), nil)
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error updating Connect User", fmt.Sprintf("Could not remove user %s from its hierarchy group", data.UserID.ValueString()), err))
+	}
+}
+
+func (r *UserHierarchyGroupAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with format <instance_id>:<user_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+}
+
+// updateUserHierarchy assigns the user to the hierarchy group, or removes it
+// from the hierarchy if hierarchyGroupID is nil.
+func updateUserHierarchy(ctx context.Context, conn *connect.Client, instanceID, userID string, hierarchyGroupID *string) error {
+	_, err := conn.UpdateUserHierarchy(ctx, &connect.UpdateUserHierarchyInput{
+		InstanceId:       aws.String(instanceID),
+		UserId:           aws.String(userID),
+		HierarchyGroupId: hierarchyGroupID,
+	})
+
+	return err
+}
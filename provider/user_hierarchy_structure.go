@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &UserHierarchyStructureResource{}
+var _ resource.ResourceWithImportState = &UserHierarchyStructureResource{}
+var _ resource.ResourceWithModifyPlan = &UserHierarchyStructureResource{}
+var _ resource.ResourceWithMoveState = &UserHierarchyStructureResource{}
+
+// userHierarchyStructureResourceType is the type of the user hierarchy
+// structure resource without the provider prefix, e.g. in operation_policies.
+const userHierarchyStructureResourceType = "connect_user_hierarchy_structure"
+
+func NewUserHierarchyStructureResource() resource.Resource {
+	return &UserHierarchyStructureResource{}
+}
+
+type UserHierarchyStructureResource struct {
+	providerData *ProviderData
+}
+
+type UserHierarchyStructureResourceModel struct {
+	InstanceID    types.String   `tfsdk:"instance_id"`
+	InstanceAlias types.String   `tfsdk:"instance_alias"`
+	Levels        types.List     `tfsdk:"levels"`
+	LevelIDs      types.List     `tfsdk:"level_ids"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	Override      *OverrideModel `tfsdk:"override"`
+}
+
+func (r *UserHierarchyStructureResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + userHierarchyStructureResourceType
+}
+
+func (r *UserHierarchyStructureResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Names of the levels of the user hierarchy of a Connect instance. Destroying the resource removes all levels",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"levels": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Names of the levels, from the first level down. Levels can only be removed once they have no groups.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 5),
+					listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 50)),
+				},
+			},
+			"level_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the levels, in the order of levels.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *UserHierarchyStructureResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *UserHierarchyStructureResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+
+	// Nothing to compare on create or destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state UserHierarchyStructureResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Renamed levels keep their IDs, added levels get new ones
+	if plan.Levels.IsUnknown() || len(plan.Levels.Elements()) != len(state.Levels.Elements()) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("level_ids"), types.ListUnknown(types.StringType))...)
+	}
+}
+
+func (r *UserHierarchyStructureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(userHierarchyStructureResourceType, resp) {
+		return
+	}
+
+	var data UserHierarchyStructureResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(userHierarchyStructureResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserHierarchyStructureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserHierarchyStructureResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(userHierarchyStructureResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(userHierarchyStructureResourceType, data.Override)
+	response, err := conn.DescribeUserHierarchyStructure(ctx, &connect.DescribeUserHierarchyStructureInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+	})
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect User Hierarchy Structure", "Could not read Connect User Hierarchy Structure", err))
+		return
+	}
+
+	levels := hierarchyStructureLevels(response.HierarchyStructure)
+
+	// The structure was removed outside Terraform
+	if len(levels) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.flattenLevels(ctx, levels)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserHierarchyStructureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, userHierarchyStructureResourceType, req, resp) {
+		return
+	}
+
+	var data UserHierarchyStructureResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(userHierarchyStructureResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// update sets the levels of the structure to the levels of the model, and
+// the level IDs of the model to those of the updated structure.
+func (r *UserHierarchyStructureResource) update(ctx context.Context, data *UserHierarchyStructureResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := []string{}
+	diags.Append(data.Levels.ElementsAs(ctx, &names, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	conn := r.providerData.resourceConnectClient(userHierarchyStructureResourceType, data.Override)
+
+	if err := updateUserHierarchyStructure(ctx, conn, data.InstanceID.ValueString(), names); err != nil {
+		diags.Append(apiError("Error updating Connect User Hierarchy Structure", "Could not update Connect User Hierarchy Structure", err))
+		return diags
+	}
+
+	response, err := conn.DescribeUserHierarchyStructure(ctx, &connect.DescribeUserHierarchyStructureInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+	})
+
+	if err != nil {
+		diags.Append(apiError("Error reading Connect User Hierarchy Structure", "Could not read the updated Connect User Hierarchy Structure", err))
+		return diags
+	}
+
+	levelIDs := []string{}
+	for _, level := range hierarchyStructureLevels(response.HierarchyStructure) {
+		levelIDs = append(levelIDs, aws.ToString(level.Id))
+	}
+
+	data.LevelIDs, diags = types.ListValueFrom(ctx, types.StringType, levelIDs)
+
+	return diags
+}
+
+func (r *UserHierarchyStructureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(userHierarchyStructureResourceType, resp) {
+		return
+	}
+
+	var data UserHierarchyStructureResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(userHierarchyStructureResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(userHierarchyStructureResourceType, data.Override)
+
+	// Groups destroyed in the same apply may not be deleted yet, so removing
+	// the levels is retried until the delete timeout
+	var err error
+	waitErr := waitFor(ctx, defaultPollInterval, func(ctx context.Context) (bool, error) {
+		err = updateUserHierarchyStructure(ctx, conn, data.InstanceID.ValueString(), nil)
+
+		if isResourceInUse(err) {
+			tflog.Debug(ctx, "Connect User Hierarchy Structure still has groups, retrying")
+			return false, nil
+		}
+
+		return true, nil
+	})
+
+	if err == nil && waitErr != nil {
+		err = waitErr
+	}
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error deleting Connect User Hierarchy Structure", "Could not remove the levels of Connect User Hierarchy Structure", err))
+	}
+}
+
+func (r *UserHierarchyStructureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("instance_id"), req, resp)
+}
+
+// MoveState migrates the state of aws_connect_user_hierarchy_structure in moved blocks.
+func (r *UserHierarchyStructureResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{connectUnscopedStateMover("aws_connect_user_hierarchy_structure", "instance_id")}
+}
+
+// flattenLevels sets the levels and level IDs of the model.
+func (m *UserHierarchyStructureResourceModel) flattenLevels(ctx context.Context, levels []*conntypes.HierarchyLevel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := []string{}
+	levelIDs := []string{}
+	for _, level := range levels {
+		names = append(names, aws.ToString(level.Name))
+		levelIDs = append(levelIDs, aws.ToString(level.Id))
+	}
+
+	m.Levels, diags = types.ListValueFrom(ctx, types.StringType, names)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.LevelIDs, diags = types.ListValueFrom(ctx, types.StringType, levelIDs)
+
+	return diags
+}
+
+// hierarchyStructureLevels returns the levels of a structure from the first
+// level down.
+func hierarchyStructureLevels(structure *conntypes.HierarchyStructure) []*conntypes.HierarchyLevel {
+	levels := []*conntypes.HierarchyLevel{}
+
+	if structure == nil {
+		return levels
+	}
+
+	for _, level := range []*conntypes.HierarchyLevel{structure.LevelOne, structure.LevelTwo, structure.LevelThree, structure.LevelFour, structure.LevelFive} {
+		if level == nil {
+			break
+		}
+
+		levels = append(levels, level)
+	}
+
+	return levels
+}
+
+// updateUserHierarchyStructure sets the names of the levels of the structure
+// of an instance, removing the levels beyond the given names.
+func updateUserHierarchyStructure(ctx context.Context, conn *connect.Client, instanceID string, names []string) error {
+	updates := make([]*conntypes.HierarchyLevelUpdate, 5)
+	for i, name := range names {
+		updates[i] = &conntypes.HierarchyLevelUpdate{Name: aws.String(name)}
+	}
+
+	_, err := conn.UpdateUserHierarchyStructure(ctx, &connect.UpdateUserHierarchyStructureInput{
+		InstanceId: aws.String(instanceID),
+		HierarchyStructure: &conntypes.HierarchyStructureUpdate{
+			LevelOne:   updates[0],
+			LevelTwo:   updates[1],
+			LevelThree: updates[2],
+			LevelFour:  updates[3],
+			LevelFive:  updates[4],
+		},
+	})
+
+	return err
+}
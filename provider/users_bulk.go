@@ -0,0 +1,679 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &UsersBulkResource{}
+var _ resource.ResourceWithImportState = &UsersBulkResource{}
+var _ resource.ResourceWithModifyPlan = &UsersBulkResource{}
+
+// usersBulkResourceType is the type of the bulk users resource without the
+// provider prefix, e.g. in operation_policies.
+const usersBulkResourceType = "connect_users_bulk"
+
+// userOperationConcurrency bounds the users created, updated or deleted at
+// once by resources managing many users. Requests are further limited by the
+// provider's max_concurrent_requests and slowed down when Connect throttles.
+const userOperationConcurrency = 10
+
+func NewUsersBulkResource() resource.Resource {
+	return &UsersBulkResource{}
+}
+
+type UsersBulkResource struct {
+	providerData *ProviderData
+}
+
+type UsersBulkResourceModel struct {
+	InstanceID    types.String             `tfsdk:"instance_id"`
+	InstanceAlias types.String             `tfsdk:"instance_alias"`
+	Users         map[string]BulkUserModel `tfsdk:"users"`
+	Tags          types.Map                `tfsdk:"tags"`
+	TagsAll       types.Map                `tfsdk:"tags_all"`
+	Timeouts      timeouts.Value           `tfsdk:"timeouts"`
+	Override      *OverrideModel           `tfsdk:"override"`
+
+	// InitialPasswordWo is always null in the plan and state, the password
+	// is read from the configuration.
+	InitialPasswordWo        types.String `tfsdk:"initial_password_wo"`
+	InitialPasswordWoVersion types.Int64  `tfsdk:"initial_password_wo_version"`
+}
+
+type BulkUserModel struct {
+	UserID                    types.String `tfsdk:"user_id"`
+	Arn                       types.String `tfsdk:"arn"`
+	FirstName                 types.String `tfsdk:"first_name"`
+	LastName                  types.String `tfsdk:"last_name"`
+	Email                     types.String `tfsdk:"email"`
+	RoutingProfileID          types.String `tfsdk:"routing_profile_id"`
+	SecurityProfileIDs        types.Set    `tfsdk:"security_profile_ids"`
+	HierarchyGroupID          types.String `tfsdk:"hierarchy_group_id"`
+	PhoneType                 types.String `tfsdk:"phone_type"`
+	AutoAccept                types.Bool   `tfsdk:"auto_accept"`
+	AfterContactWorkTimeLimit types.Int32  `tfsdk:"after_contact_work_time_limit"`
+	DeskPhoneNumber           types.String `tfsdk:"desk_phone_number"`
+}
+
+func (r *UsersBulkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + usersBulkResourceType
+}
+
+func (r *UsersBulkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the users of a Connect instance in bulk, keyed by username, for instances with too many agents to manage as individual resources",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"users": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Users by username.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 100)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"arn": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"first_name": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+						"last_name": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+						"email": schema.StringAttribute{
+							Optional:    true,
+							Description: "Email address of the user. It is not refreshed, as searches of users do not return it.",
+						},
+						"routing_profile_id": schema.StringAttribute{
+							Required: true,
+						},
+						"security_profile_ids": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 10),
+							},
+						},
+						"hierarchy_group_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"phone_type": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(string(conntypes.PhoneTypeSoftPhone)),
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(conntypes.PhoneTypeSoftPhone), string(conntypes.PhoneTypeDeskPhone)),
+							},
+						},
+						"auto_accept": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"after_contact_work_time_limit": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int32default.StaticInt32(0),
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+						"desk_phone_number": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								validE164(),
+							},
+						},
+					},
+				},
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+
+	maps.Copy(resp.Schema.Attributes, writeOnlySecretAttributes("initial_password", "Password of the users created in instances managing their own users, only sent when users are created"))
+}
+
+func (r *UsersBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *UsersBulkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanTags(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+}
+
+func (r *UsersBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(usersBulkResourceType, resp) {
+		return
+	}
+
+	var data UsersBulkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	password, diags := writeOnlySecret(ctx, req.Config, "initial_password")
+	resp.Diagnostics.Append(diags...)
+
+	tagsAll, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(usersBulkResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(usersBulkResourceType, data.Override)
+	planned := data.Users
+	data.Users = map[string]BulkUserModel{}
+
+	var mu sync.Mutex
+	err := forEachConcurrently(slices.Sorted(maps.Keys(planned)), userOperationConcurrency, func(username string) error {
+		user := planned[username]
+		if err := createBulkUser(ctx, conn, data.InstanceID.ValueString(), username, &user, password, tagsAll); err != nil {
+			return fmt.Errorf("creating user %s: %w", username, err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		data.Users[username] = user
+
+		return nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect Users", "Could not create all Connect Users", err))
+	}
+
+	// Terraform taints a resource whose create failed, so the next apply
+	// would delete and create again all the users created
+	if err != nil && len(data.Users) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Connect Users Will Be Replaced",
+			fmt.Sprintf("%d of %d users were created before the error and are saved in the state, but Terraform taints the resource, so the next apply deletes and creates them again with new user IDs. "+
+				"Run terraform untaint on this resource to keep them instead, the next apply then creates the missing users in place.", len(data.Users), len(planned)),
+		)
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created %d users", len(data.Users)))
+
+	// Save data into Terraform state, including the users created before an
+	// error so they are not orphaned
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// createBulkUser creates a user with the given tags and sets its ID and ARN.
+func createBulkUser(ctx context.Context, conn *connect.Client, instanceID, username string, user *BulkUserModel, password types.String, tags map[string]string) error {
+	input := &connect.CreateUserInput{
+		InstanceId:       aws.String(instanceID),
+		Username:         aws.String(username),
+		RoutingProfileId: aws.String(user.RoutingProfileID.ValueString()),
+		IdentityInfo:     user.identityInfo(),
+		PhoneConfig:      user.phoneConfig(),
+		HierarchyGroupId: user.HierarchyGroupID.ValueStringPointer(),
+		Password:         password.ValueStringPointer(),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags
+	}
+
+	if diags := user.SecurityProfileIDs.ElementsAs(ctx, &input.SecurityProfileIds, false); diags.HasError() {
+		return fmt.Errorf("reading security_profile_ids")
+	}
+
+	response, err := conn.CreateUser(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	user.UserID = types.StringValue(aws.ToString(response.UserId))
+	user.Arn = types.StringValue(aws.ToString(response.UserArn))
+
+	return nil
+}
+
+func (m BulkUserModel) identityInfo() *conntypes.UserIdentityInfo {
+	return &conntypes.UserIdentityInfo{
+		FirstName: m.FirstName.ValueStringPointer(),
+		LastName:  m.LastName.ValueStringPointer(),
+		Email:     m.Email.ValueStringPointer(),
+	}
+}
+
+func (m BulkUserModel) phoneConfig() *conntypes.UserPhoneConfig {
+	return &conntypes.UserPhoneConfig{
+		PhoneType:                 conntypes.PhoneType(m.PhoneType.ValueString()),
+		AutoAccept:                m.AutoAccept.ValueBool(),
+		AfterContactWorkTimeLimit: m.AfterContactWorkTimeLimit.ValueInt32(),
+		DeskPhoneNumber:           m.DeskPhoneNumber.ValueStringPointer(),
+	}
+}
+
+// searchUsers returns a lister of the users of an instance matching criteria,
+// or of all users if criteria is nil.
+func searchUsers(conn *connect.Client, instanceID string, criteria *conntypes.UserSearchCriteria) pageLister[conntypes.UserSearchSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.UserSearchSummary, *string, error) {
+		response, err := conn.SearchUsers(ctx, &connect.SearchUsersInput{
+			InstanceId:     aws.String(instanceID),
+			MaxResults:     aws.Int32(searchPageSize),
+			NextToken:      nextToken,
+			SearchCriteria: criteria,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Users, response.NextToken, nil
+	}
+}
+
+func (r *UsersBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UsersBulkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(usersBulkResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	// All users are read in pages of a single search instead of one describe
+	// per user
+	conn := r.providerData.resourceConnectClient(usersBulkResourceType, data.Override)
+	users, err := collectPages(ctx, searchUsers(conn, data.InstanceID.ValueString(), nil), 0, nil)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error searching Connect Users", "Could not search Connect Users", err))
+		return
+	}
+
+	byUsername := make(map[string]conntypes.UserSearchSummary, len(users))
+	for _, user := range users {
+		byUsername[aws.ToString(user.Username)] = user
+	}
+
+	// On import, all users of the instance are managed
+	if data.Users == nil {
+		data.Users = make(map[string]BulkUserModel, len(byUsername))
+		for username := range byUsername {
+			data.Users[username] = BulkUserModel{}
+		}
+	}
+
+	for username, user := range data.Users {
+		summary, ok := byUsername[username]
+		if !ok {
+			delete(data.Users, username)
+			continue
+		}
+
+		resp.Diagnostics.Append(user.flatten(ctx, summary)...)
+		data.Users[username] = user
+	}
+
+	// The users are tagged alike, so the tags of the first user whose tags
+	// drifted, if any, are reported as the tags of all users
+	prior, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	remote := prior
+	for _, username := range slices.Sorted(maps.Keys(data.Users)) {
+		if tags := ignoreTags(byUsername[username].Tags, r.providerData.IgnoreTags); !maps.Equal(tags, prior) {
+			remote = tags
+			break
+		}
+	}
+
+	data.Tags, data.TagsAll, diags = r.providerData.readTags(ctx, remote, data.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flatten sets the model from a user returned by SearchUsers, which does not
+// return the email address.
+func (m *BulkUserModel) flatten(ctx context.Context, user conntypes.UserSearchSummary) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.UserID = types.StringValue(aws.ToString(user.Id))
+	m.Arn = types.StringValue(aws.ToString(user.Arn))
+	m.RoutingProfileID = types.StringValue(aws.ToString(user.RoutingProfileId))
+	m.HierarchyGroupID = types.StringPointerValue(user.HierarchyGroupId)
+	m.SecurityProfileIDs, diags = types.SetValueFrom(ctx, types.StringType, user.SecurityProfileIds)
+
+	m.FirstName, m.LastName = types.StringNull(), types.StringNull()
+	if user.IdentityInfo != nil {
+		m.FirstName = types.StringPointerValue(user.IdentityInfo.FirstName)
+		m.LastName = types.StringPointerValue(user.IdentityInfo.LastName)
+	}
+
+	if m.Email.IsUnknown() {
+		m.Email = types.StringNull()
+	}
+
+	if user.PhoneConfig != nil {
+		m.PhoneType = types.StringValue(string(user.PhoneConfig.PhoneType))
+		m.AutoAccept = types.BoolValue(user.PhoneConfig.AutoAccept)
+		m.AfterContactWorkTimeLimit = types.Int32Value(user.PhoneConfig.AfterContactWorkTimeLimit)
+		m.DeskPhoneNumber = types.StringNull()
+		if aws.ToString(user.PhoneConfig.DeskPhoneNumber) != "" {
+			m.DeskPhoneNumber = types.StringPointerValue(user.PhoneConfig.DeskPhoneNumber)
+		}
+	}
+
+	return diags
+}
+
+func (r *UsersBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, usersBulkResourceType, req, resp) {
+		return
+	}
+
+	var data, state UsersBulkResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	password, diags := writeOnlySecret(ctx, req.Config, "initial_password")
+	resp.Diagnostics.Append(diags...)
+	passwordChanged, diags := writeOnlySecretChanged(ctx, req.Plan, req.State, "initial_password")
+	resp.Diagnostics.Append(diags...)
+
+	oldTags, diags := mapFromTags(ctx, state.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	newTags, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Connect only sets passwords when users are created, existing users
+	// keep theirs
+	if passwordChanged && len(state.Users) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Initial Password Not Applied to Existing Users",
+			"initial_password_wo_version changed, but Connect only sets passwords when users are created. The new initial password is used for the users created from now on, existing users keep their passwords.",
+		)
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(usersBulkResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(usersBulkResourceType, data.Override)
+	instanceID := data.InstanceID.ValueString()
+	planned := data.Users
+
+	// The users are updated from the prior state, so users whose change
+	// failed keep their prior values
+	data.Users = maps.Clone(state.Users)
+
+	usernames := slices.Sorted(maps.Keys(planned))
+	for username := range state.Users {
+		if _, ok := planned[username]; !ok {
+			usernames = append(usernames, username)
+		}
+	}
+
+	var mu sync.Mutex
+	err := forEachConcurrently(usernames, userOperationConcurrency, func(username string) error {
+		prior, exists := state.Users[username]
+		user, keep := planned[username]
+
+		switch {
+		case !keep:
+			if err := deleteBulkUser(ctx, conn, instanceID, prior); err != nil {
+				return fmt.Errorf("deleting user %s: %w", username, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			delete(data.Users, username)
+
+			return nil
+		case !exists:
+			if err := createBulkUser(ctx, conn, instanceID, username, &user, password, newTags); err != nil {
+				return fmt.Errorf("creating user %s: %w", username, err)
+			}
+		default:
+			user.UserID, user.Arn = prior.UserID, prior.Arn
+			if err := updateBulkUser(ctx, conn, instanceID, user, prior, oldTags, newTags); err != nil {
+				return fmt.Errorf("updating user %s: %w", username, err)
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		data.Users[username] = user
+
+		return nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error updating Connect Users", "Could not update all Connect Users", err))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateBulkUser applies the changes of a user and of the tags of all users,
+// calling only the update operations of the changed settings.
+func updateBulkUser(ctx context.Context, conn *connect.Client, instanceID string, user, prior BulkUserModel, oldTags, newTags map[string]string) error {
+	userID := aws.String(user.UserID.ValueString())
+
+	if !user.FirstName.Equal(prior.FirstName) || !user.LastName.Equal(prior.LastName) || !user.Email.Equal(prior.Email) {
+		_, err := conn.UpdateUserIdentityInfo(ctx, &connect.UpdateUserIdentityInfoInput{
+			InstanceId:   aws.String(instanceID),
+			UserId:       userID,
+			IdentityInfo: user.identityInfo(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !user.PhoneType.Equal(prior.PhoneType) || !user.AutoAccept.Equal(prior.AutoAccept) || !user.AfterContactWorkTimeLimit.Equal(prior.AfterContactWorkTimeLimit) || !user.DeskPhoneNumber.Equal(prior.DeskPhoneNumber) {
+		_, err := conn.UpdateUserPhoneConfig(ctx, &connect.UpdateUserPhoneConfigInput{
+			InstanceId:  aws.String(instanceID),
+			UserId:      userID,
+			PhoneConfig: user.phoneConfig(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !user.RoutingProfileID.Equal(prior.RoutingProfileID) {
+		_, err := conn.UpdateUserRoutingProfile(ctx, &connect.UpdateUserRoutingProfileInput{
+			InstanceId:       aws.String(instanceID),
+			UserId:           userID,
+			RoutingProfileId: aws.String(user.RoutingProfileID.ValueString()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !user.SecurityProfileIDs.Equal(prior.SecurityProfileIDs) {
+		input := &connect.UpdateUserSecurityProfilesInput{
+			InstanceId: aws.String(instanceID),
+			UserId:     userID,
+		}
+
+		if diags := user.SecurityProfileIDs.ElementsAs(ctx, &input.SecurityProfileIds, false); diags.HasError() {
+			return fmt.Errorf("reading security_profile_ids")
+		}
+
+		if _, err := conn.UpdateUserSecurityProfiles(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	if !user.HierarchyGroupID.Equal(prior.HierarchyGroupID) {
+		// A nil hierarchy group removes the user from its group
+		_, err := conn.UpdateUserHierarchy(ctx, &connect.UpdateUserHierarchyInput{
+			InstanceId:       aws.String(instanceID),
+			UserId:           userID,
+			HierarchyGroupId: user.HierarchyGroupID.ValueStringPointer(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return updateConnectTags(ctx, conn, user.Arn.ValueString(), oldTags, newTags)
+}
+
+// deleteBulkUser deletes a user, ignoring users already deleted.
+func deleteBulkUser(ctx context.Context, conn *connect.Client, instanceID string, user BulkUserModel) error {
+	_, err := conn.DeleteUser(ctx, &connect.DeleteUserInput{
+		InstanceId: aws.String(instanceID),
+		UserId:     aws.String(user.UserID.ValueString()),
+	})
+	if isNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (r *UsersBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(usersBulkResourceType, resp) {
+		return
+	}
+
+	var data UsersBulkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(usersBulkResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(usersBulkResourceType, data.Override)
+	remaining := maps.Clone(data.Users)
+
+	var mu sync.Mutex
+	err := forEachConcurrently(slices.Sorted(maps.Keys(data.Users)), userOperationConcurrency, func(username string) error {
+		if err := deleteBulkUser(ctx, conn, data.InstanceID.ValueString(), data.Users[username]); err != nil {
+			return fmt.Errorf("deleting user %s: %w", username, err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		delete(remaining, username)
+
+		return nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error deleting Connect Users", "Could not delete all Connect Users", err))
+
+		// Keep the users which could not be deleted in the state
+		data.Users = remaining
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *UsersBulkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Importing by instance ID manages all users of the instance
+	resource.ImportStatePassthroughID(ctx, path.Root("instance_id"), req, resp)
+}
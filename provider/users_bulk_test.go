@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testSchemaModelRoundTrip decodes a state of the schema of r with all
+// attributes null into model, a pointer to the resource model, and encodes it
+// back, failing if an attribute of the schema is missing from the model or
+// the other way around.
+func testSchemaModelRoundTrip(t *testing.T, r resource.Resource, model any) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatal(schemaResponse.Diagnostics)
+	}
+
+	objectType := schemaResponse.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	state := tfsdk.State{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+	if diags := state.Get(ctx, model); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	roundTrip := tfsdk.State{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	if diags := roundTrip.Set(ctx, model); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if !roundTrip.Raw.Equal(state.Raw) {
+		t.Errorf("got %s, want %s", roundTrip.Raw, state.Raw)
+	}
+}
+
+func TestUsersBulkResourceModel(t *testing.T) {
+	testSchemaModelRoundTrip(t, NewUsersBulkResource(), &UsersBulkResourceModel{})
+}
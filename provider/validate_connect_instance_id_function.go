@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ValidateConnectInstanceIDFunction{}
+
+func NewValidateConnectInstanceIDFunction() function.Function {
+	return &ValidateConnectInstanceIDFunction{}
+}
+
+type ValidateConnectInstanceIDFunction struct{}
+
+func (f *ValidateConnectInstanceIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_connect_instance_id"
+}
+
+func (f *ValidateConnectInstanceIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate and normalize a Connect instance ID",
+		MarkdownDescription: "Checks that the value is a Connect instance ID (UUID) or a Connect instance ARN and returns the lower case instance ID. " +
+			"An error is returned at plan time for anything else, including ARNs of resources within an instance.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "Connect instance ID or instance ARN.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ValidateConnectInstanceIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+
+	if resp.Error != nil {
+		return
+	}
+
+	instanceID, err := normalizeConnectInstanceID(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, instanceID))
+}
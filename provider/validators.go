@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var connectInstanceIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+var _ validator.String = connectInstanceIDValidator{}
+
+// connectInstanceIDValidator validates that a string is a Connect instance ID,
+// i.e. a UUID.
+type connectInstanceIDValidator struct{}
+
+func validConnectInstanceID() validator.String {
+	return connectInstanceIDValidator{}
+}
+
+func (v connectInstanceIDValidator) Description(ctx context.Context) string {
+	return "value must be a Connect instance ID (UUID)"
+}
+
+func (v connectInstanceIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v connectInstanceIDValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if connectInstanceIDPattern.MatchString(value) {
+		return
+	}
+
+	detail := fmt.Sprintf("%q is not a Connect instance ID, expected a UUID such as 12345678-1234-1234-1234-123456789012.", value)
+	if arn.IsARN(value) {
+		detail += " Use the connect_instance_id_from_arn function to get the instance ID of an ARN."
+	}
+
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid Connect Instance ID", detail)
+}
+
+// normalizeConnectInstanceID returns the lower case instance ID of a Connect
+// instance ID or instance ARN.
+func normalizeConnectInstanceID(input string) (string, error) {
+	value := strings.TrimSpace(input)
+
+	if arn.IsARN(value) {
+		instanceID, resourceType, _, err := parseConnectArn(value)
+		if err != nil {
+			return "", err
+		}
+
+		if resourceType != "" {
+			return "", fmt.Errorf("%s is the ARN of a %s, not of a Connect instance", value, resourceType)
+		}
+
+		value = instanceID
+	}
+
+	if !connectInstanceIDPattern.MatchString(value) {
+		return "", fmt.Errorf("%q is not a Connect instance ID, expected a UUID such as 12345678-1234-1234-1234-123456789012", input)
+	}
+
+	return strings.ToLower(value), nil
+}
+
+var _ validator.String = arnValidator{}
+
+// arnValidator validates that a string is an ARN of the given service, or of
+// any service if service is empty.
+type arnValidator struct {
+	service string
+}
+
+// validArn returns a validator for the ARN of a service, e.g. validArn("iam")
+// for role ARNs.
+func validArn(service string) validator.String {
+	return arnValidator{service: service}
+}
+
+func (v arnValidator) Description(ctx context.Context) string {
+	if v.service == "" {
+		return "value must be an ARN"
+	}
+
+	return fmt.Sprintf("value must be an ARN of the %s service", v.service)
+}
+
+func (v arnValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v arnValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	parsed, err := arn.Parse(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", fmt.Sprintf("%q is not an ARN: %s", value, err))
+		return
+	}
+
+	if v.service != "" && parsed.Service != v.service {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid ARN", fmt.Sprintf("%q is an ARN of the %s service, expected an ARN of the %s service.", value, parsed.Service, v.service))
+	}
+}
+
+var _ validator.String = connectArnValidator{}
+
+// connectArnValidator validates that a string is the ARN of a Connect
+// resource of the given type, e.g. queue, or of an instance if resourceType
+// is empty.
+type connectArnValidator struct {
+	resourceType string
+}
+
+// validConnectArn returns a validator for the ARN of a Connect resource type
+// as it appears in ARNs, e.g. "queue" or "contact-flow".
+func validConnectArn(resourceType string) validator.String {
+	return connectArnValidator{resourceType: resourceType}
+}
+
+func (v connectArnValidator) Description(ctx context.Context) string {
+	if v.resourceType == "" {
+		return "value must be a Connect instance ARN"
+	}
+
+	return fmt.Sprintf("value must be the ARN of a Connect %s", v.resourceType)
+}
+
+func (v connectArnValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v connectArnValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	_, resourceType, _, err := parseConnectArn(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Connect ARN", err.Error())
+		return
+	}
+
+	if resourceType != v.resourceType {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Connect ARN", fmt.Sprintf("%q is not %s.", value, strings.TrimPrefix(v.Description(ctx), "value must be ")))
+	}
+}
+
+// validConnectInstanceIDOrArn returns a validator accepting either the ID or
+// the ARN of a Connect instance.
+func validConnectInstanceIDOrArn() validator.String {
+	return stringvalidator.Any(validConnectInstanceID(), validConnectArn(""))
+}
+
+// validE164 returns a validator for phone numbers in E.164 format.
+func validE164() validator.String {
+	return stringvalidator.RegexMatches(e164Pattern, "must be a phone number in E.164 format, e.g. +12065550100; use the e164_normalize function to convert other formats")
+}
+
+// tagPattern matches the characters AWS allows in tag keys and values.
+var tagPattern = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
+
+var _ validator.String = tagKeyValidator{}
+
+// tagKeyValidator validates that a string is a tag key AWS accepts from
+// users: 1 to 128 allowed characters, not using the reserved aws: prefix.
+type tagKeyValidator struct{}
+
+func (v tagKeyValidator) Description(ctx context.Context) string {
+	return "value must be a tag key of 1 to 128 letters, numbers, spaces and _.:/=+-@, not starting with aws:"
+}
+
+func (v tagKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v tagKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	key := req.ConfigValue.ValueString()
+
+	switch {
+	case len(key) < 1 || len(key) > 128:
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Tag Key", fmt.Sprintf("Tag key %q must be 1 to 128 characters long.", key))
+	case !tagPattern.MatchString(key):
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Tag Key", fmt.Sprintf("Tag key %q must only contain letters, numbers, spaces and _.:/=+-@.", key))
+	case strings.HasPrefix(strings.ToLower(key), awsTagKeyPrefix):
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Tag Key", fmt.Sprintf("Tag key %q uses the prefix %s reserved for AWS.", key, awsTagKeyPrefix))
+	}
+}
+
+// tagsValidators returns the validators of tag maps, checking the key and
+// value constraints shared by AWS services.
+func tagsValidators() []validator.Map {
+	return []validator.Map{
+		mapvalidator.KeysAre(tagKeyValidator{}),
+		mapvalidator.ValueStringsAre(
+			stringvalidator.LengthAtMost(256),
+			stringvalidator.RegexMatches(tagPattern, "must only contain letters, numbers, spaces and _.:/=+-@"),
+		),
+	}
+}
+
+var _ validator.String = flowContentValidator{}
+
+// flowContentValidator validates the structure of flow language JSON at plan
+// time, see validateFlowContent.
+type flowContentValidator struct{}
+
+func validFlowContent() validator.String {
+	return flowContentValidator{}
+}
+
+func (v flowContentValidator) Description(ctx context.Context) string {
+	return "value must be flow language JSON whose actions and transitions are consistent"
+}
+
+func (v flowContentValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v flowContentValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, problem := range validateFlowContent(req.ConfigValue.ValueString()) {
+		if problem.warning {
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown Flow Action", problem.message)
+		} else {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Flow Content", problem.message)
+		}
+	}
+}
+
+var _ validator.String = hostPortValidator{}
+
+// hostPortValidator validates that a string is a host name with a port.
+type hostPortValidator struct{}
+
+func validHostPort() validator.String {
+	return hostPortValidator{}
+}
+
+func (v hostPortValidator) Description(ctx context.Context) string {
+	return "value must be a host name and port, e.g. example.com:5432"
+}
+
+func (v hostPortValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hostPortValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	host, port, err := net.SplitHostPort(req.ConfigValue.ValueString())
+	if err != nil || host == "" || port == "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Endpoint", fmt.Sprintf("%q is not a host name and port, e.g. example.com:5432.", req.ConfigValue.ValueString()))
+	}
+}
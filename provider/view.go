@@ -0,0 +1,563 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ViewResource{}
+var _ resource.ResourceWithImportState = &ViewResource{}
+var _ resource.ResourceWithModifyPlan = &ViewResource{}
+var _ resource.ResourceWithIdentity = &ViewResource{}
+
+// viewResourceType is the type of the view resource without the provider
+// prefix, e.g. in operation_policies.
+const viewResourceType = "connect_view"
+
+// savedViewQualifier selects the saved content of a view rather than its
+// published content when appended to the view ID.
+const savedViewQualifier = ":$SAVED"
+
+func NewViewResource() resource.Resource {
+	return &ViewResource{}
+}
+
+type ViewResource struct {
+	providerData *ProviderData
+}
+
+type ViewResourceModel struct {
+	Arn           types.String   `tfsdk:"arn"`
+	ViewID        types.String   `tfsdk:"view_id"`
+	InstanceID    types.String   `tfsdk:"instance_id"`
+	InstanceAlias types.String   `tfsdk:"instance_alias"`
+	Name          types.String   `tfsdk:"name"`
+	Description   NullableString `tfsdk:"description"`
+	Template      NormalizedJSON `tfsdk:"template"`
+	Actions       types.Set      `tfsdk:"actions"`
+	Status        types.String   `tfsdk:"status"`
+	CreateVersion types.Bool     `tfsdk:"create_version"`
+	Version       types.Int32    `tfsdk:"version"`
+	Tags          types.Map      `tfsdk:"tags"`
+	TagsAll       types.Map      `tfsdk:"tags_all"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	Override      *OverrideModel `tfsdk:"override"`
+}
+
+type ViewResourceIdentityModel struct {
+	Arn    types.String `tfsdk:"arn"`
+	ViewID types.String `tfsdk:"view_id"`
+}
+
+func (m ViewResourceModel) identity() ViewResourceIdentityModel {
+	return ViewResourceIdentityModel{
+		Arn:    m.Arn,
+		ViewID: m.ViewID,
+	}
+}
+
+// qualifiedID returns the ID of the view qualified to select the content of
+// its status.
+func (m ViewResourceModel) qualifiedID() string {
+	if m.Status.ValueString() == string(conntypes.ViewStatusSaved) {
+		return m.ViewID.ValueString() + savedViewQualifier
+	}
+
+	return m.ViewID.ValueString()
+}
+
+// publishesVersion reports whether applying the model creates a view version.
+func (m ViewResourceModel) publishesVersion() bool {
+	return m.CreateVersion.ValueBool() && m.Status.ValueString() == string(conntypes.ViewStatusPublished)
+}
+
+// content returns the view content of the model.
+func (m ViewResourceModel) content(ctx context.Context) (*conntypes.ViewInputContent, diag.Diagnostics) {
+	actions := []string{}
+	diags := m.Actions.ElementsAs(ctx, &actions, false)
+
+	return &conntypes.ViewInputContent{
+		Template: aws.String(m.Template.ValueString()),
+		Actions:  actions,
+	}, diags
+}
+
+func (r *ViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + viewResourceType
+}
+
+func (r *ViewResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = connectIdentitySchema("view_id")
+}
+
+func (r *ViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Customer managed Connect view, e.g. a step-by-step guide of the agent workspace. The template is compared after normalization, so formatting changes made by Connect cause no diff",
+
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"view_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_alias": instanceAliasAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"description": schema.StringAttribute{
+				CustomType: NullableStringType{},
+				Optional:   true,
+				Validators: []validator.String{
+					// Empty is allowed and the same as no description
+					stringvalidator.LengthAtMost(4096),
+				},
+			},
+			"template": schema.StringAttribute{
+				CustomType:  NormalizedJSONType{},
+				Required:    true,
+				Description: "JSON template of the view, e.g. exported from the view designer. Differences in key ordering or whitespace are ignored.",
+			},
+			"actions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, nil)),
+				Description: "Actions the template can trigger, e.g. Submit or Cancel.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(conntypes.ViewStatusPublished)),
+				Description: "PUBLISHED to publish the content, or SAVED to only save it, leaving the published content, if any, in use. The content of the status is read back.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(conntypes.ViewStatusPublished), string(conntypes.ViewStatusSaved)),
+				},
+			},
+			"create_version": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Create an immutable view version whenever the content is published.",
+			},
+			"version": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Latest version of the view, when create_version is set.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"override": overrideBlock(),
+		},
+	}
+}
+
+func (r *ViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *ViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if deferOnUnknownInstance(ctx, req, resp) {
+		return
+	}
+
+	r.providerData.modifyPlanInstanceAlias(ctx, req, resp)
+	r.providerData.modifyPlanTags(ctx, req, resp)
+	r.providerData.modifyPlanInstanceExists(ctx, req, resp)
+	r.providerData.modifyPlanRefresh(ctx, r, req, resp)
+
+	// Nothing to compare on create or destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new version is created whenever the content is published
+	if plan.publishesVersion() && (viewContentChanged(ctx, state, plan) || !plan.Status.Equal(state.Status)) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version"), types.Int32Unknown())...)
+	}
+}
+
+// viewContentChanged reports whether the planned view content differs from
+// the prior one other than by formatting.
+func viewContentChanged(ctx context.Context, prior, planned ViewResourceModel) bool {
+	equal, _ := prior.Template.StringSemanticEquals(ctx, planned.Template)
+
+	return !equal || !prior.Actions.Equal(planned.Actions)
+}
+
+func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.providerData.readOnlyCreate(viewResourceType, resp) {
+		return
+	}
+
+	var data ViewResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	tagsAll, diags := mapFromTags(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	content, diags := data.content(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Create, r.providerData.defaultTimeout(viewResourceType, defaultCreateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(viewResourceType, data.Override)
+	input := &connect.CreateViewInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		Name:       aws.String(data.Name.ValueString()),
+		Content:    content,
+		Status:     conntypes.ViewStatus(data.Status.ValueString()),
+	}
+
+	if data.Description.ValueString() != "" {
+		input.Description = aws.String(data.Description.ValueString())
+	}
+
+	if len(tagsAll) > 0 {
+		input.Tags = tagsAll
+	}
+
+	response, err := conn.CreateView(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect View", "Could not create Connect View", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	data.ViewID = types.StringValue(aws.ToString(response.View.Id))
+	data.Arn = types.StringValue(aws.ToString(response.View.Arn))
+	data.Version = types.Int32Null()
+
+	// Save the view before creating its version, so it is not orphaned if
+	// that fails
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+
+	if resp.Diagnostics.HasError() || !data.publishesVersion() {
+		return
+	}
+
+	version, err := createViewVersion(ctx, conn, data, response.View.ViewContentSha256)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error creating Connect View version", fmt.Sprintf("Could not create a version of Connect View %s", data.ViewID.ValueString()), err))
+		return
+	}
+
+	data.Version = types.Int32Value(version)
+
+	// Save data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Read, r.providerData.defaultTimeout(viewResourceType, defaultReadTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(viewResourceType, data.Override)
+	response, err := conn.DescribeView(ctx, &connect.DescribeViewInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		ViewId:     aws.String(data.qualifiedID()),
+	})
+
+	// Views published since they were saved may have no saved content left
+	if isNotFound(err) && data.qualifiedID() != data.ViewID.ValueString() {
+		response, err = conn.DescribeView(ctx, &connect.DescribeViewInput{
+			InstanceId: aws.String(data.InstanceID.ValueString()),
+			ViewId:     aws.String(data.ViewID.ValueString()),
+		})
+	}
+
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect View", "Could not read Connect View", err))
+		return
+	}
+
+	view := response.View
+	content := view.Content
+	if content == nil {
+		content = &conntypes.ViewContent{}
+	}
+
+	data.ViewID = types.StringValue(aws.ToString(view.Id))
+	data.Arn = types.StringValue(aws.ToString(view.Arn))
+	data.Name = types.StringValue(aws.ToString(view.Name))
+	data.Description = flattenNullableString(ctx, data.Description, view.Description)
+	data.Status = types.StringValue(string(view.Status))
+
+	// The template is kept as configured when it only differs by
+	// formatting, by the semantic equality of NormalizedJSON
+	data.Template = NormalizedJSON{StringValue: types.StringValue(aws.ToString(content.Template))}
+
+	data.Actions, diags = types.SetValueFrom(ctx, types.StringType, append([]string{}, content.Actions...))
+	resp.Diagnostics.Append(diags...)
+
+	if data.CreateVersion.ValueBool() {
+		versions, err := collectPages(ctx, listViewVersions(conn, data.InstanceID.ValueString(), data.ViewID.ValueString()), 0, nil)
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error reading Connect View", "Could not list the versions of Connect View", err))
+			return
+		}
+
+		data.Version = types.Int32Null()
+		for _, version := range versions {
+			if version.Version > data.Version.ValueInt32() {
+				data.Version = types.Int32Value(version.Version)
+			}
+		}
+	}
+
+	data.Tags, data.TagsAll, diags = r.providerData.readTags(ctx, view.Tags, data.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data and identity into Terraform state
+	resp.Diagnostics.Append(setStateAndIdentity(ctx, &resp.State, resp.Identity, &data, data.identity())...)
+}
+
+func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.providerData.readOnlyUpdate(ctx, viewResourceType, req, resp) {
+		return
+	}
+
+	var data, state ViewResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Update, r.providerData.defaultTimeout(viewResourceType, defaultUpdateTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(viewResourceType, data.Override)
+
+	if !data.Name.Equal(state.Name) || !data.Description.Equal(state.Description) {
+		_, err := conn.UpdateViewMetadata(ctx, &connect.UpdateViewMetadataInput{
+			InstanceId:  aws.String(data.InstanceID.ValueString()),
+			ViewId:      aws.String(data.ViewID.ValueString()),
+			Name:        aws.String(data.Name.ValueString()),
+			Description: aws.String(data.Description.ValueString()),
+		})
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect View", "Could not update the name of Connect View", err))
+			return
+		}
+	}
+
+	// Publishing a saved view sends its content again
+	if viewContentChanged(ctx, state, data) || !data.Status.Equal(state.Status) {
+		content, diags := data.content(ctx)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		response, err := conn.UpdateViewContent(ctx, &connect.UpdateViewContentInput{
+			InstanceId: aws.String(data.InstanceID.ValueString()),
+			ViewId:     aws.String(data.ViewID.ValueString()),
+			Content:    content,
+			Status:     conntypes.ViewStatus(data.Status.ValueString()),
+		})
+
+		if err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect View", "Could not update the content of Connect View", err))
+			return
+		}
+
+		if data.publishesVersion() {
+			version, err := createViewVersion(ctx, conn, data, response.View.ViewContentSha256)
+
+			if err != nil {
+				resp.Diagnostics.Append(apiError("Error creating Connect View version", fmt.Sprintf("Could not create a version of Connect View %s", data.ViewID.ValueString()), err))
+				return
+			}
+
+			data.Version = types.Int32Value(version)
+		}
+	}
+
+	if data.Version.IsUnknown() {
+		data.Version = types.Int32Null()
+	}
+
+	if !data.TagsAll.Equal(state.TagsAll) {
+		oldTags, diags := mapFromTags(ctx, state.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		newTags, diags := mapFromTags(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateConnectTags(ctx, conn, data.Arn.ValueString(), oldTags, newTags); err != nil {
+			resp.Diagnostics.Append(apiError("Error updating Connect View tags", "Could not update Connect View tags", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.providerData.readOnlyDelete(viewResourceType, resp) {
+		return
+	}
+
+	var data ViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := withTimeout(ctx, data.Timeouts.Delete, r.providerData.defaultTimeout(viewResourceType, defaultDeleteTimeout))
+	resp.Diagnostics.Append(diags...)
+	defer cancel()
+
+	conn := r.providerData.resourceConnectClient(viewResourceType, data.Override)
+	_, err := conn.DeleteView(ctx, &connect.DeleteViewInput{
+		InstanceId: aws.String(data.InstanceID.ValueString()),
+		ViewId:     aws.String(data.ViewID.ValueString()),
+	})
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.Append(apiError("Error deleting Connect View", "Could not delete Connect View", err))
+	}
+}
+
+func (r *ViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importConnectResource(ctx, req, resp, "view", "view_id")
+}
+
+// createViewVersion creates a version from the published content of the view
+// and returns its number. The version is only created if the content still
+// has the given hash, if any.
+func createViewVersion(ctx context.Context, conn *connect.Client, data ViewResourceModel, contentSha256 *string) (int32, error) {
+	response, err := conn.CreateViewVersion(ctx, &connect.CreateViewVersionInput{
+		InstanceId:        aws.String(data.InstanceID.ValueString()),
+		ViewId:            aws.String(data.ViewID.ValueString()),
+		ViewContentSha256: contentSha256,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return response.View.Version, nil
+}
+
+func listViewVersions(conn *connect.Client, instanceID, viewID string) pageLister[conntypes.ViewVersionSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.ViewVersionSummary, *string, error) {
+		response, err := conn.ListViewVersions(ctx, &connect.ListViewVersionsInput{
+			InstanceId: aws.String(instanceID),
+			ViewId:     aws.String(viewID),
+			MaxResults: aws.Int32(100),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.ViewVersionSummaryList, response.NextToken, nil
+	}
+}
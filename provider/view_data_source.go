@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	conntypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ViewDataSource{}
+
+// viewQualifierPattern matches the qualifiers of a view ID: $LATEST, $SAVED or
+// a version number.
+var viewQualifierPattern = regexp.MustCompile(`^(\$LATEST|\$SAVED|[1-9][0-9]*)$`)
+
+func NewViewDataSource() datasource.DataSource {
+	return &ViewDataSource{}
+}
+
+type ViewDataSource struct {
+	providerData *ProviderData
+}
+
+type ViewDataSourceModel struct {
+	InstanceID         types.String `tfsdk:"instance_id"`
+	InstanceAlias      types.String `tfsdk:"instance_alias"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	Qualifier          types.String `tfsdk:"qualifier"`
+	ViewID             types.String `tfsdk:"view_id"`
+	Arn                types.String `tfsdk:"arn"`
+	Description        types.String `tfsdk:"description"`
+	Status             types.String `tfsdk:"status"`
+	Version            types.Int32  `tfsdk:"version"`
+	VersionDescription types.String `tfsdk:"version_description"`
+	Template           types.String `tfsdk:"template"`
+	InputSchema        types.String `tfsdk:"input_schema"`
+	Actions            types.List   `tfsdk:"actions"`
+	ContentSha256      types.String `tfsdk:"content_sha256"`
+}
+
+func (d *ViewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_view"
+}
+
+func (d *ViewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Connect view by name, including AWS managed views, and returns its content",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: instanceIDDescription,
+				Validators:  instanceIDValidators(),
+			},
+			"instance_alias": dataSourceInstanceAliasAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the view, e.g. `Form` for the AWS managed form view.",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only look up views of this type, `AWS_MANAGED` or `CUSTOMER_MANAGED`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(conntypes.ViewTypeAwsManaged), string(conntypes.ViewTypeCustomerManaged)),
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version of the view to return: `$LATEST` for the latest published content, `$SAVED` for the saved content or a version number. Defaults to `$LATEST`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(viewQualifierPattern, "must be $LATEST, $SAVED or a version number"),
+				},
+			},
+			"view_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the view content, `PUBLISHED` or `SAVED`.",
+			},
+			"version": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Version of the returned content.",
+			},
+			"version_description": schema.StringAttribute{
+				Computed: true,
+			},
+			"template": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON template of the view.",
+			},
+			"input_schema": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON schema of the inputs of the view, for the flow blocks showing it.",
+			},
+			"actions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Actions the view can return, i.e. the branches of the flow blocks showing it.",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ViewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *ViewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ViewDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.providerData.resolveInstanceID(ctx, &data.InstanceID, data.InstanceAlias)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.providerData.connectClient(nil)
+	instanceID := data.InstanceID.ValueString()
+	name := data.Name.ValueString()
+
+	views, err := collectPages(ctx, listViews(conn, instanceID, conntypes.ViewType(data.Type.ValueString())), 0, func(view conntypes.ViewSummary) bool {
+		return aws.ToString(view.Name) == name
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error listing Connect Views", "Could not list the views of the instance", err))
+		return
+	}
+
+	if len(views) == 0 {
+		resp.Diagnostics.AddError("Connect View Not Found", fmt.Sprintf("Instance %s has no view named %q.", instanceID, name))
+		return
+	}
+
+	if len(views) > 1 {
+		resp.Diagnostics.AddError("Multiple Connect Views Found", fmt.Sprintf("Instance %s has an AWS managed and a customer managed view named %q, set type to choose one.", instanceID, name))
+		return
+	}
+
+	qualifier := "$LATEST"
+	if !data.Qualifier.IsNull() {
+		qualifier = data.Qualifier.ValueString()
+	}
+
+	response, err := conn.DescribeView(ctx, &connect.DescribeViewInput{
+		InstanceId: aws.String(instanceID),
+		ViewId:     aws.String(aws.ToString(views[0].Id) + ":" + qualifier),
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiError("Error reading Connect View", fmt.Sprintf("Could not read version %s of view %s", qualifier, name), err))
+		return
+	}
+
+	view := response.View
+	content := view.Content
+	if content == nil {
+		content = &conntypes.ViewContent{}
+	}
+
+	data.ViewID = types.StringPointerValue(views[0].Id)
+	data.Arn = types.StringPointerValue(view.Arn)
+	data.Type = types.StringValue(string(view.Type))
+	data.Description = types.StringPointerValue(view.Description)
+	data.Status = types.StringValue(string(view.Status))
+	data.Version = types.Int32Value(view.Version)
+	data.VersionDescription = types.StringPointerValue(view.VersionDescription)
+	data.Template = types.StringPointerValue(content.Template)
+	data.InputSchema = types.StringPointerValue(content.InputSchema)
+	data.ContentSha256 = types.StringPointerValue(view.ViewContentSha256)
+
+	actions, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, content.Actions...))
+	resp.Diagnostics.Append(diags...)
+	data.Actions = actions
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listViews returns the pages of the views of an instance, of all types if
+// viewType is empty.
+func listViews(conn *connect.Client, instanceID string, viewType conntypes.ViewType) pageLister[conntypes.ViewSummary] {
+	return func(ctx context.Context, nextToken *string) ([]conntypes.ViewSummary, *string, error) {
+		response, err := conn.ListViews(ctx, &connect.ListViewsInput{
+			InstanceId: aws.String(instanceID),
+			Type:       viewType,
+			MaxResults: aws.Int32(100),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.ViewsSummaryList, response.NextToken, nil
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"time"
+)
+
+// Defaults for polling long running operations.
+const (
+	defaultPollInterval  = 2 * time.Second
+	maxPollInterval      = 30 * time.Second
+	defaultActionTimeout = 60 * time.Minute
+)
+
+// waitFor calls check until it reports done, returns an error or ctx is done,
+// e.g. when the timeout of the operation expires. The first check is
+// immediate, then the wait between checks starts at interval and doubles up to
+// maxPollInterval, so short operations finish quickly and long ones, like
+// instance creations, are not polled at a rate adding to throttling.
+func waitFor(ctx context.Context, interval time.Duration, check func(ctx context.Context) (bool, error)) error {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = min(2*interval, maxPollInterval)
+		timer.Reset(interval)
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeOnlySecretAttributes returns the attributes of a secret, e.g. a user
+// password, following the <name>_wo / <name>_wo_version convention. The secret
+// is write-only and never persisted in the state, so changing it is signalled
+// by changing the version, which is stored.
+func writeOnlySecretAttributes(name, description string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		name + "_wo": schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Description: description + ". The value is never stored in the state, change " + name + "_wo_version to update it.",
+		},
+		name + "_wo_version": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Version of " + name + "_wo, change it to update the secret.",
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot(name + "_wo")),
+			},
+		},
+	}
+}
+
+// writeOnlySecret reads the write-only secret name from the config, which is
+// the only place its value is available.
+func writeOnlySecret(ctx context.Context, config tfsdk.Config, name string) (types.String, diag.Diagnostics) {
+	var value types.String
+
+	diags := config.GetAttribute(ctx, path.Root(name+"_wo"), &value)
+
+	return value, diags
+}
+
+// writeOnlySecretChanged reports whether the version of the write-only secret
+// name changed between the state and the plan, i.e. whether an update should
+// send the secret.
+func writeOnlySecretChanged(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, name string) (bool, diag.Diagnostics) {
+	var planned, prior types.Int64
+	var diags diag.Diagnostics
+
+	diags.Append(plan.GetAttribute(ctx, path.Root(name+"_wo_version"), &planned)...)
+	diags.Append(state.GetAttribute(ctx, path.Root(name+"_wo_version"), &prior)...)
+
+	return !planned.Equal(prior), diags
+}